@@ -0,0 +1,47 @@
+package ghx
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type workflowRunLister interface {
+	ListWorkflowRunsByID(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	ListWorkflowRunsByFileName(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	ListRepositoryWorkflowRuns(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+}
+
+//ghxgen:extend ActionsService.MapWorkflowRunsByID
+func newMapWorkflowRunsByIDF(actionsService workflowRunLister) func(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.ListWorkflowRunsOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+			return actionsService.ListWorkflowRunsByID(ctx, owner, repo, workflowID, opts)
+		})
+		return PaginateWrapped(opts.Page, list, workflowRunsOf, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+//ghxgen:extend ActionsService.MapWorkflowRunsByFileName
+func newMapWorkflowRunsByFileNameF(actionsService workflowRunLister) func(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.ListWorkflowRunsOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+			return actionsService.ListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, opts)
+		})
+		return PaginateWrapped(opts.Page, list, workflowRunsOf, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+//ghxgen:extend ActionsService.MapRepositoryWorkflowRuns
+func newMapRepositoryWorkflowRunsF(actionsService workflowRunLister) func(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.ListWorkflowRunsOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+			return actionsService.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+		})
+		return PaginateWrapped(opts.Page, list, workflowRunsOf, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+func workflowRunsOf(r *github.WorkflowRuns) []*github.WorkflowRun {
+	return r.WorkflowRuns
+}
@@ -0,0 +1,85 @@
+package ghx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapWorkflowRuns(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testOwner    = "testOwner"
+		testRepo     = "testRepo"
+		testID       = int64(7)
+		testFileName = "ci.yml"
+	)
+	testCtx := context.Background()
+
+	expect := []*github.WorkflowRun{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}, {ID: PTR[int64](3)}}
+	wrappedPages := func(t *testing.T) func(page int) (*github.WorkflowRuns, *github.Response, error) {
+		t.Helper()
+		pages := servePages(t, expect[:2], expect[2:])
+		return func(page int) (*github.WorkflowRuns, *github.Response, error) {
+			runs, resp, err := pages(page)
+			return &github.WorkflowRuns{WorkflowRuns: runs}, resp, err
+		}
+	}
+
+	t.Run("MapWorkflowRunsByID", func(t *testing.T) {
+		t.Parallel()
+
+		pages := wrappedPages(t)
+		s := NewActionsService(&ActionsServiceF{
+			ListWorkflowRunsByID: func(_ context.Context, actualOwner string, actualRepo string, actualID int64, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testID, actualID)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.WorkflowRun
+		require.NoError(t, newMapWorkflowRunsByIDF(s)(testCtx, testOwner, testRepo, testID, &github.ListWorkflowRunsOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapWorkflowRunsByFileName", func(t *testing.T) {
+		t.Parallel()
+
+		pages := wrappedPages(t)
+		s := NewActionsService(&ActionsServiceF{
+			ListWorkflowRunsByFileName: func(_ context.Context, actualOwner string, actualRepo string, actualFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testFileName, actualFileName)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.WorkflowRun
+		require.NoError(t, newMapWorkflowRunsByFileNameF(s)(testCtx, testOwner, testRepo, testFileName, &github.ListWorkflowRunsOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapRepositoryWorkflowRuns", func(t *testing.T) {
+		t.Parallel()
+
+		pages := wrappedPages(t)
+		s := NewActionsService(&ActionsServiceF{
+			ListRepositoryWorkflowRuns: func(_ context.Context, actualOwner string, actualRepo string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.WorkflowRun
+		require.NoError(t, newMapRepositoryWorkflowRunsF(s)(testCtx, testOwner, testRepo, &github.ListWorkflowRunsOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+}
@@ -0,0 +1,21 @@
+package ghx
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type notificationLister interface {
+	ListNotifications(ctx context.Context, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error)
+}
+
+//ghxgen:extend ActivityService.MapNotifications
+func newMapNotificationsF(activityService notificationLister) func(ctx context.Context, opts *github.NotificationListOptions, handle NotificationHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, opts *github.NotificationListOptions, handle NotificationHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.NotificationListOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+			return activityService.ListNotifications(ctx, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
@@ -0,0 +1,27 @@
+package ghx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapNotifications(t *testing.T) {
+	t.Parallel()
+
+	expect := []*github.Notification{{ID: PTR("1")}, {ID: PTR("2")}, {ID: PTR("3")}}
+	pages := servePages(t, expect[:2], expect[2:])
+	s := NewActivityService(&ActivityServiceF{
+		ListNotifications: func(_ context.Context, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+			assert.True(t, opts.All)
+			return pages(opts.Page)
+		},
+	})
+
+	var actual []*github.Notification
+	require.NoError(t, newMapNotificationsF(s)(context.Background(), &github.NotificationListOptions{All: true}, collect(&actual)))
+	assert.Equal(t, expect, actual)
+}
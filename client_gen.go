@@ -0,0 +1,5800 @@
+// Code generated by ghxgen. DO NOT EDIT.
+
+package ghx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type Client struct {
+	*github.Client
+
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Billing            *BillingService
+	Checks             *ChecksService
+	CodeScanning       *CodeScanningService
+	CodesOfConduct     *CodesOfConductService
+	Codespaces         *CodespacesService
+	Copilot            *CopilotService
+	Dependabot         *DependabotService
+	DependencyGraph    *DependencyGraphService
+	Emojis             *EmojisService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	Licenses           *LicensesService
+	Markdown           *MarkdownService
+	Marketplace        *MarketplaceService
+	Meta               *MetaService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	RateLimit          *RateLimitService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	SCIM               *SCIMService
+	Search             *SearchService
+	SecretScanning     *SecretScanningService
+	SecurityAdvisories *SecurityAdvisoriesService
+	Teams              *TeamsService
+	Users              *UsersService
+}
+
+// NewClient returns a new *ghx.Client with all calls routed to *github.Client
+func NewClient(client *github.Client) *Client {
+	return &Client{
+		Client:             client,
+		Actions:            newActionsServicePassthrough(client),
+		Activity:           newActivityServicePassthrough(client),
+		Admin:              newAdminServicePassthrough(client),
+		Apps:               newAppsServicePassthrough(client),
+		Authorizations:     newAuthorizationsServicePassthrough(client),
+		Billing:            newBillingServicePassthrough(client),
+		Checks:             newChecksServicePassthrough(client),
+		CodeScanning:       newCodeScanningServicePassthrough(client),
+		CodesOfConduct:     newCodesOfConductServicePassthrough(client),
+		Codespaces:         newCodespacesServicePassthrough(client),
+		Copilot:            newCopilotServicePassthrough(client),
+		Dependabot:         newDependabotServicePassthrough(client),
+		DependencyGraph:    newDependencyGraphServicePassthrough(client),
+		Emojis:             newEmojisServicePassthrough(client),
+		Enterprise:         newEnterpriseServicePassthrough(client),
+		Gists:              newGistsServicePassthrough(client),
+		Git:                newGitServicePassthrough(client),
+		Gitignores:         newGitignoresServicePassthrough(client),
+		Interactions:       newInteractionsServicePassthrough(client),
+		IssueImport:        newIssueImportServicePassthrough(client),
+		Issues:             newIssuesServicePassthrough(client),
+		Licenses:           newLicensesServicePassthrough(client),
+		Markdown:           newMarkdownServicePassthrough(client),
+		Marketplace:        newMarketplaceServicePassthrough(client),
+		Meta:               newMetaServicePassthrough(client),
+		Migrations:         newMigrationServicePassthrough(client),
+		Organizations:      newOrganizationsServicePassthrough(client),
+		Projects:           newProjectsServicePassthrough(client),
+		PullRequests:       newPullRequestsServicePassthrough(client),
+		RateLimit:          newRateLimitServicePassthrough(client),
+		Reactions:          newReactionsServicePassthrough(client),
+		Repositories:       newRepositoriesServicePassthrough(client),
+		SCIM:               newSCIMServicePassthrough(client),
+		Search:             newSearchServicePassthrough(client),
+		SecretScanning:     newSecretScanningServicePassthrough(client),
+		SecurityAdvisories: newSecurityAdvisoriesServicePassthrough(client),
+		Teams:              newTeamsServicePassthrough(client),
+		Users:              newUsersServicePassthrough(client),
+	}
+}
+
+type ActionsServiceF struct {
+	AddEnabledOrgInEnterprise                    func(ctx context.Context, owner string, organizationID int64) (*github.Response, error)
+	AddEnabledReposInOrg                         func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
+	AddRepoToRequiredWorkflow                    func(ctx context.Context, org string, requiredWorkflowID int64, repoID int64) (*github.Response, error)
+	AddRepositoryAccessRunnerGroup               func(ctx context.Context, org string, groupID int64, repoID int64) (*github.Response, error)
+	AddRunnerGroupRunners                        func(ctx context.Context, org string, groupID int64, runnerID int64) (*github.Response, error)
+	AddSelectedRepoToOrgSecret                   func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	AddSelectedRepoToOrgVariable                 func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	CancelWorkflowRunByID                        func(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error)
+	CreateEnvVariable                            func(ctx context.Context, owner string, repo string, env string, variable *github.ActionsVariable) (*github.Response, error)
+	CreateOrUpdateEnvSecret                      func(ctx context.Context, repoID int, env string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	CreateOrUpdateOrgSecret                      func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	CreateOrUpdateRepoSecret                     func(ctx context.Context, owner string, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	CreateOrgVariable                            func(ctx context.Context, org string, variable *github.ActionsVariable) (*github.Response, error)
+	CreateOrganizationRegistrationToken          func(ctx context.Context, org string) (*github.RegistrationToken, *github.Response, error)
+	CreateOrganizationRemoveToken                func(ctx context.Context, org string) (*github.RemoveToken, *github.Response, error)
+	CreateOrganizationRunnerGroup                func(ctx context.Context, org string, createReq github.CreateRunnerGroupRequest) (*github.RunnerGroup, *github.Response, error)
+	CreateRegistrationToken                      func(ctx context.Context, owner string, repo string) (*github.RegistrationToken, *github.Response, error)
+	CreateRemoveToken                            func(ctx context.Context, owner string, repo string) (*github.RemoveToken, *github.Response, error)
+	CreateRepoVariable                           func(ctx context.Context, owner string, repo string, variable *github.ActionsVariable) (*github.Response, error)
+	CreateRequiredWorkflow                       func(ctx context.Context, org string, createRequiredWorkflowOptions *github.CreateUpdateRequiredWorkflowOptions) (*github.OrgRequiredWorkflow, *github.Response, error)
+	CreateWorkflowDispatchEventByFileName        func(ctx context.Context, owner string, repo string, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error)
+	CreateWorkflowDispatchEventByID              func(ctx context.Context, owner string, repo string, workflowID int64, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error)
+	DeleteArtifact                               func(ctx context.Context, owner string, repo string, artifactID int64) (*github.Response, error)
+	DeleteCachesByID                             func(ctx context.Context, owner string, repo string, cacheID int64) (*github.Response, error)
+	DeleteCachesByKey                            func(ctx context.Context, owner string, repo string, key string, ref *string) (*github.Response, error)
+	DeleteEnvSecret                              func(ctx context.Context, repoID int, env string, secretName string) (*github.Response, error)
+	DeleteEnvVariable                            func(ctx context.Context, owner string, repo string, env string, variableName string) (*github.Response, error)
+	DeleteOrgSecret                              func(ctx context.Context, org string, name string) (*github.Response, error)
+	DeleteOrgVariable                            func(ctx context.Context, org string, name string) (*github.Response, error)
+	DeleteOrganizationRunnerGroup                func(ctx context.Context, org string, groupID int64) (*github.Response, error)
+	DeleteRepoSecret                             func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	DeleteRepoVariable                           func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	DeleteRequiredWorkflow                       func(ctx context.Context, org string, requiredWorkflowID int64) (*github.Response, error)
+	DeleteWorkflowRun                            func(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error)
+	DeleteWorkflowRunLogs                        func(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error)
+	DisableWorkflowByFileName                    func(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Response, error)
+	DisableWorkflowByID                          func(ctx context.Context, owner string, repo string, workflowID int64) (*github.Response, error)
+	DownloadArtifact                             func(ctx context.Context, owner string, repo string, artifactID int64, maxRedirects int) (*url.URL, *github.Response, error)
+	EditActionsAllowed                           func(ctx context.Context, org string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error)
+	EditActionsAllowedInEnterprise               func(ctx context.Context, enterprise string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error)
+	EditActionsPermissions                       func(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error)
+	EditActionsPermissionsInEnterprise           func(ctx context.Context, enterprise string, actionsPermissionsEnterprise github.ActionsPermissionsEnterprise) (*github.ActionsPermissionsEnterprise, *github.Response, error)
+	EditDefaultWorkflowPermissionsInEnterprise   func(ctx context.Context, enterprise string, permissions github.DefaultWorkflowPermissionEnterprise) (*github.DefaultWorkflowPermissionEnterprise, *github.Response, error)
+	EditDefaultWorkflowPermissionsInOrganization func(ctx context.Context, org string, permissions github.DefaultWorkflowPermissionOrganization) (*github.DefaultWorkflowPermissionOrganization, *github.Response, error)
+	EnableWorkflowByFileName                     func(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Response, error)
+	EnableWorkflowByID                           func(ctx context.Context, owner string, repo string, workflowID int64) (*github.Response, error)
+	GenerateOrgJITConfig                         func(ctx context.Context, org string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error)
+	GenerateRepoJITConfig                        func(ctx context.Context, owner string, repo string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error)
+	GetActionsAllowed                            func(ctx context.Context, org string) (*github.ActionsAllowed, *github.Response, error)
+	GetActionsAllowedInEnterprise                func(ctx context.Context, enterprise string) (*github.ActionsAllowed, *github.Response, error)
+	GetActionsPermissions                        func(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error)
+	GetActionsPermissionsInEnterprise            func(ctx context.Context, enterprise string) (*github.ActionsPermissionsEnterprise, *github.Response, error)
+	GetArtifact                                  func(ctx context.Context, owner string, repo string, artifactID int64) (*github.Artifact, *github.Response, error)
+	GetCacheUsageForRepo                         func(ctx context.Context, owner string, repo string) (*github.ActionsCacheUsage, *github.Response, error)
+	GetDefaultWorkflowPermissionsInEnterprise    func(ctx context.Context, enterprise string) (*github.DefaultWorkflowPermissionEnterprise, *github.Response, error)
+	GetDefaultWorkflowPermissionsInOrganization  func(ctx context.Context, org string) (*github.DefaultWorkflowPermissionOrganization, *github.Response, error)
+	GetEnvPublicKey                              func(ctx context.Context, repoID int, env string) (*github.PublicKey, *github.Response, error)
+	GetEnvSecret                                 func(ctx context.Context, repoID int, env string, secretName string) (*github.Secret, *github.Response, error)
+	GetEnvVariable                               func(ctx context.Context, owner string, repo string, env string, variableName string) (*github.ActionsVariable, *github.Response, error)
+	GetOrgOIDCSubjectClaimCustomTemplate         func(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	GetOrgPublicKey                              func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error)
+	GetOrgSecret                                 func(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error)
+	GetOrgVariable                               func(ctx context.Context, org string, name string) (*github.ActionsVariable, *github.Response, error)
+	GetOrganizationRunner                        func(ctx context.Context, org string, runnerID int64) (*github.Runner, *github.Response, error)
+	GetOrganizationRunnerGroup                   func(ctx context.Context, org string, groupID int64) (*github.RunnerGroup, *github.Response, error)
+	GetRepoOIDCSubjectClaimCustomTemplate        func(ctx context.Context, owner string, repo string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	GetRepoPublicKey                             func(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret                                func(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error)
+	GetRepoVariable                              func(ctx context.Context, owner string, repo string, name string) (*github.ActionsVariable, *github.Response, error)
+	GetRequiredWorkflowByID                      func(ctx context.Context, owner string, requiredWorkflowID int64) (*github.OrgRequiredWorkflow, *github.Response, error)
+	GetRunner                                    func(ctx context.Context, owner string, repo string, runnerID int64) (*github.Runner, *github.Response, error)
+	GetTotalCacheUsageForEnterprise              func(ctx context.Context, enterprise string) (*github.TotalCacheUsage, *github.Response, error)
+	GetTotalCacheUsageForOrg                     func(ctx context.Context, org string) (*github.TotalCacheUsage, *github.Response, error)
+	GetWorkflowByFileName                        func(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Workflow, *github.Response, error)
+	GetWorkflowByID                              func(ctx context.Context, owner string, repo string, workflowID int64) (*github.Workflow, *github.Response, error)
+	GetWorkflowJobByID                           func(ctx context.Context, owner string, repo string, jobID int64) (*github.WorkflowJob, *github.Response, error)
+	GetWorkflowJobLogs                           func(ctx context.Context, owner string, repo string, jobID int64, maxRedirects int) (*url.URL, *github.Response, error)
+	GetWorkflowRunAttempt                        func(ctx context.Context, owner string, repo string, runID int64, attemptNumber int, opts *github.WorkflowRunAttemptOptions) (*github.WorkflowRun, *github.Response, error)
+	GetWorkflowRunAttemptLogs                    func(ctx context.Context, owner string, repo string, runID int64, attemptNumber int, maxRedirects int) (*url.URL, *github.Response, error)
+	GetWorkflowRunByID                           func(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRun, *github.Response, error)
+	GetWorkflowRunLogs                           func(ctx context.Context, owner string, repo string, runID int64, maxRedirects int) (*url.URL, *github.Response, error)
+	GetWorkflowRunUsageByID                      func(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunUsage, *github.Response, error)
+	GetWorkflowUsageByFileName                   func(ctx context.Context, owner string, repo string, workflowFileName string) (*github.WorkflowUsage, *github.Response, error)
+	GetWorkflowUsageByID                         func(ctx context.Context, owner string, repo string, workflowID int64) (*github.WorkflowUsage, *github.Response, error)
+	ListArtifacts                                func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ArtifactList, *github.Response, error)
+	ListCacheUsageByRepoForOrg                   func(ctx context.Context, org string, opts *github.ListOptions) (*github.ActionsCacheUsageList, *github.Response, error)
+	ListCaches                                   func(ctx context.Context, owner string, repo string, opts *github.ActionsCacheListOptions) (*github.ActionsCacheList, *github.Response, error)
+	ListEnabledOrgsInEnterprise                  func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnEnterpriseRepos, *github.Response, error)
+	ListEnabledReposInOrg                        func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error)
+	ListEnvSecrets                               func(ctx context.Context, repoID int, env string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListEnvVariables                             func(ctx context.Context, owner string, repo string, env string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error)
+	ListOrgRequiredWorkflows                     func(ctx context.Context, org string, opts *github.ListOptions) (*github.OrgRequiredWorkflows, *github.Response, error)
+	ListOrgSecrets                               func(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListOrgVariables                             func(ctx context.Context, org string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error)
+	ListOrganizationRunnerApplicationDownloads   func(ctx context.Context, org string) ([]*github.RunnerApplicationDownload, *github.Response, error)
+	ListOrganizationRunnerGroups                 func(ctx context.Context, org string, opts *github.ListOrgRunnerGroupOptions) (*github.RunnerGroups, *github.Response, error)
+	ListOrganizationRunners                      func(ctx context.Context, org string, opts *github.ListRunnersOptions) (*github.Runners, *github.Response, error)
+	ListRepoOrgSecrets                           func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListRepoOrgVariables                         func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error)
+	ListRepoRequiredWorkflows                    func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.RepoRequiredWorkflows, *github.Response, error)
+	ListRepoSecrets                              func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListRepoVariables                            func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error)
+	ListRepositoryAccessRunnerGroup              func(ctx context.Context, org string, groupID int64, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error)
+	ListRepositoryWorkflowRuns                   func(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	ListRequiredWorkflowSelectedRepos            func(ctx context.Context, org string, requiredWorkflowID int64, opts *github.ListOptions) (*github.RequiredWorkflowSelectedRepos, *github.Response, error)
+	ListRunnerApplicationDownloads               func(ctx context.Context, owner string, repo string) ([]*github.RunnerApplicationDownload, *github.Response, error)
+	ListRunnerGroupRunners                       func(ctx context.Context, org string, groupID int64, opts *github.ListOptions) (*github.Runners, *github.Response, error)
+	ListRunners                                  func(ctx context.Context, owner string, repo string, opts *github.ListRunnersOptions) (*github.Runners, *github.Response, error)
+	ListSelectedReposForOrgSecret                func(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	ListSelectedReposForOrgVariable              func(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	ListWorkflowJobs                             func(ctx context.Context, owner string, repo string, runID int64, opts *github.ListWorkflowJobsOptions) (*github.Jobs, *github.Response, error)
+	ListWorkflowJobsAttempt                      func(ctx context.Context, owner string, repo string, runID int64, attemptNumber int64, opts *github.ListOptions) (*github.Jobs, *github.Response, error)
+	ListWorkflowRunArtifacts                     func(ctx context.Context, owner string, repo string, runID int64, opts *github.ListOptions) (*github.ArtifactList, *github.Response, error)
+	ListWorkflowRunsByFileName                   func(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	ListWorkflowRunsByID                         func(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	ListWorkflows                                func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Workflows, *github.Response, error)
+	PendingDeployments                           func(ctx context.Context, owner string, repo string, runID int64, request *github.PendingDeploymentsRequest) ([]*github.Deployment, *github.Response, error)
+	RemoveEnabledOrgInEnterprise                 func(ctx context.Context, owner string, organizationID int64) (*github.Response, error)
+	RemoveEnabledReposInOrg                      func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
+	RemoveOrganizationRunner                     func(ctx context.Context, org string, runnerID int64) (*github.Response, error)
+	RemoveRepoFromRequiredWorkflow               func(ctx context.Context, org string, requiredWorkflowID int64, repoID int64) (*github.Response, error)
+	RemoveRepositoryAccessRunnerGroup            func(ctx context.Context, org string, groupID int64, repoID int64) (*github.Response, error)
+	RemoveRunner                                 func(ctx context.Context, owner string, repo string, runnerID int64) (*github.Response, error)
+	RemoveRunnerGroupRunners                     func(ctx context.Context, org string, groupID int64, runnerID int64) (*github.Response, error)
+	RemoveSelectedRepoFromOrgSecret              func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	RemoveSelectedRepoFromOrgVariable            func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	RerunFailedJobsByID                          func(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error)
+	RerunJobByID                                 func(ctx context.Context, owner string, repo string, jobID int64) (*github.Response, error)
+	RerunWorkflowByID                            func(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error)
+	SetEnabledOrgsInEnterprise                   func(ctx context.Context, owner string, organizationIDs []int64) (*github.Response, error)
+	SetEnabledReposInOrg                         func(ctx context.Context, owner string, repositoryIDs []int64) (*github.Response, error)
+	SetOrgOIDCSubjectClaimCustomTemplate         func(ctx context.Context, org string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+	SetRepoOIDCSubjectClaimCustomTemplate        func(ctx context.Context, owner string, repo string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+	SetRepositoryAccessRunnerGroup               func(ctx context.Context, org string, groupID int64, ids github.SetRepoAccessRunnerGroupRequest) (*github.Response, error)
+	SetRequiredWorkflowSelectedRepos             func(ctx context.Context, org string, requiredWorkflowID int64, ids github.SelectedRepoIDs) (*github.Response, error)
+	SetRunnerGroupRunners                        func(ctx context.Context, org string, groupID int64, ids github.SetRunnerGroupRunnersRequest) (*github.Response, error)
+	SetSelectedReposForOrgSecret                 func(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	SetSelectedReposForOrgVariable               func(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	UpdateEnvVariable                            func(ctx context.Context, owner string, repo string, env string, variable *github.ActionsVariable) (*github.Response, error)
+	UpdateOrgVariable                            func(ctx context.Context, org string, variable *github.ActionsVariable) (*github.Response, error)
+	UpdateOrganizationRunnerGroup                func(ctx context.Context, org string, groupID int64, updateReq github.UpdateRunnerGroupRequest) (*github.RunnerGroup, *github.Response, error)
+	UpdateRepoVariable                           func(ctx context.Context, owner string, repo string, variable *github.ActionsVariable) (*github.Response, error)
+	UpdateRequiredWorkflow                       func(ctx context.Context, org string, requiredWorkflowID int64, updateRequiredWorkflowOptions *github.CreateUpdateRequiredWorkflowOptions) (*github.OrgRequiredWorkflow, *github.Response, error)
+
+	MapRepositoryWorkflowRuns func(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error                          `ghx:"extension"`
+	MapWorkflowRunsByFileName func(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+	MapWorkflowRunsByID       func(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error        `ghx:"extension"`
+}
+
+type ActionsService struct {
+	f *ActionsServiceF
+}
+
+func (a *ActionsService) AddEnabledOrgInEnterprise(ctx context.Context, owner string, organizationID int64) (*github.Response, error) {
+	return a.f.AddEnabledOrgInEnterprise(ctx, owner, organizationID)
+}
+func (a *ActionsService) AddEnabledReposInOrg(ctx context.Context, owner string, repositoryID int64) (*github.Response, error) {
+	return a.f.AddEnabledReposInOrg(ctx, owner, repositoryID)
+}
+func (a *ActionsService) AddRepoToRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, repoID int64) (*github.Response, error) {
+	return a.f.AddRepoToRequiredWorkflow(ctx, org, requiredWorkflowID, repoID)
+}
+func (a *ActionsService) AddRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, repoID int64) (*github.Response, error) {
+	return a.f.AddRepositoryAccessRunnerGroup(ctx, org, groupID, repoID)
+}
+func (a *ActionsService) AddRunnerGroupRunners(ctx context.Context, org string, groupID int64, runnerID int64) (*github.Response, error) {
+	return a.f.AddRunnerGroupRunners(ctx, org, groupID, runnerID)
+}
+func (a *ActionsService) AddSelectedRepoToOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return a.f.AddSelectedRepoToOrgSecret(ctx, org, name, repo)
+}
+func (a *ActionsService) AddSelectedRepoToOrgVariable(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return a.f.AddSelectedRepoToOrgVariable(ctx, org, name, repo)
+}
+func (a *ActionsService) CancelWorkflowRunByID(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error) {
+	return a.f.CancelWorkflowRunByID(ctx, owner, repo, runID)
+}
+func (a *ActionsService) CreateEnvVariable(ctx context.Context, owner string, repo string, env string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.CreateEnvVariable(ctx, owner, repo, env, variable)
+}
+func (a *ActionsService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int, env string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return a.f.CreateOrUpdateEnvSecret(ctx, repoID, env, eSecret)
+}
+func (a *ActionsService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return a.f.CreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+func (a *ActionsService) CreateOrUpdateRepoSecret(ctx context.Context, owner string, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return a.f.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+func (a *ActionsService) CreateOrgVariable(ctx context.Context, org string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.CreateOrgVariable(ctx, org, variable)
+}
+func (a *ActionsService) CreateOrganizationRegistrationToken(ctx context.Context, org string) (*github.RegistrationToken, *github.Response, error) {
+	return a.f.CreateOrganizationRegistrationToken(ctx, org)
+}
+func (a *ActionsService) CreateOrganizationRemoveToken(ctx context.Context, org string) (*github.RemoveToken, *github.Response, error) {
+	return a.f.CreateOrganizationRemoveToken(ctx, org)
+}
+func (a *ActionsService) CreateOrganizationRunnerGroup(ctx context.Context, org string, createReq github.CreateRunnerGroupRequest) (*github.RunnerGroup, *github.Response, error) {
+	return a.f.CreateOrganizationRunnerGroup(ctx, org, createReq)
+}
+func (a *ActionsService) CreateRegistrationToken(ctx context.Context, owner string, repo string) (*github.RegistrationToken, *github.Response, error) {
+	return a.f.CreateRegistrationToken(ctx, owner, repo)
+}
+func (a *ActionsService) CreateRemoveToken(ctx context.Context, owner string, repo string) (*github.RemoveToken, *github.Response, error) {
+	return a.f.CreateRemoveToken(ctx, owner, repo)
+}
+func (a *ActionsService) CreateRepoVariable(ctx context.Context, owner string, repo string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.CreateRepoVariable(ctx, owner, repo, variable)
+}
+func (a *ActionsService) CreateRequiredWorkflow(ctx context.Context, org string, createRequiredWorkflowOptions *github.CreateUpdateRequiredWorkflowOptions) (*github.OrgRequiredWorkflow, *github.Response, error) {
+	return a.f.CreateRequiredWorkflow(ctx, org, createRequiredWorkflowOptions)
+}
+func (a *ActionsService) CreateWorkflowDispatchEventByFileName(ctx context.Context, owner string, repo string, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error) {
+	return a.f.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowFileName, event)
+}
+func (a *ActionsService) CreateWorkflowDispatchEventByID(ctx context.Context, owner string, repo string, workflowID int64, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error) {
+	return a.f.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflowID, event)
+}
+func (a *ActionsService) DeleteArtifact(ctx context.Context, owner string, repo string, artifactID int64) (*github.Response, error) {
+	return a.f.DeleteArtifact(ctx, owner, repo, artifactID)
+}
+func (a *ActionsService) DeleteCachesByID(ctx context.Context, owner string, repo string, cacheID int64) (*github.Response, error) {
+	return a.f.DeleteCachesByID(ctx, owner, repo, cacheID)
+}
+func (a *ActionsService) DeleteCachesByKey(ctx context.Context, owner string, repo string, key string, ref *string) (*github.Response, error) {
+	return a.f.DeleteCachesByKey(ctx, owner, repo, key, ref)
+}
+func (a *ActionsService) DeleteEnvSecret(ctx context.Context, repoID int, env string, secretName string) (*github.Response, error) {
+	return a.f.DeleteEnvSecret(ctx, repoID, env, secretName)
+}
+func (a *ActionsService) DeleteEnvVariable(ctx context.Context, owner string, repo string, env string, variableName string) (*github.Response, error) {
+	return a.f.DeleteEnvVariable(ctx, owner, repo, env, variableName)
+}
+func (a *ActionsService) DeleteOrgSecret(ctx context.Context, org string, name string) (*github.Response, error) {
+	return a.f.DeleteOrgSecret(ctx, org, name)
+}
+func (a *ActionsService) DeleteOrgVariable(ctx context.Context, org string, name string) (*github.Response, error) {
+	return a.f.DeleteOrgVariable(ctx, org, name)
+}
+func (a *ActionsService) DeleteOrganizationRunnerGroup(ctx context.Context, org string, groupID int64) (*github.Response, error) {
+	return a.f.DeleteOrganizationRunnerGroup(ctx, org, groupID)
+}
+func (a *ActionsService) DeleteRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return a.f.DeleteRepoSecret(ctx, owner, repo, name)
+}
+func (a *ActionsService) DeleteRepoVariable(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return a.f.DeleteRepoVariable(ctx, owner, repo, name)
+}
+func (a *ActionsService) DeleteRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64) (*github.Response, error) {
+	return a.f.DeleteRequiredWorkflow(ctx, org, requiredWorkflowID)
+}
+func (a *ActionsService) DeleteWorkflowRun(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error) {
+	return a.f.DeleteWorkflowRun(ctx, owner, repo, runID)
+}
+func (a *ActionsService) DeleteWorkflowRunLogs(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error) {
+	return a.f.DeleteWorkflowRunLogs(ctx, owner, repo, runID)
+}
+func (a *ActionsService) DisableWorkflowByFileName(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Response, error) {
+	return a.f.DisableWorkflowByFileName(ctx, owner, repo, workflowFileName)
+}
+func (a *ActionsService) DisableWorkflowByID(ctx context.Context, owner string, repo string, workflowID int64) (*github.Response, error) {
+	return a.f.DisableWorkflowByID(ctx, owner, repo, workflowID)
+}
+func (a *ActionsService) DownloadArtifact(ctx context.Context, owner string, repo string, artifactID int64, maxRedirects int) (*url.URL, *github.Response, error) {
+	return a.f.DownloadArtifact(ctx, owner, repo, artifactID, maxRedirects)
+}
+func (a *ActionsService) EditActionsAllowed(ctx context.Context, org string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error) {
+	return a.f.EditActionsAllowed(ctx, org, actionsAllowed)
+}
+func (a *ActionsService) EditActionsAllowedInEnterprise(ctx context.Context, enterprise string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error) {
+	return a.f.EditActionsAllowedInEnterprise(ctx, enterprise, actionsAllowed)
+}
+func (a *ActionsService) EditActionsPermissions(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error) {
+	return a.f.EditActionsPermissions(ctx, org, actionsPermissions)
+}
+func (a *ActionsService) EditActionsPermissionsInEnterprise(ctx context.Context, enterprise string, actionsPermissionsEnterprise github.ActionsPermissionsEnterprise) (*github.ActionsPermissionsEnterprise, *github.Response, error) {
+	return a.f.EditActionsPermissionsInEnterprise(ctx, enterprise, actionsPermissionsEnterprise)
+}
+func (a *ActionsService) EditDefaultWorkflowPermissionsInEnterprise(ctx context.Context, enterprise string, permissions github.DefaultWorkflowPermissionEnterprise) (*github.DefaultWorkflowPermissionEnterprise, *github.Response, error) {
+	return a.f.EditDefaultWorkflowPermissionsInEnterprise(ctx, enterprise, permissions)
+}
+func (a *ActionsService) EditDefaultWorkflowPermissionsInOrganization(ctx context.Context, org string, permissions github.DefaultWorkflowPermissionOrganization) (*github.DefaultWorkflowPermissionOrganization, *github.Response, error) {
+	return a.f.EditDefaultWorkflowPermissionsInOrganization(ctx, org, permissions)
+}
+func (a *ActionsService) EnableWorkflowByFileName(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Response, error) {
+	return a.f.EnableWorkflowByFileName(ctx, owner, repo, workflowFileName)
+}
+func (a *ActionsService) EnableWorkflowByID(ctx context.Context, owner string, repo string, workflowID int64) (*github.Response, error) {
+	return a.f.EnableWorkflowByID(ctx, owner, repo, workflowID)
+}
+func (a *ActionsService) GenerateOrgJITConfig(ctx context.Context, org string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error) {
+	return a.f.GenerateOrgJITConfig(ctx, org, request)
+}
+func (a *ActionsService) GenerateRepoJITConfig(ctx context.Context, owner string, repo string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error) {
+	return a.f.GenerateRepoJITConfig(ctx, owner, repo, request)
+}
+func (a *ActionsService) GetActionsAllowed(ctx context.Context, org string) (*github.ActionsAllowed, *github.Response, error) {
+	return a.f.GetActionsAllowed(ctx, org)
+}
+func (a *ActionsService) GetActionsAllowedInEnterprise(ctx context.Context, enterprise string) (*github.ActionsAllowed, *github.Response, error) {
+	return a.f.GetActionsAllowedInEnterprise(ctx, enterprise)
+}
+func (a *ActionsService) GetActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error) {
+	return a.f.GetActionsPermissions(ctx, org)
+}
+func (a *ActionsService) GetActionsPermissionsInEnterprise(ctx context.Context, enterprise string) (*github.ActionsPermissionsEnterprise, *github.Response, error) {
+	return a.f.GetActionsPermissionsInEnterprise(ctx, enterprise)
+}
+func (a *ActionsService) GetArtifact(ctx context.Context, owner string, repo string, artifactID int64) (*github.Artifact, *github.Response, error) {
+	return a.f.GetArtifact(ctx, owner, repo, artifactID)
+}
+func (a *ActionsService) GetCacheUsageForRepo(ctx context.Context, owner string, repo string) (*github.ActionsCacheUsage, *github.Response, error) {
+	return a.f.GetCacheUsageForRepo(ctx, owner, repo)
+}
+func (a *ActionsService) GetDefaultWorkflowPermissionsInEnterprise(ctx context.Context, enterprise string) (*github.DefaultWorkflowPermissionEnterprise, *github.Response, error) {
+	return a.f.GetDefaultWorkflowPermissionsInEnterprise(ctx, enterprise)
+}
+func (a *ActionsService) GetDefaultWorkflowPermissionsInOrganization(ctx context.Context, org string) (*github.DefaultWorkflowPermissionOrganization, *github.Response, error) {
+	return a.f.GetDefaultWorkflowPermissionsInOrganization(ctx, org)
+}
+func (a *ActionsService) GetEnvPublicKey(ctx context.Context, repoID int, env string) (*github.PublicKey, *github.Response, error) {
+	return a.f.GetEnvPublicKey(ctx, repoID, env)
+}
+func (a *ActionsService) GetEnvSecret(ctx context.Context, repoID int, env string, secretName string) (*github.Secret, *github.Response, error) {
+	return a.f.GetEnvSecret(ctx, repoID, env, secretName)
+}
+func (a *ActionsService) GetEnvVariable(ctx context.Context, owner string, repo string, env string, variableName string) (*github.ActionsVariable, *github.Response, error) {
+	return a.f.GetEnvVariable(ctx, owner, repo, env, variableName)
+}
+func (a *ActionsService) GetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error) {
+	return a.f.GetOrgOIDCSubjectClaimCustomTemplate(ctx, org)
+}
+func (a *ActionsService) GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return a.f.GetOrgPublicKey(ctx, org)
+}
+func (a *ActionsService) GetOrgSecret(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error) {
+	return a.f.GetOrgSecret(ctx, org, name)
+}
+func (a *ActionsService) GetOrgVariable(ctx context.Context, org string, name string) (*github.ActionsVariable, *github.Response, error) {
+	return a.f.GetOrgVariable(ctx, org, name)
+}
+func (a *ActionsService) GetOrganizationRunner(ctx context.Context, org string, runnerID int64) (*github.Runner, *github.Response, error) {
+	return a.f.GetOrganizationRunner(ctx, org, runnerID)
+}
+func (a *ActionsService) GetOrganizationRunnerGroup(ctx context.Context, org string, groupID int64) (*github.RunnerGroup, *github.Response, error) {
+	return a.f.GetOrganizationRunnerGroup(ctx, org, groupID)
+}
+func (a *ActionsService) GetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner string, repo string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error) {
+	return a.f.GetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repo)
+}
+func (a *ActionsService) GetRepoPublicKey(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error) {
+	return a.f.GetRepoPublicKey(ctx, owner, repo)
+}
+func (a *ActionsService) GetRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error) {
+	return a.f.GetRepoSecret(ctx, owner, repo, name)
+}
+func (a *ActionsService) GetRepoVariable(ctx context.Context, owner string, repo string, name string) (*github.ActionsVariable, *github.Response, error) {
+	return a.f.GetRepoVariable(ctx, owner, repo, name)
+}
+func (a *ActionsService) GetRequiredWorkflowByID(ctx context.Context, owner string, requiredWorkflowID int64) (*github.OrgRequiredWorkflow, *github.Response, error) {
+	return a.f.GetRequiredWorkflowByID(ctx, owner, requiredWorkflowID)
+}
+func (a *ActionsService) GetRunner(ctx context.Context, owner string, repo string, runnerID int64) (*github.Runner, *github.Response, error) {
+	return a.f.GetRunner(ctx, owner, repo, runnerID)
+}
+func (a *ActionsService) GetTotalCacheUsageForEnterprise(ctx context.Context, enterprise string) (*github.TotalCacheUsage, *github.Response, error) {
+	return a.f.GetTotalCacheUsageForEnterprise(ctx, enterprise)
+}
+func (a *ActionsService) GetTotalCacheUsageForOrg(ctx context.Context, org string) (*github.TotalCacheUsage, *github.Response, error) {
+	return a.f.GetTotalCacheUsageForOrg(ctx, org)
+}
+func (a *ActionsService) GetWorkflowByFileName(ctx context.Context, owner string, repo string, workflowFileName string) (*github.Workflow, *github.Response, error) {
+	return a.f.GetWorkflowByFileName(ctx, owner, repo, workflowFileName)
+}
+func (a *ActionsService) GetWorkflowByID(ctx context.Context, owner string, repo string, workflowID int64) (*github.Workflow, *github.Response, error) {
+	return a.f.GetWorkflowByID(ctx, owner, repo, workflowID)
+}
+func (a *ActionsService) GetWorkflowJobByID(ctx context.Context, owner string, repo string, jobID int64) (*github.WorkflowJob, *github.Response, error) {
+	return a.f.GetWorkflowJobByID(ctx, owner, repo, jobID)
+}
+func (a *ActionsService) GetWorkflowJobLogs(ctx context.Context, owner string, repo string, jobID int64, maxRedirects int) (*url.URL, *github.Response, error) {
+	return a.f.GetWorkflowJobLogs(ctx, owner, repo, jobID, maxRedirects)
+}
+func (a *ActionsService) GetWorkflowRunAttempt(ctx context.Context, owner string, repo string, runID int64, attemptNumber int, opts *github.WorkflowRunAttemptOptions) (*github.WorkflowRun, *github.Response, error) {
+	return a.f.GetWorkflowRunAttempt(ctx, owner, repo, runID, attemptNumber, opts)
+}
+func (a *ActionsService) GetWorkflowRunAttemptLogs(ctx context.Context, owner string, repo string, runID int64, attemptNumber int, maxRedirects int) (*url.URL, *github.Response, error) {
+	return a.f.GetWorkflowRunAttemptLogs(ctx, owner, repo, runID, attemptNumber, maxRedirects)
+}
+func (a *ActionsService) GetWorkflowRunByID(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRun, *github.Response, error) {
+	return a.f.GetWorkflowRunByID(ctx, owner, repo, runID)
+}
+func (a *ActionsService) GetWorkflowRunLogs(ctx context.Context, owner string, repo string, runID int64, maxRedirects int) (*url.URL, *github.Response, error) {
+	return a.f.GetWorkflowRunLogs(ctx, owner, repo, runID, maxRedirects)
+}
+func (a *ActionsService) GetWorkflowRunUsageByID(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunUsage, *github.Response, error) {
+	return a.f.GetWorkflowRunUsageByID(ctx, owner, repo, runID)
+}
+func (a *ActionsService) GetWorkflowUsageByFileName(ctx context.Context, owner string, repo string, workflowFileName string) (*github.WorkflowUsage, *github.Response, error) {
+	return a.f.GetWorkflowUsageByFileName(ctx, owner, repo, workflowFileName)
+}
+func (a *ActionsService) GetWorkflowUsageByID(ctx context.Context, owner string, repo string, workflowID int64) (*github.WorkflowUsage, *github.Response, error) {
+	return a.f.GetWorkflowUsageByID(ctx, owner, repo, workflowID)
+}
+func (a *ActionsService) ListArtifacts(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ArtifactList, *github.Response, error) {
+	return a.f.ListArtifacts(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListCacheUsageByRepoForOrg(ctx context.Context, org string, opts *github.ListOptions) (*github.ActionsCacheUsageList, *github.Response, error) {
+	return a.f.ListCacheUsageByRepoForOrg(ctx, org, opts)
+}
+func (a *ActionsService) ListCaches(ctx context.Context, owner string, repo string, opts *github.ActionsCacheListOptions) (*github.ActionsCacheList, *github.Response, error) {
+	return a.f.ListCaches(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListEnabledOrgsInEnterprise(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnEnterpriseRepos, *github.Response, error) {
+	return a.f.ListEnabledOrgsInEnterprise(ctx, owner, opts)
+}
+func (a *ActionsService) ListEnabledReposInOrg(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+	return a.f.ListEnabledReposInOrg(ctx, owner, opts)
+}
+func (a *ActionsService) ListEnvSecrets(ctx context.Context, repoID int, env string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return a.f.ListEnvSecrets(ctx, repoID, env, opts)
+}
+func (a *ActionsService) ListEnvVariables(ctx context.Context, owner string, repo string, env string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error) {
+	return a.f.ListEnvVariables(ctx, owner, repo, env, opts)
+}
+func (a *ActionsService) ListOrgRequiredWorkflows(ctx context.Context, org string, opts *github.ListOptions) (*github.OrgRequiredWorkflows, *github.Response, error) {
+	return a.f.ListOrgRequiredWorkflows(ctx, org, opts)
+}
+func (a *ActionsService) ListOrgSecrets(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return a.f.ListOrgSecrets(ctx, org, opts)
+}
+func (a *ActionsService) ListOrgVariables(ctx context.Context, org string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error) {
+	return a.f.ListOrgVariables(ctx, org, opts)
+}
+func (a *ActionsService) ListOrganizationRunnerApplicationDownloads(ctx context.Context, org string) ([]*github.RunnerApplicationDownload, *github.Response, error) {
+	return a.f.ListOrganizationRunnerApplicationDownloads(ctx, org)
+}
+func (a *ActionsService) ListOrganizationRunnerGroups(ctx context.Context, org string, opts *github.ListOrgRunnerGroupOptions) (*github.RunnerGroups, *github.Response, error) {
+	return a.f.ListOrganizationRunnerGroups(ctx, org, opts)
+}
+func (a *ActionsService) ListOrganizationRunners(ctx context.Context, org string, opts *github.ListRunnersOptions) (*github.Runners, *github.Response, error) {
+	return a.f.ListOrganizationRunners(ctx, org, opts)
+}
+func (a *ActionsService) ListRepoOrgSecrets(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return a.f.ListRepoOrgSecrets(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRepoOrgVariables(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error) {
+	return a.f.ListRepoOrgVariables(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRepoRequiredWorkflows(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.RepoRequiredWorkflows, *github.Response, error) {
+	return a.f.ListRepoRequiredWorkflows(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRepoSecrets(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return a.f.ListRepoSecrets(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRepoVariables(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ActionsVariables, *github.Response, error) {
+	return a.f.ListRepoVariables(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error) {
+	return a.f.ListRepositoryAccessRunnerGroup(ctx, org, groupID, opts)
+}
+func (a *ActionsService) ListRepositoryWorkflowRuns(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+	return a.f.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, opts *github.ListOptions) (*github.RequiredWorkflowSelectedRepos, *github.Response, error) {
+	return a.f.ListRequiredWorkflowSelectedRepos(ctx, org, requiredWorkflowID, opts)
+}
+func (a *ActionsService) ListRunnerApplicationDownloads(ctx context.Context, owner string, repo string) ([]*github.RunnerApplicationDownload, *github.Response, error) {
+	return a.f.ListRunnerApplicationDownloads(ctx, owner, repo)
+}
+func (a *ActionsService) ListRunnerGroupRunners(ctx context.Context, org string, groupID int64, opts *github.ListOptions) (*github.Runners, *github.Response, error) {
+	return a.f.ListRunnerGroupRunners(ctx, org, groupID, opts)
+}
+func (a *ActionsService) ListRunners(ctx context.Context, owner string, repo string, opts *github.ListRunnersOptions) (*github.Runners, *github.Response, error) {
+	return a.f.ListRunners(ctx, owner, repo, opts)
+}
+func (a *ActionsService) ListSelectedReposForOrgSecret(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return a.f.ListSelectedReposForOrgSecret(ctx, org, name, opts)
+}
+func (a *ActionsService) ListSelectedReposForOrgVariable(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return a.f.ListSelectedReposForOrgVariable(ctx, org, name, opts)
+}
+func (a *ActionsService) ListWorkflowJobs(ctx context.Context, owner string, repo string, runID int64, opts *github.ListWorkflowJobsOptions) (*github.Jobs, *github.Response, error) {
+	return a.f.ListWorkflowJobs(ctx, owner, repo, runID, opts)
+}
+func (a *ActionsService) ListWorkflowJobsAttempt(ctx context.Context, owner string, repo string, runID int64, attemptNumber int64, opts *github.ListOptions) (*github.Jobs, *github.Response, error) {
+	return a.f.ListWorkflowJobsAttempt(ctx, owner, repo, runID, attemptNumber, opts)
+}
+func (a *ActionsService) ListWorkflowRunArtifacts(ctx context.Context, owner string, repo string, runID int64, opts *github.ListOptions) (*github.ArtifactList, *github.Response, error) {
+	return a.f.ListWorkflowRunArtifacts(ctx, owner, repo, runID, opts)
+}
+func (a *ActionsService) ListWorkflowRunsByFileName(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+	return a.f.ListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, opts)
+}
+func (a *ActionsService) ListWorkflowRunsByID(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+	return a.f.ListWorkflowRunsByID(ctx, owner, repo, workflowID, opts)
+}
+func (a *ActionsService) ListWorkflows(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Workflows, *github.Response, error) {
+	return a.f.ListWorkflows(ctx, owner, repo, opts)
+}
+func (a *ActionsService) PendingDeployments(ctx context.Context, owner string, repo string, runID int64, request *github.PendingDeploymentsRequest) ([]*github.Deployment, *github.Response, error) {
+	return a.f.PendingDeployments(ctx, owner, repo, runID, request)
+}
+func (a *ActionsService) RemoveEnabledOrgInEnterprise(ctx context.Context, owner string, organizationID int64) (*github.Response, error) {
+	return a.f.RemoveEnabledOrgInEnterprise(ctx, owner, organizationID)
+}
+func (a *ActionsService) RemoveEnabledReposInOrg(ctx context.Context, owner string, repositoryID int64) (*github.Response, error) {
+	return a.f.RemoveEnabledReposInOrg(ctx, owner, repositoryID)
+}
+func (a *ActionsService) RemoveOrganizationRunner(ctx context.Context, org string, runnerID int64) (*github.Response, error) {
+	return a.f.RemoveOrganizationRunner(ctx, org, runnerID)
+}
+func (a *ActionsService) RemoveRepoFromRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, repoID int64) (*github.Response, error) {
+	return a.f.RemoveRepoFromRequiredWorkflow(ctx, org, requiredWorkflowID, repoID)
+}
+func (a *ActionsService) RemoveRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, repoID int64) (*github.Response, error) {
+	return a.f.RemoveRepositoryAccessRunnerGroup(ctx, org, groupID, repoID)
+}
+func (a *ActionsService) RemoveRunner(ctx context.Context, owner string, repo string, runnerID int64) (*github.Response, error) {
+	return a.f.RemoveRunner(ctx, owner, repo, runnerID)
+}
+func (a *ActionsService) RemoveRunnerGroupRunners(ctx context.Context, org string, groupID int64, runnerID int64) (*github.Response, error) {
+	return a.f.RemoveRunnerGroupRunners(ctx, org, groupID, runnerID)
+}
+func (a *ActionsService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return a.f.RemoveSelectedRepoFromOrgSecret(ctx, org, name, repo)
+}
+func (a *ActionsService) RemoveSelectedRepoFromOrgVariable(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return a.f.RemoveSelectedRepoFromOrgVariable(ctx, org, name, repo)
+}
+func (a *ActionsService) RerunFailedJobsByID(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error) {
+	return a.f.RerunFailedJobsByID(ctx, owner, repo, runID)
+}
+func (a *ActionsService) RerunJobByID(ctx context.Context, owner string, repo string, jobID int64) (*github.Response, error) {
+	return a.f.RerunJobByID(ctx, owner, repo, jobID)
+}
+func (a *ActionsService) RerunWorkflowByID(ctx context.Context, owner string, repo string, runID int64) (*github.Response, error) {
+	return a.f.RerunWorkflowByID(ctx, owner, repo, runID)
+}
+func (a *ActionsService) SetEnabledOrgsInEnterprise(ctx context.Context, owner string, organizationIDs []int64) (*github.Response, error) {
+	return a.f.SetEnabledOrgsInEnterprise(ctx, owner, organizationIDs)
+}
+func (a *ActionsService) SetEnabledReposInOrg(ctx context.Context, owner string, repositoryIDs []int64) (*github.Response, error) {
+	return a.f.SetEnabledReposInOrg(ctx, owner, repositoryIDs)
+}
+func (a *ActionsService) SetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error) {
+	return a.f.SetOrgOIDCSubjectClaimCustomTemplate(ctx, org, template)
+}
+func (a *ActionsService) SetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner string, repo string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error) {
+	return a.f.SetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repo, template)
+}
+func (a *ActionsService) SetRepositoryAccessRunnerGroup(ctx context.Context, org string, groupID int64, ids github.SetRepoAccessRunnerGroupRequest) (*github.Response, error) {
+	return a.f.SetRepositoryAccessRunnerGroup(ctx, org, groupID, ids)
+}
+func (a *ActionsService) SetRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return a.f.SetRequiredWorkflowSelectedRepos(ctx, org, requiredWorkflowID, ids)
+}
+func (a *ActionsService) SetRunnerGroupRunners(ctx context.Context, org string, groupID int64, ids github.SetRunnerGroupRunnersRequest) (*github.Response, error) {
+	return a.f.SetRunnerGroupRunners(ctx, org, groupID, ids)
+}
+func (a *ActionsService) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return a.f.SetSelectedReposForOrgSecret(ctx, org, name, ids)
+}
+func (a *ActionsService) SetSelectedReposForOrgVariable(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return a.f.SetSelectedReposForOrgVariable(ctx, org, name, ids)
+}
+func (a *ActionsService) UpdateEnvVariable(ctx context.Context, owner string, repo string, env string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.UpdateEnvVariable(ctx, owner, repo, env, variable)
+}
+func (a *ActionsService) UpdateOrgVariable(ctx context.Context, org string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.UpdateOrgVariable(ctx, org, variable)
+}
+func (a *ActionsService) UpdateOrganizationRunnerGroup(ctx context.Context, org string, groupID int64, updateReq github.UpdateRunnerGroupRequest) (*github.RunnerGroup, *github.Response, error) {
+	return a.f.UpdateOrganizationRunnerGroup(ctx, org, groupID, updateReq)
+}
+func (a *ActionsService) UpdateRepoVariable(ctx context.Context, owner string, repo string, variable *github.ActionsVariable) (*github.Response, error) {
+	return a.f.UpdateRepoVariable(ctx, owner, repo, variable)
+}
+func (a *ActionsService) UpdateRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, updateRequiredWorkflowOptions *github.CreateUpdateRequiredWorkflowOptions) (*github.OrgRequiredWorkflow, *github.Response, error) {
+	return a.f.UpdateRequiredWorkflow(ctx, org, requiredWorkflowID, updateRequiredWorkflowOptions)
+}
+
+func (a *ActionsService) MapRepositoryWorkflowRuns(ctx context.Context, owner string, repo string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return a.f.MapRepositoryWorkflowRuns(ctx, owner, repo, opts, handle, pageOpts...)
+}
+func (a *ActionsService) MapWorkflowRunsByFileName(ctx context.Context, owner string, repo string, workflowFileName string, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return a.f.MapWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, opts, handle, pageOpts...)
+}
+func (a *ActionsService) MapWorkflowRunsByID(ctx context.Context, owner string, repo string, workflowID int64, opts *github.ListWorkflowRunsOptions, handle WorkflowRunHandler, pageOpts ...PaginatorOption) error {
+	return a.f.MapWorkflowRunsByID(ctx, owner, repo, workflowID, opts, handle, pageOpts...)
+}
+
+// NewActionsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewActionsService(f *ActionsServiceF) *ActionsService {
+	var table ActionsServiceF
+	if f != nil {
+		table = *f
+	}
+	a := &ActionsService{f: &table}
+	if table.MapRepositoryWorkflowRuns == nil {
+		table.MapRepositoryWorkflowRuns = newMapRepositoryWorkflowRunsF(a)
+	}
+	if table.MapWorkflowRunsByFileName == nil {
+		table.MapWorkflowRunsByFileName = newMapWorkflowRunsByFileNameF(a)
+	}
+	if table.MapWorkflowRunsByID == nil {
+		table.MapWorkflowRunsByID = newMapWorkflowRunsByIDF(a)
+	}
+	return a
+}
+
+func newActionsServicePassthrough(client *github.Client) *ActionsService {
+	return NewActionsService(&ActionsServiceF{
+		AddEnabledOrgInEnterprise:                    client.Actions.AddEnabledOrgInEnterprise,
+		AddEnabledReposInOrg:                         client.Actions.AddEnabledReposInOrg,
+		AddRepoToRequiredWorkflow:                    client.Actions.AddRepoToRequiredWorkflow,
+		AddRepositoryAccessRunnerGroup:               client.Actions.AddRepositoryAccessRunnerGroup,
+		AddRunnerGroupRunners:                        client.Actions.AddRunnerGroupRunners,
+		AddSelectedRepoToOrgSecret:                   client.Actions.AddSelectedRepoToOrgSecret,
+		AddSelectedRepoToOrgVariable:                 client.Actions.AddSelectedRepoToOrgVariable,
+		CancelWorkflowRunByID:                        client.Actions.CancelWorkflowRunByID,
+		CreateEnvVariable:                            client.Actions.CreateEnvVariable,
+		CreateOrUpdateEnvSecret:                      client.Actions.CreateOrUpdateEnvSecret,
+		CreateOrUpdateOrgSecret:                      client.Actions.CreateOrUpdateOrgSecret,
+		CreateOrUpdateRepoSecret:                     client.Actions.CreateOrUpdateRepoSecret,
+		CreateOrgVariable:                            client.Actions.CreateOrgVariable,
+		CreateOrganizationRegistrationToken:          client.Actions.CreateOrganizationRegistrationToken,
+		CreateOrganizationRemoveToken:                client.Actions.CreateOrganizationRemoveToken,
+		CreateOrganizationRunnerGroup:                client.Actions.CreateOrganizationRunnerGroup,
+		CreateRegistrationToken:                      client.Actions.CreateRegistrationToken,
+		CreateRemoveToken:                            client.Actions.CreateRemoveToken,
+		CreateRepoVariable:                           client.Actions.CreateRepoVariable,
+		CreateRequiredWorkflow:                       client.Actions.CreateRequiredWorkflow,
+		CreateWorkflowDispatchEventByFileName:        client.Actions.CreateWorkflowDispatchEventByFileName,
+		CreateWorkflowDispatchEventByID:              client.Actions.CreateWorkflowDispatchEventByID,
+		DeleteArtifact:                               client.Actions.DeleteArtifact,
+		DeleteCachesByID:                             client.Actions.DeleteCachesByID,
+		DeleteCachesByKey:                            client.Actions.DeleteCachesByKey,
+		DeleteEnvSecret:                              client.Actions.DeleteEnvSecret,
+		DeleteEnvVariable:                            client.Actions.DeleteEnvVariable,
+		DeleteOrgSecret:                              client.Actions.DeleteOrgSecret,
+		DeleteOrgVariable:                            client.Actions.DeleteOrgVariable,
+		DeleteOrganizationRunnerGroup:                client.Actions.DeleteOrganizationRunnerGroup,
+		DeleteRepoSecret:                             client.Actions.DeleteRepoSecret,
+		DeleteRepoVariable:                           client.Actions.DeleteRepoVariable,
+		DeleteRequiredWorkflow:                       client.Actions.DeleteRequiredWorkflow,
+		DeleteWorkflowRun:                            client.Actions.DeleteWorkflowRun,
+		DeleteWorkflowRunLogs:                        client.Actions.DeleteWorkflowRunLogs,
+		DisableWorkflowByFileName:                    client.Actions.DisableWorkflowByFileName,
+		DisableWorkflowByID:                          client.Actions.DisableWorkflowByID,
+		DownloadArtifact:                             client.Actions.DownloadArtifact,
+		EditActionsAllowed:                           client.Actions.EditActionsAllowed,
+		EditActionsAllowedInEnterprise:               client.Actions.EditActionsAllowedInEnterprise,
+		EditActionsPermissions:                       client.Actions.EditActionsPermissions,
+		EditActionsPermissionsInEnterprise:           client.Actions.EditActionsPermissionsInEnterprise,
+		EditDefaultWorkflowPermissionsInEnterprise:   client.Actions.EditDefaultWorkflowPermissionsInEnterprise,
+		EditDefaultWorkflowPermissionsInOrganization: client.Actions.EditDefaultWorkflowPermissionsInOrganization,
+		EnableWorkflowByFileName:                     client.Actions.EnableWorkflowByFileName,
+		EnableWorkflowByID:                           client.Actions.EnableWorkflowByID,
+		GenerateOrgJITConfig:                         client.Actions.GenerateOrgJITConfig,
+		GenerateRepoJITConfig:                        client.Actions.GenerateRepoJITConfig,
+		GetActionsAllowed:                            client.Actions.GetActionsAllowed,
+		GetActionsAllowedInEnterprise:                client.Actions.GetActionsAllowedInEnterprise,
+		GetActionsPermissions:                        client.Actions.GetActionsPermissions,
+		GetActionsPermissionsInEnterprise:            client.Actions.GetActionsPermissionsInEnterprise,
+		GetArtifact:                                  client.Actions.GetArtifact,
+		GetCacheUsageForRepo:                         client.Actions.GetCacheUsageForRepo,
+		GetDefaultWorkflowPermissionsInEnterprise:    client.Actions.GetDefaultWorkflowPermissionsInEnterprise,
+		GetDefaultWorkflowPermissionsInOrganization:  client.Actions.GetDefaultWorkflowPermissionsInOrganization,
+		GetEnvPublicKey:                              client.Actions.GetEnvPublicKey,
+		GetEnvSecret:                                 client.Actions.GetEnvSecret,
+		GetEnvVariable:                               client.Actions.GetEnvVariable,
+		GetOrgOIDCSubjectClaimCustomTemplate:         client.Actions.GetOrgOIDCSubjectClaimCustomTemplate,
+		GetOrgPublicKey:                              client.Actions.GetOrgPublicKey,
+		GetOrgSecret:                                 client.Actions.GetOrgSecret,
+		GetOrgVariable:                               client.Actions.GetOrgVariable,
+		GetOrganizationRunner:                        client.Actions.GetOrganizationRunner,
+		GetOrganizationRunnerGroup:                   client.Actions.GetOrganizationRunnerGroup,
+		GetRepoOIDCSubjectClaimCustomTemplate:        client.Actions.GetRepoOIDCSubjectClaimCustomTemplate,
+		GetRepoPublicKey:                             client.Actions.GetRepoPublicKey,
+		GetRepoSecret:                                client.Actions.GetRepoSecret,
+		GetRepoVariable:                              client.Actions.GetRepoVariable,
+		GetRequiredWorkflowByID:                      client.Actions.GetRequiredWorkflowByID,
+		GetRunner:                                    client.Actions.GetRunner,
+		GetTotalCacheUsageForEnterprise:              client.Actions.GetTotalCacheUsageForEnterprise,
+		GetTotalCacheUsageForOrg:                     client.Actions.GetTotalCacheUsageForOrg,
+		GetWorkflowByFileName:                        client.Actions.GetWorkflowByFileName,
+		GetWorkflowByID:                              client.Actions.GetWorkflowByID,
+		GetWorkflowJobByID:                           client.Actions.GetWorkflowJobByID,
+		GetWorkflowJobLogs:                           client.Actions.GetWorkflowJobLogs,
+		GetWorkflowRunAttempt:                        client.Actions.GetWorkflowRunAttempt,
+		GetWorkflowRunAttemptLogs:                    client.Actions.GetWorkflowRunAttemptLogs,
+		GetWorkflowRunByID:                           client.Actions.GetWorkflowRunByID,
+		GetWorkflowRunLogs:                           client.Actions.GetWorkflowRunLogs,
+		GetWorkflowRunUsageByID:                      client.Actions.GetWorkflowRunUsageByID,
+		GetWorkflowUsageByFileName:                   client.Actions.GetWorkflowUsageByFileName,
+		GetWorkflowUsageByID:                         client.Actions.GetWorkflowUsageByID,
+		ListArtifacts:                                client.Actions.ListArtifacts,
+		ListCacheUsageByRepoForOrg:                   client.Actions.ListCacheUsageByRepoForOrg,
+		ListCaches:                                   client.Actions.ListCaches,
+		ListEnabledOrgsInEnterprise:                  client.Actions.ListEnabledOrgsInEnterprise,
+		ListEnabledReposInOrg:                        client.Actions.ListEnabledReposInOrg,
+		ListEnvSecrets:                               client.Actions.ListEnvSecrets,
+		ListEnvVariables:                             client.Actions.ListEnvVariables,
+		ListOrgRequiredWorkflows:                     client.Actions.ListOrgRequiredWorkflows,
+		ListOrgSecrets:                               client.Actions.ListOrgSecrets,
+		ListOrgVariables:                             client.Actions.ListOrgVariables,
+		ListOrganizationRunnerApplicationDownloads:   client.Actions.ListOrganizationRunnerApplicationDownloads,
+		ListOrganizationRunnerGroups:                 client.Actions.ListOrganizationRunnerGroups,
+		ListOrganizationRunners:                      client.Actions.ListOrganizationRunners,
+		ListRepoOrgSecrets:                           client.Actions.ListRepoOrgSecrets,
+		ListRepoOrgVariables:                         client.Actions.ListRepoOrgVariables,
+		ListRepoRequiredWorkflows:                    client.Actions.ListRepoRequiredWorkflows,
+		ListRepoSecrets:                              client.Actions.ListRepoSecrets,
+		ListRepoVariables:                            client.Actions.ListRepoVariables,
+		ListRepositoryAccessRunnerGroup:              client.Actions.ListRepositoryAccessRunnerGroup,
+		ListRepositoryWorkflowRuns:                   client.Actions.ListRepositoryWorkflowRuns,
+		ListRequiredWorkflowSelectedRepos:            client.Actions.ListRequiredWorkflowSelectedRepos,
+		ListRunnerApplicationDownloads:               client.Actions.ListRunnerApplicationDownloads,
+		ListRunnerGroupRunners:                       client.Actions.ListRunnerGroupRunners,
+		ListRunners:                                  client.Actions.ListRunners,
+		ListSelectedReposForOrgSecret:                client.Actions.ListSelectedReposForOrgSecret,
+		ListSelectedReposForOrgVariable:              client.Actions.ListSelectedReposForOrgVariable,
+		ListWorkflowJobs:                             client.Actions.ListWorkflowJobs,
+		ListWorkflowJobsAttempt:                      client.Actions.ListWorkflowJobsAttempt,
+		ListWorkflowRunArtifacts:                     client.Actions.ListWorkflowRunArtifacts,
+		ListWorkflowRunsByFileName:                   client.Actions.ListWorkflowRunsByFileName,
+		ListWorkflowRunsByID:                         client.Actions.ListWorkflowRunsByID,
+		ListWorkflows:                                client.Actions.ListWorkflows,
+		PendingDeployments:                           client.Actions.PendingDeployments,
+		RemoveEnabledOrgInEnterprise:                 client.Actions.RemoveEnabledOrgInEnterprise,
+		RemoveEnabledReposInOrg:                      client.Actions.RemoveEnabledReposInOrg,
+		RemoveOrganizationRunner:                     client.Actions.RemoveOrganizationRunner,
+		RemoveRepoFromRequiredWorkflow:               client.Actions.RemoveRepoFromRequiredWorkflow,
+		RemoveRepositoryAccessRunnerGroup:            client.Actions.RemoveRepositoryAccessRunnerGroup,
+		RemoveRunner:                                 client.Actions.RemoveRunner,
+		RemoveRunnerGroupRunners:                     client.Actions.RemoveRunnerGroupRunners,
+		RemoveSelectedRepoFromOrgSecret:              client.Actions.RemoveSelectedRepoFromOrgSecret,
+		RemoveSelectedRepoFromOrgVariable:            client.Actions.RemoveSelectedRepoFromOrgVariable,
+		RerunFailedJobsByID:                          client.Actions.RerunFailedJobsByID,
+		RerunJobByID:                                 client.Actions.RerunJobByID,
+		RerunWorkflowByID:                            client.Actions.RerunWorkflowByID,
+		SetEnabledOrgsInEnterprise:                   client.Actions.SetEnabledOrgsInEnterprise,
+		SetEnabledReposInOrg:                         client.Actions.SetEnabledReposInOrg,
+		SetOrgOIDCSubjectClaimCustomTemplate:         client.Actions.SetOrgOIDCSubjectClaimCustomTemplate,
+		SetRepoOIDCSubjectClaimCustomTemplate:        client.Actions.SetRepoOIDCSubjectClaimCustomTemplate,
+		SetRepositoryAccessRunnerGroup:               client.Actions.SetRepositoryAccessRunnerGroup,
+		SetRequiredWorkflowSelectedRepos:             client.Actions.SetRequiredWorkflowSelectedRepos,
+		SetRunnerGroupRunners:                        client.Actions.SetRunnerGroupRunners,
+		SetSelectedReposForOrgSecret:                 client.Actions.SetSelectedReposForOrgSecret,
+		SetSelectedReposForOrgVariable:               client.Actions.SetSelectedReposForOrgVariable,
+		UpdateEnvVariable:                            client.Actions.UpdateEnvVariable,
+		UpdateOrgVariable:                            client.Actions.UpdateOrgVariable,
+		UpdateOrganizationRunnerGroup:                client.Actions.UpdateOrganizationRunnerGroup,
+		UpdateRepoVariable:                           client.Actions.UpdateRepoVariable,
+		UpdateRequiredWorkflow:                       client.Actions.UpdateRequiredWorkflow,
+	})
+}
+
+type ActivityServiceF struct {
+	DeleteRepositorySubscription    func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	DeleteThreadSubscription        func(ctx context.Context, id string) (*github.Response, error)
+	GetRepositorySubscription       func(ctx context.Context, owner string, repo string) (*github.Subscription, *github.Response, error)
+	GetThread                       func(ctx context.Context, id string) (*github.Notification, *github.Response, error)
+	GetThreadSubscription           func(ctx context.Context, id string) (*github.Subscription, *github.Response, error)
+	IsStarred                       func(ctx context.Context, owner string, repo string) (bool, *github.Response, error)
+	ListEvents                      func(ctx context.Context, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListEventsForOrganization       func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListEventsForRepoNetwork        func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListEventsPerformedByUser       func(ctx context.Context, user string, publicOnly bool, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListEventsReceivedByUser        func(ctx context.Context, user string, publicOnly bool, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListFeeds                       func(ctx context.Context) (*github.Feeds, *github.Response, error)
+	ListIssueEventsForRepository    func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error)
+	ListNotifications               func(ctx context.Context, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error)
+	ListRepositoryEvents            func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListRepositoryNotifications     func(ctx context.Context, owner string, repo string, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error)
+	ListStargazers                  func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Stargazer, *github.Response, error)
+	ListStarred                     func(ctx context.Context, user string, opts *github.ActivityListStarredOptions) ([]*github.StarredRepository, *github.Response, error)
+	ListUserEventsForOrganization   func(ctx context.Context, org string, user string, opts *github.ListOptions) ([]*github.Event, *github.Response, error)
+	ListWatched                     func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
+	ListWatchers                    func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	MarkNotificationsRead           func(ctx context.Context, lastRead github.Timestamp) (*github.Response, error)
+	MarkRepositoryNotificationsRead func(ctx context.Context, owner string, repo string, lastRead github.Timestamp) (*github.Response, error)
+	MarkThreadRead                  func(ctx context.Context, id string) (*github.Response, error)
+	SetRepositorySubscription       func(ctx context.Context, owner string, repo string, subscription *github.Subscription) (*github.Subscription, *github.Response, error)
+	SetThreadSubscription           func(ctx context.Context, id string, subscription *github.Subscription) (*github.Subscription, *github.Response, error)
+	Star                            func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	Unstar                          func(ctx context.Context, owner string, repo string) (*github.Response, error)
+
+	MapNotifications func(ctx context.Context, opts *github.NotificationListOptions, handle NotificationHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+}
+
+type ActivityService struct {
+	f *ActivityServiceF
+}
+
+func (a *ActivityService) DeleteRepositorySubscription(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return a.f.DeleteRepositorySubscription(ctx, owner, repo)
+}
+func (a *ActivityService) DeleteThreadSubscription(ctx context.Context, id string) (*github.Response, error) {
+	return a.f.DeleteThreadSubscription(ctx, id)
+}
+func (a *ActivityService) GetRepositorySubscription(ctx context.Context, owner string, repo string) (*github.Subscription, *github.Response, error) {
+	return a.f.GetRepositorySubscription(ctx, owner, repo)
+}
+func (a *ActivityService) GetThread(ctx context.Context, id string) (*github.Notification, *github.Response, error) {
+	return a.f.GetThread(ctx, id)
+}
+func (a *ActivityService) GetThreadSubscription(ctx context.Context, id string) (*github.Subscription, *github.Response, error) {
+	return a.f.GetThreadSubscription(ctx, id)
+}
+func (a *ActivityService) IsStarred(ctx context.Context, owner string, repo string) (bool, *github.Response, error) {
+	return a.f.IsStarred(ctx, owner, repo)
+}
+func (a *ActivityService) ListEvents(ctx context.Context, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListEvents(ctx, opts)
+}
+func (a *ActivityService) ListEventsForOrganization(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListEventsForOrganization(ctx, org, opts)
+}
+func (a *ActivityService) ListEventsForRepoNetwork(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListEventsForRepoNetwork(ctx, owner, repo, opts)
+}
+func (a *ActivityService) ListEventsPerformedByUser(ctx context.Context, user string, publicOnly bool, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListEventsPerformedByUser(ctx, user, publicOnly, opts)
+}
+func (a *ActivityService) ListEventsReceivedByUser(ctx context.Context, user string, publicOnly bool, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListEventsReceivedByUser(ctx, user, publicOnly, opts)
+}
+func (a *ActivityService) ListFeeds(ctx context.Context) (*github.Feeds, *github.Response, error) {
+	return a.f.ListFeeds(ctx)
+}
+func (a *ActivityService) ListIssueEventsForRepository(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error) {
+	return a.f.ListIssueEventsForRepository(ctx, owner, repo, opts)
+}
+func (a *ActivityService) ListNotifications(ctx context.Context, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+	return a.f.ListNotifications(ctx, opts)
+}
+func (a *ActivityService) ListRepositoryEvents(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListRepositoryEvents(ctx, owner, repo, opts)
+}
+func (a *ActivityService) ListRepositoryNotifications(ctx context.Context, owner string, repo string, opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+	return a.f.ListRepositoryNotifications(ctx, owner, repo, opts)
+}
+func (a *ActivityService) ListStargazers(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Stargazer, *github.Response, error) {
+	return a.f.ListStargazers(ctx, owner, repo, opts)
+}
+func (a *ActivityService) ListStarred(ctx context.Context, user string, opts *github.ActivityListStarredOptions) ([]*github.StarredRepository, *github.Response, error) {
+	return a.f.ListStarred(ctx, user, opts)
+}
+func (a *ActivityService) ListUserEventsForOrganization(ctx context.Context, org string, user string, opts *github.ListOptions) ([]*github.Event, *github.Response, error) {
+	return a.f.ListUserEventsForOrganization(ctx, org, user, opts)
+}
+func (a *ActivityService) ListWatched(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	return a.f.ListWatched(ctx, user, opts)
+}
+func (a *ActivityService) ListWatchers(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return a.f.ListWatchers(ctx, owner, repo, opts)
+}
+func (a *ActivityService) MarkNotificationsRead(ctx context.Context, lastRead github.Timestamp) (*github.Response, error) {
+	return a.f.MarkNotificationsRead(ctx, lastRead)
+}
+func (a *ActivityService) MarkRepositoryNotificationsRead(ctx context.Context, owner string, repo string, lastRead github.Timestamp) (*github.Response, error) {
+	return a.f.MarkRepositoryNotificationsRead(ctx, owner, repo, lastRead)
+}
+func (a *ActivityService) MarkThreadRead(ctx context.Context, id string) (*github.Response, error) {
+	return a.f.MarkThreadRead(ctx, id)
+}
+func (a *ActivityService) SetRepositorySubscription(ctx context.Context, owner string, repo string, subscription *github.Subscription) (*github.Subscription, *github.Response, error) {
+	return a.f.SetRepositorySubscription(ctx, owner, repo, subscription)
+}
+func (a *ActivityService) SetThreadSubscription(ctx context.Context, id string, subscription *github.Subscription) (*github.Subscription, *github.Response, error) {
+	return a.f.SetThreadSubscription(ctx, id, subscription)
+}
+func (a *ActivityService) Star(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return a.f.Star(ctx, owner, repo)
+}
+func (a *ActivityService) Unstar(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return a.f.Unstar(ctx, owner, repo)
+}
+
+func (a *ActivityService) MapNotifications(ctx context.Context, opts *github.NotificationListOptions, handle NotificationHandler, pageOpts ...PaginatorOption) error {
+	return a.f.MapNotifications(ctx, opts, handle, pageOpts...)
+}
+
+// NewActivityService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewActivityService(f *ActivityServiceF) *ActivityService {
+	var table ActivityServiceF
+	if f != nil {
+		table = *f
+	}
+	a := &ActivityService{f: &table}
+	if table.MapNotifications == nil {
+		table.MapNotifications = newMapNotificationsF(a)
+	}
+	return a
+}
+
+func newActivityServicePassthrough(client *github.Client) *ActivityService {
+	return NewActivityService(&ActivityServiceF{
+		DeleteRepositorySubscription:    client.Activity.DeleteRepositorySubscription,
+		DeleteThreadSubscription:        client.Activity.DeleteThreadSubscription,
+		GetRepositorySubscription:       client.Activity.GetRepositorySubscription,
+		GetThread:                       client.Activity.GetThread,
+		GetThreadSubscription:           client.Activity.GetThreadSubscription,
+		IsStarred:                       client.Activity.IsStarred,
+		ListEvents:                      client.Activity.ListEvents,
+		ListEventsForOrganization:       client.Activity.ListEventsForOrganization,
+		ListEventsForRepoNetwork:        client.Activity.ListEventsForRepoNetwork,
+		ListEventsPerformedByUser:       client.Activity.ListEventsPerformedByUser,
+		ListEventsReceivedByUser:        client.Activity.ListEventsReceivedByUser,
+		ListFeeds:                       client.Activity.ListFeeds,
+		ListIssueEventsForRepository:    client.Activity.ListIssueEventsForRepository,
+		ListNotifications:               client.Activity.ListNotifications,
+		ListRepositoryEvents:            client.Activity.ListRepositoryEvents,
+		ListRepositoryNotifications:     client.Activity.ListRepositoryNotifications,
+		ListStargazers:                  client.Activity.ListStargazers,
+		ListStarred:                     client.Activity.ListStarred,
+		ListUserEventsForOrganization:   client.Activity.ListUserEventsForOrganization,
+		ListWatched:                     client.Activity.ListWatched,
+		ListWatchers:                    client.Activity.ListWatchers,
+		MarkNotificationsRead:           client.Activity.MarkNotificationsRead,
+		MarkRepositoryNotificationsRead: client.Activity.MarkRepositoryNotificationsRead,
+		MarkThreadRead:                  client.Activity.MarkThreadRead,
+		SetRepositorySubscription:       client.Activity.SetRepositorySubscription,
+		SetThreadSubscription:           client.Activity.SetThreadSubscription,
+		Star:                            client.Activity.Star,
+		Unstar:                          client.Activity.Unstar,
+	})
+}
+
+type AdminServiceF struct {
+	CreateOrg               func(ctx context.Context, org *github.Organization, admin string) (*github.Organization, *github.Response, error)
+	CreateUser              func(ctx context.Context, userReq github.CreateUserRequest) (*github.User, *github.Response, error)
+	CreateUserImpersonation func(ctx context.Context, username string, opts *github.ImpersonateUserOptions) (*github.UserAuthorization, *github.Response, error)
+	DeleteUser              func(ctx context.Context, username string) (*github.Response, error)
+	DeleteUserImpersonation func(ctx context.Context, username string) (*github.Response, error)
+	GetAdminStats           func(ctx context.Context) (*github.AdminStats, *github.Response, error)
+	RenameOrg               func(ctx context.Context, org *github.Organization, newName string) (*github.RenameOrgResponse, *github.Response, error)
+	RenameOrgByName         func(ctx context.Context, org string, newName string) (*github.RenameOrgResponse, *github.Response, error)
+	UpdateTeamLDAPMapping   func(ctx context.Context, team int64, mapping *github.TeamLDAPMapping) (*github.TeamLDAPMapping, *github.Response, error)
+	UpdateUserLDAPMapping   func(ctx context.Context, user string, mapping *github.UserLDAPMapping) (*github.UserLDAPMapping, *github.Response, error)
+}
+
+type AdminService struct {
+	f *AdminServiceF
+}
+
+func (a *AdminService) CreateOrg(ctx context.Context, org *github.Organization, admin string) (*github.Organization, *github.Response, error) {
+	return a.f.CreateOrg(ctx, org, admin)
+}
+func (a *AdminService) CreateUser(ctx context.Context, userReq github.CreateUserRequest) (*github.User, *github.Response, error) {
+	return a.f.CreateUser(ctx, userReq)
+}
+func (a *AdminService) CreateUserImpersonation(ctx context.Context, username string, opts *github.ImpersonateUserOptions) (*github.UserAuthorization, *github.Response, error) {
+	return a.f.CreateUserImpersonation(ctx, username, opts)
+}
+func (a *AdminService) DeleteUser(ctx context.Context, username string) (*github.Response, error) {
+	return a.f.DeleteUser(ctx, username)
+}
+func (a *AdminService) DeleteUserImpersonation(ctx context.Context, username string) (*github.Response, error) {
+	return a.f.DeleteUserImpersonation(ctx, username)
+}
+func (a *AdminService) GetAdminStats(ctx context.Context) (*github.AdminStats, *github.Response, error) {
+	return a.f.GetAdminStats(ctx)
+}
+func (a *AdminService) RenameOrg(ctx context.Context, org *github.Organization, newName string) (*github.RenameOrgResponse, *github.Response, error) {
+	return a.f.RenameOrg(ctx, org, newName)
+}
+func (a *AdminService) RenameOrgByName(ctx context.Context, org string, newName string) (*github.RenameOrgResponse, *github.Response, error) {
+	return a.f.RenameOrgByName(ctx, org, newName)
+}
+func (a *AdminService) UpdateTeamLDAPMapping(ctx context.Context, team int64, mapping *github.TeamLDAPMapping) (*github.TeamLDAPMapping, *github.Response, error) {
+	return a.f.UpdateTeamLDAPMapping(ctx, team, mapping)
+}
+func (a *AdminService) UpdateUserLDAPMapping(ctx context.Context, user string, mapping *github.UserLDAPMapping) (*github.UserLDAPMapping, *github.Response, error) {
+	return a.f.UpdateUserLDAPMapping(ctx, user, mapping)
+}
+
+// NewAdminService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewAdminService(f *AdminServiceF) *AdminService {
+	var table AdminServiceF
+	if f != nil {
+		table = *f
+	}
+	return &AdminService{f: &table}
+}
+
+func newAdminServicePassthrough(client *github.Client) *AdminService {
+	return NewAdminService(&AdminServiceF{
+		CreateOrg:               client.Admin.CreateOrg,
+		CreateUser:              client.Admin.CreateUser,
+		CreateUserImpersonation: client.Admin.CreateUserImpersonation,
+		DeleteUser:              client.Admin.DeleteUser,
+		DeleteUserImpersonation: client.Admin.DeleteUserImpersonation,
+		GetAdminStats:           client.Admin.GetAdminStats,
+		RenameOrg:               client.Admin.RenameOrg,
+		RenameOrgByName:         client.Admin.RenameOrgByName,
+		UpdateTeamLDAPMapping:   client.Admin.UpdateTeamLDAPMapping,
+		UpdateUserLDAPMapping:   client.Admin.UpdateUserLDAPMapping,
+	})
+}
+
+type AppsServiceF struct {
+	AddRepository                    func(ctx context.Context, instID int64, repoID int64) (*github.Repository, *github.Response, error)
+	CompleteAppManifest              func(ctx context.Context, code string) (*github.AppConfig, *github.Response, error)
+	CreateAttachment                 func(ctx context.Context, contentReferenceID int64, title string, body string) (*github.Attachment, *github.Response, error)
+	CreateInstallationToken          func(ctx context.Context, id int64, opts *github.InstallationTokenOptions) (*github.InstallationToken, *github.Response, error)
+	CreateInstallationTokenListRepos func(ctx context.Context, id int64, opts *github.InstallationTokenListRepoOptions) (*github.InstallationToken, *github.Response, error)
+	DeleteInstallation               func(ctx context.Context, id int64) (*github.Response, error)
+	FindOrganizationInstallation     func(ctx context.Context, org string) (*github.Installation, *github.Response, error)
+	FindRepositoryInstallation       func(ctx context.Context, owner string, repo string) (*github.Installation, *github.Response, error)
+	FindRepositoryInstallationByID   func(ctx context.Context, id int64) (*github.Installation, *github.Response, error)
+	FindUserInstallation             func(ctx context.Context, user string) (*github.Installation, *github.Response, error)
+	Get                              func(ctx context.Context, appSlug string) (*github.App, *github.Response, error)
+	GetHookConfig                    func(ctx context.Context) (*github.HookConfig, *github.Response, error)
+	GetHookDelivery                  func(ctx context.Context, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	GetInstallation                  func(ctx context.Context, id int64) (*github.Installation, *github.Response, error)
+	ListHookDeliveries               func(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
+	ListInstallationRequests         func(ctx context.Context, opts *github.ListOptions) ([]*github.InstallationRequest, *github.Response, error)
+	ListInstallations                func(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error)
+	ListRepos                        func(ctx context.Context, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error)
+	ListUserInstallations            func(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error)
+	ListUserRepos                    func(ctx context.Context, id int64, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error)
+	RedeliverHookDelivery            func(ctx context.Context, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	RemoveRepository                 func(ctx context.Context, instID int64, repoID int64) (*github.Response, error)
+	RevokeInstallationToken          func(ctx context.Context) (*github.Response, error)
+	SuspendInstallation              func(ctx context.Context, id int64) (*github.Response, error)
+	UnsuspendInstallation            func(ctx context.Context, id int64) (*github.Response, error)
+	UpdateHookConfig                 func(ctx context.Context, config *github.HookConfig) (*github.HookConfig, *github.Response, error)
+}
+
+type AppsService struct {
+	f *AppsServiceF
+}
+
+func (a *AppsService) AddRepository(ctx context.Context, instID int64, repoID int64) (*github.Repository, *github.Response, error) {
+	return a.f.AddRepository(ctx, instID, repoID)
+}
+func (a *AppsService) CompleteAppManifest(ctx context.Context, code string) (*github.AppConfig, *github.Response, error) {
+	return a.f.CompleteAppManifest(ctx, code)
+}
+func (a *AppsService) CreateAttachment(ctx context.Context, contentReferenceID int64, title string, body string) (*github.Attachment, *github.Response, error) {
+	return a.f.CreateAttachment(ctx, contentReferenceID, title, body)
+}
+func (a *AppsService) CreateInstallationToken(ctx context.Context, id int64, opts *github.InstallationTokenOptions) (*github.InstallationToken, *github.Response, error) {
+	return a.f.CreateInstallationToken(ctx, id, opts)
+}
+func (a *AppsService) CreateInstallationTokenListRepos(ctx context.Context, id int64, opts *github.InstallationTokenListRepoOptions) (*github.InstallationToken, *github.Response, error) {
+	return a.f.CreateInstallationTokenListRepos(ctx, id, opts)
+}
+func (a *AppsService) DeleteInstallation(ctx context.Context, id int64) (*github.Response, error) {
+	return a.f.DeleteInstallation(ctx, id)
+}
+func (a *AppsService) FindOrganizationInstallation(ctx context.Context, org string) (*github.Installation, *github.Response, error) {
+	return a.f.FindOrganizationInstallation(ctx, org)
+}
+func (a *AppsService) FindRepositoryInstallation(ctx context.Context, owner string, repo string) (*github.Installation, *github.Response, error) {
+	return a.f.FindRepositoryInstallation(ctx, owner, repo)
+}
+func (a *AppsService) FindRepositoryInstallationByID(ctx context.Context, id int64) (*github.Installation, *github.Response, error) {
+	return a.f.FindRepositoryInstallationByID(ctx, id)
+}
+func (a *AppsService) FindUserInstallation(ctx context.Context, user string) (*github.Installation, *github.Response, error) {
+	return a.f.FindUserInstallation(ctx, user)
+}
+func (a *AppsService) Get(ctx context.Context, appSlug string) (*github.App, *github.Response, error) {
+	return a.f.Get(ctx, appSlug)
+}
+func (a *AppsService) GetHookConfig(ctx context.Context) (*github.HookConfig, *github.Response, error) {
+	return a.f.GetHookConfig(ctx)
+}
+func (a *AppsService) GetHookDelivery(ctx context.Context, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return a.f.GetHookDelivery(ctx, deliveryID)
+}
+func (a *AppsService) GetInstallation(ctx context.Context, id int64) (*github.Installation, *github.Response, error) {
+	return a.f.GetInstallation(ctx, id)
+}
+func (a *AppsService) ListHookDeliveries(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	return a.f.ListHookDeliveries(ctx, opts)
+}
+func (a *AppsService) ListInstallationRequests(ctx context.Context, opts *github.ListOptions) ([]*github.InstallationRequest, *github.Response, error) {
+	return a.f.ListInstallationRequests(ctx, opts)
+}
+func (a *AppsService) ListInstallations(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error) {
+	return a.f.ListInstallations(ctx, opts)
+}
+func (a *AppsService) ListRepos(ctx context.Context, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error) {
+	return a.f.ListRepos(ctx, opts)
+}
+func (a *AppsService) ListUserInstallations(ctx context.Context, opts *github.ListOptions) ([]*github.Installation, *github.Response, error) {
+	return a.f.ListUserInstallations(ctx, opts)
+}
+func (a *AppsService) ListUserRepos(ctx context.Context, id int64, opts *github.ListOptions) (*github.ListRepositories, *github.Response, error) {
+	return a.f.ListUserRepos(ctx, id, opts)
+}
+func (a *AppsService) RedeliverHookDelivery(ctx context.Context, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return a.f.RedeliverHookDelivery(ctx, deliveryID)
+}
+func (a *AppsService) RemoveRepository(ctx context.Context, instID int64, repoID int64) (*github.Response, error) {
+	return a.f.RemoveRepository(ctx, instID, repoID)
+}
+func (a *AppsService) RevokeInstallationToken(ctx context.Context) (*github.Response, error) {
+	return a.f.RevokeInstallationToken(ctx)
+}
+func (a *AppsService) SuspendInstallation(ctx context.Context, id int64) (*github.Response, error) {
+	return a.f.SuspendInstallation(ctx, id)
+}
+func (a *AppsService) UnsuspendInstallation(ctx context.Context, id int64) (*github.Response, error) {
+	return a.f.UnsuspendInstallation(ctx, id)
+}
+func (a *AppsService) UpdateHookConfig(ctx context.Context, config *github.HookConfig) (*github.HookConfig, *github.Response, error) {
+	return a.f.UpdateHookConfig(ctx, config)
+}
+
+// NewAppsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewAppsService(f *AppsServiceF) *AppsService {
+	var table AppsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &AppsService{f: &table}
+}
+
+func newAppsServicePassthrough(client *github.Client) *AppsService {
+	return NewAppsService(&AppsServiceF{
+		AddRepository:                    client.Apps.AddRepository,
+		CompleteAppManifest:              client.Apps.CompleteAppManifest,
+		CreateAttachment:                 client.Apps.CreateAttachment,
+		CreateInstallationToken:          client.Apps.CreateInstallationToken,
+		CreateInstallationTokenListRepos: client.Apps.CreateInstallationTokenListRepos,
+		DeleteInstallation:               client.Apps.DeleteInstallation,
+		FindOrganizationInstallation:     client.Apps.FindOrganizationInstallation,
+		FindRepositoryInstallation:       client.Apps.FindRepositoryInstallation,
+		FindRepositoryInstallationByID:   client.Apps.FindRepositoryInstallationByID,
+		FindUserInstallation:             client.Apps.FindUserInstallation,
+		Get:                              client.Apps.Get,
+		GetHookConfig:                    client.Apps.GetHookConfig,
+		GetHookDelivery:                  client.Apps.GetHookDelivery,
+		GetInstallation:                  client.Apps.GetInstallation,
+		ListHookDeliveries:               client.Apps.ListHookDeliveries,
+		ListInstallationRequests:         client.Apps.ListInstallationRequests,
+		ListInstallations:                client.Apps.ListInstallations,
+		ListRepos:                        client.Apps.ListRepos,
+		ListUserInstallations:            client.Apps.ListUserInstallations,
+		ListUserRepos:                    client.Apps.ListUserRepos,
+		RedeliverHookDelivery:            client.Apps.RedeliverHookDelivery,
+		RemoveRepository:                 client.Apps.RemoveRepository,
+		RevokeInstallationToken:          client.Apps.RevokeInstallationToken,
+		SuspendInstallation:              client.Apps.SuspendInstallation,
+		UnsuspendInstallation:            client.Apps.UnsuspendInstallation,
+		UpdateHookConfig:                 client.Apps.UpdateHookConfig,
+	})
+}
+
+type AuthorizationsServiceF struct {
+	Check               func(ctx context.Context, clientID string, accessToken string) (*github.Authorization, *github.Response, error)
+	CreateImpersonation func(ctx context.Context, username string, authReq *github.AuthorizationRequest) (*github.Authorization, *github.Response, error)
+	DeleteGrant         func(ctx context.Context, clientID string, accessToken string) (*github.Response, error)
+	DeleteImpersonation func(ctx context.Context, username string) (*github.Response, error)
+	Reset               func(ctx context.Context, clientID string, accessToken string) (*github.Authorization, *github.Response, error)
+	Revoke              func(ctx context.Context, clientID string, accessToken string) (*github.Response, error)
+}
+
+type AuthorizationsService struct {
+	f *AuthorizationsServiceF
+}
+
+func (a *AuthorizationsService) Check(ctx context.Context, clientID string, accessToken string) (*github.Authorization, *github.Response, error) {
+	return a.f.Check(ctx, clientID, accessToken)
+}
+func (a *AuthorizationsService) CreateImpersonation(ctx context.Context, username string, authReq *github.AuthorizationRequest) (*github.Authorization, *github.Response, error) {
+	return a.f.CreateImpersonation(ctx, username, authReq)
+}
+func (a *AuthorizationsService) DeleteGrant(ctx context.Context, clientID string, accessToken string) (*github.Response, error) {
+	return a.f.DeleteGrant(ctx, clientID, accessToken)
+}
+func (a *AuthorizationsService) DeleteImpersonation(ctx context.Context, username string) (*github.Response, error) {
+	return a.f.DeleteImpersonation(ctx, username)
+}
+func (a *AuthorizationsService) Reset(ctx context.Context, clientID string, accessToken string) (*github.Authorization, *github.Response, error) {
+	return a.f.Reset(ctx, clientID, accessToken)
+}
+func (a *AuthorizationsService) Revoke(ctx context.Context, clientID string, accessToken string) (*github.Response, error) {
+	return a.f.Revoke(ctx, clientID, accessToken)
+}
+
+// NewAuthorizationsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewAuthorizationsService(f *AuthorizationsServiceF) *AuthorizationsService {
+	var table AuthorizationsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &AuthorizationsService{f: &table}
+}
+
+func newAuthorizationsServicePassthrough(client *github.Client) *AuthorizationsService {
+	return NewAuthorizationsService(&AuthorizationsServiceF{
+		Check:               client.Authorizations.Check,
+		CreateImpersonation: client.Authorizations.CreateImpersonation,
+		DeleteGrant:         client.Authorizations.DeleteGrant,
+		DeleteImpersonation: client.Authorizations.DeleteImpersonation,
+		Reset:               client.Authorizations.Reset,
+		Revoke:              client.Authorizations.Revoke,
+	})
+}
+
+type BillingServiceF struct {
+	GetActionsBillingOrg                   func(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error)
+	GetActionsBillingUser                  func(ctx context.Context, user string) (*github.ActionBilling, *github.Response, error)
+	GetAdvancedSecurityActiveCommittersOrg func(ctx context.Context, org string, opts *github.ListOptions) (*github.ActiveCommitters, *github.Response, error)
+	GetPackagesBillingOrg                  func(ctx context.Context, org string) (*github.PackageBilling, *github.Response, error)
+	GetPackagesBillingUser                 func(ctx context.Context, user string) (*github.PackageBilling, *github.Response, error)
+	GetStorageBillingOrg                   func(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error)
+	GetStorageBillingUser                  func(ctx context.Context, user string) (*github.StorageBilling, *github.Response, error)
+}
+
+type BillingService struct {
+	f *BillingServiceF
+}
+
+func (b *BillingService) GetActionsBillingOrg(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error) {
+	return b.f.GetActionsBillingOrg(ctx, org)
+}
+func (b *BillingService) GetActionsBillingUser(ctx context.Context, user string) (*github.ActionBilling, *github.Response, error) {
+	return b.f.GetActionsBillingUser(ctx, user)
+}
+func (b *BillingService) GetAdvancedSecurityActiveCommittersOrg(ctx context.Context, org string, opts *github.ListOptions) (*github.ActiveCommitters, *github.Response, error) {
+	return b.f.GetAdvancedSecurityActiveCommittersOrg(ctx, org, opts)
+}
+func (b *BillingService) GetPackagesBillingOrg(ctx context.Context, org string) (*github.PackageBilling, *github.Response, error) {
+	return b.f.GetPackagesBillingOrg(ctx, org)
+}
+func (b *BillingService) GetPackagesBillingUser(ctx context.Context, user string) (*github.PackageBilling, *github.Response, error) {
+	return b.f.GetPackagesBillingUser(ctx, user)
+}
+func (b *BillingService) GetStorageBillingOrg(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error) {
+	return b.f.GetStorageBillingOrg(ctx, org)
+}
+func (b *BillingService) GetStorageBillingUser(ctx context.Context, user string) (*github.StorageBilling, *github.Response, error) {
+	return b.f.GetStorageBillingUser(ctx, user)
+}
+
+// NewBillingService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewBillingService(f *BillingServiceF) *BillingService {
+	var table BillingServiceF
+	if f != nil {
+		table = *f
+	}
+	return &BillingService{f: &table}
+}
+
+func newBillingServicePassthrough(client *github.Client) *BillingService {
+	return NewBillingService(&BillingServiceF{
+		GetActionsBillingOrg:                   client.Billing.GetActionsBillingOrg,
+		GetActionsBillingUser:                  client.Billing.GetActionsBillingUser,
+		GetAdvancedSecurityActiveCommittersOrg: client.Billing.GetAdvancedSecurityActiveCommittersOrg,
+		GetPackagesBillingOrg:                  client.Billing.GetPackagesBillingOrg,
+		GetPackagesBillingUser:                 client.Billing.GetPackagesBillingUser,
+		GetStorageBillingOrg:                   client.Billing.GetStorageBillingOrg,
+		GetStorageBillingUser:                  client.Billing.GetStorageBillingUser,
+	})
+}
+
+type ChecksServiceF struct {
+	CreateCheckRun           func(ctx context.Context, owner string, repo string, opts github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+	CreateCheckSuite         func(ctx context.Context, owner string, repo string, opts github.CreateCheckSuiteOptions) (*github.CheckSuite, *github.Response, error)
+	GetCheckRun              func(ctx context.Context, owner string, repo string, checkRunID int64) (*github.CheckRun, *github.Response, error)
+	GetCheckSuite            func(ctx context.Context, owner string, repo string, checkSuiteID int64) (*github.CheckSuite, *github.Response, error)
+	ListCheckRunAnnotations  func(ctx context.Context, owner string, repo string, checkRunID int64, opts *github.ListOptions) ([]*github.CheckRunAnnotation, *github.Response, error)
+	ListCheckRunsCheckSuite  func(ctx context.Context, owner string, repo string, checkSuiteID int64, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error)
+	ListCheckRunsForRef      func(ctx context.Context, owner string, repo string, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error)
+	ListCheckSuitesForRef    func(ctx context.Context, owner string, repo string, ref string, opts *github.ListCheckSuiteOptions) (*github.ListCheckSuiteResults, *github.Response, error)
+	ReRequestCheckRun        func(ctx context.Context, owner string, repo string, checkRunID int64) (*github.Response, error)
+	ReRequestCheckSuite      func(ctx context.Context, owner string, repo string, checkSuiteID int64) (*github.Response, error)
+	SetCheckSuitePreferences func(ctx context.Context, owner string, repo string, opts github.CheckSuitePreferenceOptions) (*github.CheckSuitePreferenceResults, *github.Response, error)
+	UpdateCheckRun           func(ctx context.Context, owner string, repo string, checkRunID int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error)
+}
+
+type ChecksService struct {
+	f *ChecksServiceF
+}
+
+func (c *ChecksService) CreateCheckRun(ctx context.Context, owner string, repo string, opts github.CreateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+	return c.f.CreateCheckRun(ctx, owner, repo, opts)
+}
+func (c *ChecksService) CreateCheckSuite(ctx context.Context, owner string, repo string, opts github.CreateCheckSuiteOptions) (*github.CheckSuite, *github.Response, error) {
+	return c.f.CreateCheckSuite(ctx, owner, repo, opts)
+}
+func (c *ChecksService) GetCheckRun(ctx context.Context, owner string, repo string, checkRunID int64) (*github.CheckRun, *github.Response, error) {
+	return c.f.GetCheckRun(ctx, owner, repo, checkRunID)
+}
+func (c *ChecksService) GetCheckSuite(ctx context.Context, owner string, repo string, checkSuiteID int64) (*github.CheckSuite, *github.Response, error) {
+	return c.f.GetCheckSuite(ctx, owner, repo, checkSuiteID)
+}
+func (c *ChecksService) ListCheckRunAnnotations(ctx context.Context, owner string, repo string, checkRunID int64, opts *github.ListOptions) ([]*github.CheckRunAnnotation, *github.Response, error) {
+	return c.f.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, opts)
+}
+func (c *ChecksService) ListCheckRunsCheckSuite(ctx context.Context, owner string, repo string, checkSuiteID int64, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error) {
+	return c.f.ListCheckRunsCheckSuite(ctx, owner, repo, checkSuiteID, opts)
+}
+func (c *ChecksService) ListCheckRunsForRef(ctx context.Context, owner string, repo string, ref string, opts *github.ListCheckRunsOptions) (*github.ListCheckRunsResults, *github.Response, error) {
+	return c.f.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+}
+func (c *ChecksService) ListCheckSuitesForRef(ctx context.Context, owner string, repo string, ref string, opts *github.ListCheckSuiteOptions) (*github.ListCheckSuiteResults, *github.Response, error) {
+	return c.f.ListCheckSuitesForRef(ctx, owner, repo, ref, opts)
+}
+func (c *ChecksService) ReRequestCheckRun(ctx context.Context, owner string, repo string, checkRunID int64) (*github.Response, error) {
+	return c.f.ReRequestCheckRun(ctx, owner, repo, checkRunID)
+}
+func (c *ChecksService) ReRequestCheckSuite(ctx context.Context, owner string, repo string, checkSuiteID int64) (*github.Response, error) {
+	return c.f.ReRequestCheckSuite(ctx, owner, repo, checkSuiteID)
+}
+func (c *ChecksService) SetCheckSuitePreferences(ctx context.Context, owner string, repo string, opts github.CheckSuitePreferenceOptions) (*github.CheckSuitePreferenceResults, *github.Response, error) {
+	return c.f.SetCheckSuitePreferences(ctx, owner, repo, opts)
+}
+func (c *ChecksService) UpdateCheckRun(ctx context.Context, owner string, repo string, checkRunID int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, *github.Response, error) {
+	return c.f.UpdateCheckRun(ctx, owner, repo, checkRunID, opts)
+}
+
+// NewChecksService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewChecksService(f *ChecksServiceF) *ChecksService {
+	var table ChecksServiceF
+	if f != nil {
+		table = *f
+	}
+	return &ChecksService{f: &table}
+}
+
+func newChecksServicePassthrough(client *github.Client) *ChecksService {
+	return NewChecksService(&ChecksServiceF{
+		CreateCheckRun:           client.Checks.CreateCheckRun,
+		CreateCheckSuite:         client.Checks.CreateCheckSuite,
+		GetCheckRun:              client.Checks.GetCheckRun,
+		GetCheckSuite:            client.Checks.GetCheckSuite,
+		ListCheckRunAnnotations:  client.Checks.ListCheckRunAnnotations,
+		ListCheckRunsCheckSuite:  client.Checks.ListCheckRunsCheckSuite,
+		ListCheckRunsForRef:      client.Checks.ListCheckRunsForRef,
+		ListCheckSuitesForRef:    client.Checks.ListCheckSuitesForRef,
+		ReRequestCheckRun:        client.Checks.ReRequestCheckRun,
+		ReRequestCheckSuite:      client.Checks.ReRequestCheckSuite,
+		SetCheckSuitePreferences: client.Checks.SetCheckSuitePreferences,
+		UpdateCheckRun:           client.Checks.UpdateCheckRun,
+	})
+}
+
+type CodeScanningServiceF struct {
+	DeleteAnalysis                  func(ctx context.Context, owner string, repo string, id int64) (*github.DeleteAnalysis, *github.Response, error)
+	GetAlert                        func(ctx context.Context, owner string, repo string, id int64) (*github.Alert, *github.Response, error)
+	GetAnalysis                     func(ctx context.Context, owner string, repo string, id int64) (*github.ScanningAnalysis, *github.Response, error)
+	GetCodeQLDatabase               func(ctx context.Context, owner string, repo string, language string) (*github.CodeQLDatabase, *github.Response, error)
+	GetDefaultSetupConfiguration    func(ctx context.Context, owner string, repo string) (*github.DefaultSetupConfiguration, *github.Response, error)
+	GetSARIF                        func(ctx context.Context, owner string, repo string, sarifID string) (*github.SARIFUpload, *github.Response, error)
+	ListAlertInstances              func(ctx context.Context, owner string, repo string, id int64, opts *github.AlertInstancesListOptions) ([]*github.MostRecentInstance, *github.Response, error)
+	ListAlertsForOrg                func(ctx context.Context, org string, opts *github.AlertListOptions) ([]*github.Alert, *github.Response, error)
+	ListAlertsForRepo               func(ctx context.Context, owner string, repo string, opts *github.AlertListOptions) ([]*github.Alert, *github.Response, error)
+	ListAnalysesForRepo             func(ctx context.Context, owner string, repo string, opts *github.AnalysesListOptions) ([]*github.ScanningAnalysis, *github.Response, error)
+	ListCodeQLDatabases             func(ctx context.Context, owner string, repo string) ([]*github.CodeQLDatabase, *github.Response, error)
+	UpdateAlert                     func(ctx context.Context, owner string, repo string, id int64, stateInfo *github.CodeScanningAlertState) (*github.Alert, *github.Response, error)
+	UpdateDefaultSetupConfiguration func(ctx context.Context, owner string, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error)
+	UploadSarif                     func(ctx context.Context, owner string, repo string, sarif *github.SarifAnalysis) (*github.SarifID, *github.Response, error)
+}
+
+type CodeScanningService struct {
+	f *CodeScanningServiceF
+}
+
+func (c *CodeScanningService) DeleteAnalysis(ctx context.Context, owner string, repo string, id int64) (*github.DeleteAnalysis, *github.Response, error) {
+	return c.f.DeleteAnalysis(ctx, owner, repo, id)
+}
+func (c *CodeScanningService) GetAlert(ctx context.Context, owner string, repo string, id int64) (*github.Alert, *github.Response, error) {
+	return c.f.GetAlert(ctx, owner, repo, id)
+}
+func (c *CodeScanningService) GetAnalysis(ctx context.Context, owner string, repo string, id int64) (*github.ScanningAnalysis, *github.Response, error) {
+	return c.f.GetAnalysis(ctx, owner, repo, id)
+}
+func (c *CodeScanningService) GetCodeQLDatabase(ctx context.Context, owner string, repo string, language string) (*github.CodeQLDatabase, *github.Response, error) {
+	return c.f.GetCodeQLDatabase(ctx, owner, repo, language)
+}
+func (c *CodeScanningService) GetDefaultSetupConfiguration(ctx context.Context, owner string, repo string) (*github.DefaultSetupConfiguration, *github.Response, error) {
+	return c.f.GetDefaultSetupConfiguration(ctx, owner, repo)
+}
+func (c *CodeScanningService) GetSARIF(ctx context.Context, owner string, repo string, sarifID string) (*github.SARIFUpload, *github.Response, error) {
+	return c.f.GetSARIF(ctx, owner, repo, sarifID)
+}
+func (c *CodeScanningService) ListAlertInstances(ctx context.Context, owner string, repo string, id int64, opts *github.AlertInstancesListOptions) ([]*github.MostRecentInstance, *github.Response, error) {
+	return c.f.ListAlertInstances(ctx, owner, repo, id, opts)
+}
+func (c *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *github.AlertListOptions) ([]*github.Alert, *github.Response, error) {
+	return c.f.ListAlertsForOrg(ctx, org, opts)
+}
+func (c *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner string, repo string, opts *github.AlertListOptions) ([]*github.Alert, *github.Response, error) {
+	return c.f.ListAlertsForRepo(ctx, owner, repo, opts)
+}
+func (c *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner string, repo string, opts *github.AnalysesListOptions) ([]*github.ScanningAnalysis, *github.Response, error) {
+	return c.f.ListAnalysesForRepo(ctx, owner, repo, opts)
+}
+func (c *CodeScanningService) ListCodeQLDatabases(ctx context.Context, owner string, repo string) ([]*github.CodeQLDatabase, *github.Response, error) {
+	return c.f.ListCodeQLDatabases(ctx, owner, repo)
+}
+func (c *CodeScanningService) UpdateAlert(ctx context.Context, owner string, repo string, id int64, stateInfo *github.CodeScanningAlertState) (*github.Alert, *github.Response, error) {
+	return c.f.UpdateAlert(ctx, owner, repo, id, stateInfo)
+}
+func (c *CodeScanningService) UpdateDefaultSetupConfiguration(ctx context.Context, owner string, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error) {
+	return c.f.UpdateDefaultSetupConfiguration(ctx, owner, repo, options)
+}
+func (c *CodeScanningService) UploadSarif(ctx context.Context, owner string, repo string, sarif *github.SarifAnalysis) (*github.SarifID, *github.Response, error) {
+	return c.f.UploadSarif(ctx, owner, repo, sarif)
+}
+
+// NewCodeScanningService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewCodeScanningService(f *CodeScanningServiceF) *CodeScanningService {
+	var table CodeScanningServiceF
+	if f != nil {
+		table = *f
+	}
+	return &CodeScanningService{f: &table}
+}
+
+func newCodeScanningServicePassthrough(client *github.Client) *CodeScanningService {
+	return NewCodeScanningService(&CodeScanningServiceF{
+		DeleteAnalysis:                  client.CodeScanning.DeleteAnalysis,
+		GetAlert:                        client.CodeScanning.GetAlert,
+		GetAnalysis:                     client.CodeScanning.GetAnalysis,
+		GetCodeQLDatabase:               client.CodeScanning.GetCodeQLDatabase,
+		GetDefaultSetupConfiguration:    client.CodeScanning.GetDefaultSetupConfiguration,
+		GetSARIF:                        client.CodeScanning.GetSARIF,
+		ListAlertInstances:              client.CodeScanning.ListAlertInstances,
+		ListAlertsForOrg:                client.CodeScanning.ListAlertsForOrg,
+		ListAlertsForRepo:               client.CodeScanning.ListAlertsForRepo,
+		ListAnalysesForRepo:             client.CodeScanning.ListAnalysesForRepo,
+		ListCodeQLDatabases:             client.CodeScanning.ListCodeQLDatabases,
+		UpdateAlert:                     client.CodeScanning.UpdateAlert,
+		UpdateDefaultSetupConfiguration: client.CodeScanning.UpdateDefaultSetupConfiguration,
+		UploadSarif:                     client.CodeScanning.UploadSarif,
+	})
+}
+
+type CodesOfConductServiceF struct {
+	Get  func(ctx context.Context, key string) (*github.CodeOfConduct, *github.Response, error)
+	List func(ctx context.Context) ([]*github.CodeOfConduct, *github.Response, error)
+}
+
+type CodesOfConductService struct {
+	f *CodesOfConductServiceF
+}
+
+func (c *CodesOfConductService) Get(ctx context.Context, key string) (*github.CodeOfConduct, *github.Response, error) {
+	return c.f.Get(ctx, key)
+}
+func (c *CodesOfConductService) List(ctx context.Context) ([]*github.CodeOfConduct, *github.Response, error) {
+	return c.f.List(ctx)
+}
+
+// NewCodesOfConductService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewCodesOfConductService(f *CodesOfConductServiceF) *CodesOfConductService {
+	var table CodesOfConductServiceF
+	if f != nil {
+		table = *f
+	}
+	return &CodesOfConductService{f: &table}
+}
+
+func newCodesOfConductServicePassthrough(client *github.Client) *CodesOfConductService {
+	return NewCodesOfConductService(&CodesOfConductServiceF{
+		Get:  client.CodesOfConduct.Get,
+		List: client.CodesOfConduct.List,
+	})
+}
+
+type CodespacesServiceF struct {
+	AddSelectedRepoToOrgSecret       func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	AddSelectedRepoToUserSecret      func(ctx context.Context, name string, repo *github.Repository) (*github.Response, error)
+	CreateInRepo                     func(ctx context.Context, owner string, repo string, request *github.CreateCodespaceOptions) (*github.Codespace, *github.Response, error)
+	CreateOrUpdateOrgSecret          func(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	CreateOrUpdateRepoSecret         func(ctx context.Context, owner string, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
+	CreateOrUpdateUserSecret         func(ctx context.Context, eSecret *github.EncryptedSecret) (*github.Response, error)
+	Delete                           func(ctx context.Context, codespaceName string) (*github.Response, error)
+	DeleteOrgSecret                  func(ctx context.Context, org string, name string) (*github.Response, error)
+	DeleteRepoSecret                 func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	DeleteUserSecret                 func(ctx context.Context, name string) (*github.Response, error)
+	GetOrgPublicKey                  func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error)
+	GetOrgSecret                     func(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error)
+	GetRepoPublicKey                 func(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret                    func(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error)
+	GetUserPublicKey                 func(ctx context.Context) (*github.PublicKey, *github.Response, error)
+	GetUserSecret                    func(ctx context.Context, name string) (*github.Secret, *github.Response, error)
+	List                             func(ctx context.Context, opts *github.ListCodespacesOptions) (*github.ListCodespaces, *github.Response, error)
+	ListInRepo                       func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ListCodespaces, *github.Response, error)
+	ListOrgSecrets                   func(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListRepoSecrets                  func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListSelectedReposForOrgSecret    func(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	ListSelectedReposForUserSecret   func(ctx context.Context, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	ListUserSecrets                  func(ctx context.Context, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	RemoveSelectedRepoFromOrgSecret  func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	RemoveSelectedRepoFromUserSecret func(ctx context.Context, name string, repo *github.Repository) (*github.Response, error)
+	SetSelectedReposForOrgSecret     func(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	SetSelectedReposForUserSecret    func(ctx context.Context, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	Start                            func(ctx context.Context, codespaceName string) (*github.Codespace, *github.Response, error)
+	Stop                             func(ctx context.Context, codespaceName string) (*github.Codespace, *github.Response, error)
+}
+
+type CodespacesService struct {
+	f *CodespacesServiceF
+}
+
+func (c *CodespacesService) AddSelectedRepoToOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return c.f.AddSelectedRepoToOrgSecret(ctx, org, name, repo)
+}
+func (c *CodespacesService) AddSelectedRepoToUserSecret(ctx context.Context, name string, repo *github.Repository) (*github.Response, error) {
+	return c.f.AddSelectedRepoToUserSecret(ctx, name, repo)
+}
+func (c *CodespacesService) CreateInRepo(ctx context.Context, owner string, repo string, request *github.CreateCodespaceOptions) (*github.Codespace, *github.Response, error) {
+	return c.f.CreateInRepo(ctx, owner, repo, request)
+}
+func (c *CodespacesService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return c.f.CreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+func (c *CodespacesService) CreateOrUpdateRepoSecret(ctx context.Context, owner string, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return c.f.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+func (c *CodespacesService) CreateOrUpdateUserSecret(ctx context.Context, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return c.f.CreateOrUpdateUserSecret(ctx, eSecret)
+}
+func (c *CodespacesService) Delete(ctx context.Context, codespaceName string) (*github.Response, error) {
+	return c.f.Delete(ctx, codespaceName)
+}
+func (c *CodespacesService) DeleteOrgSecret(ctx context.Context, org string, name string) (*github.Response, error) {
+	return c.f.DeleteOrgSecret(ctx, org, name)
+}
+func (c *CodespacesService) DeleteRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return c.f.DeleteRepoSecret(ctx, owner, repo, name)
+}
+func (c *CodespacesService) DeleteUserSecret(ctx context.Context, name string) (*github.Response, error) {
+	return c.f.DeleteUserSecret(ctx, name)
+}
+func (c *CodespacesService) GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return c.f.GetOrgPublicKey(ctx, org)
+}
+func (c *CodespacesService) GetOrgSecret(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error) {
+	return c.f.GetOrgSecret(ctx, org, name)
+}
+func (c *CodespacesService) GetRepoPublicKey(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error) {
+	return c.f.GetRepoPublicKey(ctx, owner, repo)
+}
+func (c *CodespacesService) GetRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error) {
+	return c.f.GetRepoSecret(ctx, owner, repo, name)
+}
+func (c *CodespacesService) GetUserPublicKey(ctx context.Context) (*github.PublicKey, *github.Response, error) {
+	return c.f.GetUserPublicKey(ctx)
+}
+func (c *CodespacesService) GetUserSecret(ctx context.Context, name string) (*github.Secret, *github.Response, error) {
+	return c.f.GetUserSecret(ctx, name)
+}
+func (c *CodespacesService) List(ctx context.Context, opts *github.ListCodespacesOptions) (*github.ListCodespaces, *github.Response, error) {
+	return c.f.List(ctx, opts)
+}
+func (c *CodespacesService) ListInRepo(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.ListCodespaces, *github.Response, error) {
+	return c.f.ListInRepo(ctx, owner, repo, opts)
+}
+func (c *CodespacesService) ListOrgSecrets(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return c.f.ListOrgSecrets(ctx, org, opts)
+}
+func (c *CodespacesService) ListRepoSecrets(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return c.f.ListRepoSecrets(ctx, owner, repo, opts)
+}
+func (c *CodespacesService) ListSelectedReposForOrgSecret(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return c.f.ListSelectedReposForOrgSecret(ctx, org, name, opts)
+}
+func (c *CodespacesService) ListSelectedReposForUserSecret(ctx context.Context, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return c.f.ListSelectedReposForUserSecret(ctx, name, opts)
+}
+func (c *CodespacesService) ListUserSecrets(ctx context.Context, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return c.f.ListUserSecrets(ctx, opts)
+}
+func (c *CodespacesService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return c.f.RemoveSelectedRepoFromOrgSecret(ctx, org, name, repo)
+}
+func (c *CodespacesService) RemoveSelectedRepoFromUserSecret(ctx context.Context, name string, repo *github.Repository) (*github.Response, error) {
+	return c.f.RemoveSelectedRepoFromUserSecret(ctx, name, repo)
+}
+func (c *CodespacesService) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return c.f.SetSelectedReposForOrgSecret(ctx, org, name, ids)
+}
+func (c *CodespacesService) SetSelectedReposForUserSecret(ctx context.Context, name string, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return c.f.SetSelectedReposForUserSecret(ctx, name, ids)
+}
+func (c *CodespacesService) Start(ctx context.Context, codespaceName string) (*github.Codespace, *github.Response, error) {
+	return c.f.Start(ctx, codespaceName)
+}
+func (c *CodespacesService) Stop(ctx context.Context, codespaceName string) (*github.Codespace, *github.Response, error) {
+	return c.f.Stop(ctx, codespaceName)
+}
+
+// NewCodespacesService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewCodespacesService(f *CodespacesServiceF) *CodespacesService {
+	var table CodespacesServiceF
+	if f != nil {
+		table = *f
+	}
+	return &CodespacesService{f: &table}
+}
+
+func newCodespacesServicePassthrough(client *github.Client) *CodespacesService {
+	return NewCodespacesService(&CodespacesServiceF{
+		AddSelectedRepoToOrgSecret:       client.Codespaces.AddSelectedRepoToOrgSecret,
+		AddSelectedRepoToUserSecret:      client.Codespaces.AddSelectedRepoToUserSecret,
+		CreateInRepo:                     client.Codespaces.CreateInRepo,
+		CreateOrUpdateOrgSecret:          client.Codespaces.CreateOrUpdateOrgSecret,
+		CreateOrUpdateRepoSecret:         client.Codespaces.CreateOrUpdateRepoSecret,
+		CreateOrUpdateUserSecret:         client.Codespaces.CreateOrUpdateUserSecret,
+		Delete:                           client.Codespaces.Delete,
+		DeleteOrgSecret:                  client.Codespaces.DeleteOrgSecret,
+		DeleteRepoSecret:                 client.Codespaces.DeleteRepoSecret,
+		DeleteUserSecret:                 client.Codespaces.DeleteUserSecret,
+		GetOrgPublicKey:                  client.Codespaces.GetOrgPublicKey,
+		GetOrgSecret:                     client.Codespaces.GetOrgSecret,
+		GetRepoPublicKey:                 client.Codespaces.GetRepoPublicKey,
+		GetRepoSecret:                    client.Codespaces.GetRepoSecret,
+		GetUserPublicKey:                 client.Codespaces.GetUserPublicKey,
+		GetUserSecret:                    client.Codespaces.GetUserSecret,
+		List:                             client.Codespaces.List,
+		ListInRepo:                       client.Codespaces.ListInRepo,
+		ListOrgSecrets:                   client.Codespaces.ListOrgSecrets,
+		ListRepoSecrets:                  client.Codespaces.ListRepoSecrets,
+		ListSelectedReposForOrgSecret:    client.Codespaces.ListSelectedReposForOrgSecret,
+		ListSelectedReposForUserSecret:   client.Codespaces.ListSelectedReposForUserSecret,
+		ListUserSecrets:                  client.Codespaces.ListUserSecrets,
+		RemoveSelectedRepoFromOrgSecret:  client.Codespaces.RemoveSelectedRepoFromOrgSecret,
+		RemoveSelectedRepoFromUserSecret: client.Codespaces.RemoveSelectedRepoFromUserSecret,
+		SetSelectedReposForOrgSecret:     client.Codespaces.SetSelectedReposForOrgSecret,
+		SetSelectedReposForUserSecret:    client.Codespaces.SetSelectedReposForUserSecret,
+		Start:                            client.Codespaces.Start,
+		Stop:                             client.Codespaces.Stop,
+	})
+}
+
+type CopilotServiceF struct {
+	AddCopilotTeams    func(ctx context.Context, org string, teamNames []string) (*github.SeatAssignments, *github.Response, error)
+	AddCopilotUsers    func(ctx context.Context, org string, users []string) (*github.SeatAssignments, *github.Response, error)
+	GetCopilotBilling  func(ctx context.Context, org string) (*github.CopilotOrganizationDetails, *github.Response, error)
+	GetSeatDetails     func(ctx context.Context, org string, user string) (*github.CopilotSeatDetails, *github.Response, error)
+	ListCopilotSeats   func(ctx context.Context, org string, opts *github.ListOptions) (*github.ListCopilotSeatsResponse, *github.Response, error)
+	RemoveCopilotTeams func(ctx context.Context, org string, teamNames []string) (*github.SeatCancellations, *github.Response, error)
+	RemoveCopilotUsers func(ctx context.Context, org string, users []string) (*github.SeatCancellations, *github.Response, error)
+}
+
+type CopilotService struct {
+	f *CopilotServiceF
+}
+
+func (c *CopilotService) AddCopilotTeams(ctx context.Context, org string, teamNames []string) (*github.SeatAssignments, *github.Response, error) {
+	return c.f.AddCopilotTeams(ctx, org, teamNames)
+}
+func (c *CopilotService) AddCopilotUsers(ctx context.Context, org string, users []string) (*github.SeatAssignments, *github.Response, error) {
+	return c.f.AddCopilotUsers(ctx, org, users)
+}
+func (c *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*github.CopilotOrganizationDetails, *github.Response, error) {
+	return c.f.GetCopilotBilling(ctx, org)
+}
+func (c *CopilotService) GetSeatDetails(ctx context.Context, org string, user string) (*github.CopilotSeatDetails, *github.Response, error) {
+	return c.f.GetSeatDetails(ctx, org, user)
+}
+func (c *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts *github.ListOptions) (*github.ListCopilotSeatsResponse, *github.Response, error) {
+	return c.f.ListCopilotSeats(ctx, org, opts)
+}
+func (c *CopilotService) RemoveCopilotTeams(ctx context.Context, org string, teamNames []string) (*github.SeatCancellations, *github.Response, error) {
+	return c.f.RemoveCopilotTeams(ctx, org, teamNames)
+}
+func (c *CopilotService) RemoveCopilotUsers(ctx context.Context, org string, users []string) (*github.SeatCancellations, *github.Response, error) {
+	return c.f.RemoveCopilotUsers(ctx, org, users)
+}
+
+// NewCopilotService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewCopilotService(f *CopilotServiceF) *CopilotService {
+	var table CopilotServiceF
+	if f != nil {
+		table = *f
+	}
+	return &CopilotService{f: &table}
+}
+
+func newCopilotServicePassthrough(client *github.Client) *CopilotService {
+	return NewCopilotService(&CopilotServiceF{
+		AddCopilotTeams:    client.Copilot.AddCopilotTeams,
+		AddCopilotUsers:    client.Copilot.AddCopilotUsers,
+		GetCopilotBilling:  client.Copilot.GetCopilotBilling,
+		GetSeatDetails:     client.Copilot.GetSeatDetails,
+		ListCopilotSeats:   client.Copilot.ListCopilotSeats,
+		RemoveCopilotTeams: client.Copilot.RemoveCopilotTeams,
+		RemoveCopilotUsers: client.Copilot.RemoveCopilotUsers,
+	})
+}
+
+type DependabotServiceF struct {
+	AddSelectedRepoToOrgSecret      func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	CreateOrUpdateOrgSecret         func(ctx context.Context, org string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error)
+	CreateOrUpdateRepoSecret        func(ctx context.Context, owner string, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error)
+	DeleteOrgSecret                 func(ctx context.Context, org string, name string) (*github.Response, error)
+	DeleteRepoSecret                func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	GetOrgPublicKey                 func(ctx context.Context, org string) (*github.PublicKey, *github.Response, error)
+	GetOrgSecret                    func(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error)
+	GetRepoAlert                    func(ctx context.Context, owner string, repo string, number int) (*github.DependabotAlert, *github.Response, error)
+	GetRepoPublicKey                func(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret                   func(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error)
+	ListOrgAlerts                   func(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error)
+	ListOrgSecrets                  func(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListRepoAlerts                  func(ctx context.Context, owner string, repo string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error)
+	ListRepoSecrets                 func(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error)
+	ListSelectedReposForOrgSecret   func(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	RemoveSelectedRepoFromOrgSecret func(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error)
+	SetSelectedReposForOrgSecret    func(ctx context.Context, org string, name string, ids github.DependabotSecretsSelectedRepoIDs) (*github.Response, error)
+	UpdateAlert                     func(ctx context.Context, owner string, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error)
+}
+
+type DependabotService struct {
+	f *DependabotServiceF
+}
+
+func (d *DependabotService) AddSelectedRepoToOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return d.f.AddSelectedRepoToOrgSecret(ctx, org, name, repo)
+}
+func (d *DependabotService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error) {
+	return d.f.CreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+func (d *DependabotService) CreateOrUpdateRepoSecret(ctx context.Context, owner string, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error) {
+	return d.f.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+func (d *DependabotService) DeleteOrgSecret(ctx context.Context, org string, name string) (*github.Response, error) {
+	return d.f.DeleteOrgSecret(ctx, org, name)
+}
+func (d *DependabotService) DeleteRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return d.f.DeleteRepoSecret(ctx, owner, repo, name)
+}
+func (d *DependabotService) GetOrgPublicKey(ctx context.Context, org string) (*github.PublicKey, *github.Response, error) {
+	return d.f.GetOrgPublicKey(ctx, org)
+}
+func (d *DependabotService) GetOrgSecret(ctx context.Context, org string, name string) (*github.Secret, *github.Response, error) {
+	return d.f.GetOrgSecret(ctx, org, name)
+}
+func (d *DependabotService) GetRepoAlert(ctx context.Context, owner string, repo string, number int) (*github.DependabotAlert, *github.Response, error) {
+	return d.f.GetRepoAlert(ctx, owner, repo, number)
+}
+func (d *DependabotService) GetRepoPublicKey(ctx context.Context, owner string, repo string) (*github.PublicKey, *github.Response, error) {
+	return d.f.GetRepoPublicKey(ctx, owner, repo)
+}
+func (d *DependabotService) GetRepoSecret(ctx context.Context, owner string, repo string, name string) (*github.Secret, *github.Response, error) {
+	return d.f.GetRepoSecret(ctx, owner, repo, name)
+}
+func (d *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error) {
+	return d.f.ListOrgAlerts(ctx, org, opts)
+}
+func (d *DependabotService) ListOrgSecrets(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return d.f.ListOrgSecrets(ctx, org, opts)
+}
+func (d *DependabotService) ListRepoAlerts(ctx context.Context, owner string, repo string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error) {
+	return d.f.ListRepoAlerts(ctx, owner, repo, opts)
+}
+func (d *DependabotService) ListRepoSecrets(ctx context.Context, owner string, repo string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	return d.f.ListRepoSecrets(ctx, owner, repo, opts)
+}
+func (d *DependabotService) ListSelectedReposForOrgSecret(ctx context.Context, org string, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return d.f.ListSelectedReposForOrgSecret(ctx, org, name, opts)
+}
+func (d *DependabotService) RemoveSelectedRepoFromOrgSecret(ctx context.Context, org string, name string, repo *github.Repository) (*github.Response, error) {
+	return d.f.RemoveSelectedRepoFromOrgSecret(ctx, org, name, repo)
+}
+func (d *DependabotService) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids github.DependabotSecretsSelectedRepoIDs) (*github.Response, error) {
+	return d.f.SetSelectedReposForOrgSecret(ctx, org, name, ids)
+}
+func (d *DependabotService) UpdateAlert(ctx context.Context, owner string, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error) {
+	return d.f.UpdateAlert(ctx, owner, repo, number, stateInfo)
+}
+
+// NewDependabotService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewDependabotService(f *DependabotServiceF) *DependabotService {
+	var table DependabotServiceF
+	if f != nil {
+		table = *f
+	}
+	return &DependabotService{f: &table}
+}
+
+func newDependabotServicePassthrough(client *github.Client) *DependabotService {
+	return NewDependabotService(&DependabotServiceF{
+		AddSelectedRepoToOrgSecret:      client.Dependabot.AddSelectedRepoToOrgSecret,
+		CreateOrUpdateOrgSecret:         client.Dependabot.CreateOrUpdateOrgSecret,
+		CreateOrUpdateRepoSecret:        client.Dependabot.CreateOrUpdateRepoSecret,
+		DeleteOrgSecret:                 client.Dependabot.DeleteOrgSecret,
+		DeleteRepoSecret:                client.Dependabot.DeleteRepoSecret,
+		GetOrgPublicKey:                 client.Dependabot.GetOrgPublicKey,
+		GetOrgSecret:                    client.Dependabot.GetOrgSecret,
+		GetRepoAlert:                    client.Dependabot.GetRepoAlert,
+		GetRepoPublicKey:                client.Dependabot.GetRepoPublicKey,
+		GetRepoSecret:                   client.Dependabot.GetRepoSecret,
+		ListOrgAlerts:                   client.Dependabot.ListOrgAlerts,
+		ListOrgSecrets:                  client.Dependabot.ListOrgSecrets,
+		ListRepoAlerts:                  client.Dependabot.ListRepoAlerts,
+		ListRepoSecrets:                 client.Dependabot.ListRepoSecrets,
+		ListSelectedReposForOrgSecret:   client.Dependabot.ListSelectedReposForOrgSecret,
+		RemoveSelectedRepoFromOrgSecret: client.Dependabot.RemoveSelectedRepoFromOrgSecret,
+		SetSelectedReposForOrgSecret:    client.Dependabot.SetSelectedReposForOrgSecret,
+		UpdateAlert:                     client.Dependabot.UpdateAlert,
+	})
+}
+
+type DependencyGraphServiceF struct {
+	CreateSnapshot func(ctx context.Context, owner string, repo string, dependencyGraphSnapshot *github.DependencyGraphSnapshot) (*github.DependencyGraphSnapshotCreationData, *github.Response, error)
+	GetSBOM        func(ctx context.Context, owner string, repo string) (*github.SBOM, *github.Response, error)
+}
+
+type DependencyGraphService struct {
+	f *DependencyGraphServiceF
+}
+
+func (d *DependencyGraphService) CreateSnapshot(ctx context.Context, owner string, repo string, dependencyGraphSnapshot *github.DependencyGraphSnapshot) (*github.DependencyGraphSnapshotCreationData, *github.Response, error) {
+	return d.f.CreateSnapshot(ctx, owner, repo, dependencyGraphSnapshot)
+}
+func (d *DependencyGraphService) GetSBOM(ctx context.Context, owner string, repo string) (*github.SBOM, *github.Response, error) {
+	return d.f.GetSBOM(ctx, owner, repo)
+}
+
+// NewDependencyGraphService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewDependencyGraphService(f *DependencyGraphServiceF) *DependencyGraphService {
+	var table DependencyGraphServiceF
+	if f != nil {
+		table = *f
+	}
+	return &DependencyGraphService{f: &table}
+}
+
+func newDependencyGraphServicePassthrough(client *github.Client) *DependencyGraphService {
+	return NewDependencyGraphService(&DependencyGraphServiceF{
+		CreateSnapshot: client.DependencyGraph.CreateSnapshot,
+		GetSBOM:        client.DependencyGraph.GetSBOM,
+	})
+}
+
+type EmojisServiceF struct {
+	List func(ctx context.Context) (map[string]string, *github.Response, error)
+}
+
+type EmojisService struct {
+	f *EmojisServiceF
+}
+
+func (e *EmojisService) List(ctx context.Context) (map[string]string, *github.Response, error) {
+	return e.f.List(ctx)
+}
+
+// NewEmojisService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewEmojisService(f *EmojisServiceF) *EmojisService {
+	var table EmojisServiceF
+	if f != nil {
+		table = *f
+	}
+	return &EmojisService{f: &table}
+}
+
+func newEmojisServicePassthrough(client *github.Client) *EmojisService {
+	return NewEmojisService(&EmojisServiceF{
+		List: client.Emojis.List,
+	})
+}
+
+type EnterpriseServiceF struct {
+	AddOrganizationAccessRunnerGroup    func(ctx context.Context, enterprise string, groupID int64, orgID int64) (*github.Response, error)
+	AddRunnerGroupRunners               func(ctx context.Context, enterprise string, groupID int64, runnerID int64) (*github.Response, error)
+	CreateEnterpriseRunnerGroup         func(ctx context.Context, enterprise string, createReq github.CreateEnterpriseRunnerGroupRequest) (*github.EnterpriseRunnerGroup, *github.Response, error)
+	CreateRegistrationToken             func(ctx context.Context, enterprise string) (*github.RegistrationToken, *github.Response, error)
+	DeleteEnterpriseRunnerGroup         func(ctx context.Context, enterprise string, groupID int64) (*github.Response, error)
+	EnableDisableSecurityFeature        func(ctx context.Context, enterprise string, securityProduct string, enablement string) (*github.Response, error)
+	GenerateEnterpriseJITConfig         func(ctx context.Context, enterprise string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error)
+	GetAuditLog                         func(ctx context.Context, enterprise string, opts *github.GetAuditLogOptions) ([]*github.AuditEntry, *github.Response, error)
+	GetCodeSecurityAndAnalysis          func(ctx context.Context, enterprise string) (*github.EnterpriseSecurityAnalysisSettings, *github.Response, error)
+	GetEnterpriseRunnerGroup            func(ctx context.Context, enterprise string, groupID int64) (*github.EnterpriseRunnerGroup, *github.Response, error)
+	ListOrganizationAccessRunnerGroup   func(ctx context.Context, enterprise string, groupID int64, opts *github.ListOptions) (*github.ListOrganizations, *github.Response, error)
+	ListRunnerApplicationDownloads      func(ctx context.Context, enterprise string) ([]*github.RunnerApplicationDownload, *github.Response, error)
+	ListRunnerGroupRunners              func(ctx context.Context, enterprise string, groupID int64, opts *github.ListOptions) (*github.Runners, *github.Response, error)
+	ListRunnerGroups                    func(ctx context.Context, enterprise string, opts *github.ListEnterpriseRunnerGroupOptions) (*github.EnterpriseRunnerGroups, *github.Response, error)
+	ListRunners                         func(ctx context.Context, enterprise string, opts *github.ListOptions) (*github.Runners, *github.Response, error)
+	RemoveOrganizationAccessRunnerGroup func(ctx context.Context, enterprise string, groupID int64, orgID int64) (*github.Response, error)
+	RemoveRunner                        func(ctx context.Context, enterprise string, runnerID int64) (*github.Response, error)
+	RemoveRunnerGroupRunners            func(ctx context.Context, enterprise string, groupID int64, runnerID int64) (*github.Response, error)
+	SetOrganizationAccessRunnerGroup    func(ctx context.Context, enterprise string, groupID int64, ids github.SetOrgAccessRunnerGroupRequest) (*github.Response, error)
+	SetRunnerGroupRunners               func(ctx context.Context, enterprise string, groupID int64, ids github.SetRunnerGroupRunnersRequest) (*github.Response, error)
+	UpdateCodeSecurityAndAnalysis       func(ctx context.Context, enterprise string, settings *github.EnterpriseSecurityAnalysisSettings) (*github.Response, error)
+	UpdateEnterpriseRunnerGroup         func(ctx context.Context, enterprise string, groupID int64, updateReq github.UpdateEnterpriseRunnerGroupRequest) (*github.EnterpriseRunnerGroup, *github.Response, error)
+}
+
+type EnterpriseService struct {
+	f *EnterpriseServiceF
+}
+
+func (e *EnterpriseService) AddOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, orgID int64) (*github.Response, error) {
+	return e.f.AddOrganizationAccessRunnerGroup(ctx, enterprise, groupID, orgID)
+}
+func (e *EnterpriseService) AddRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, runnerID int64) (*github.Response, error) {
+	return e.f.AddRunnerGroupRunners(ctx, enterprise, groupID, runnerID)
+}
+func (e *EnterpriseService) CreateEnterpriseRunnerGroup(ctx context.Context, enterprise string, createReq github.CreateEnterpriseRunnerGroupRequest) (*github.EnterpriseRunnerGroup, *github.Response, error) {
+	return e.f.CreateEnterpriseRunnerGroup(ctx, enterprise, createReq)
+}
+func (e *EnterpriseService) CreateRegistrationToken(ctx context.Context, enterprise string) (*github.RegistrationToken, *github.Response, error) {
+	return e.f.CreateRegistrationToken(ctx, enterprise)
+}
+func (e *EnterpriseService) DeleteEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*github.Response, error) {
+	return e.f.DeleteEnterpriseRunnerGroup(ctx, enterprise, groupID)
+}
+func (e *EnterpriseService) EnableDisableSecurityFeature(ctx context.Context, enterprise string, securityProduct string, enablement string) (*github.Response, error) {
+	return e.f.EnableDisableSecurityFeature(ctx, enterprise, securityProduct, enablement)
+}
+func (e *EnterpriseService) GenerateEnterpriseJITConfig(ctx context.Context, enterprise string, request *github.GenerateJITConfigRequest) (*github.JITRunnerConfig, *github.Response, error) {
+	return e.f.GenerateEnterpriseJITConfig(ctx, enterprise, request)
+}
+func (e *EnterpriseService) GetAuditLog(ctx context.Context, enterprise string, opts *github.GetAuditLogOptions) ([]*github.AuditEntry, *github.Response, error) {
+	return e.f.GetAuditLog(ctx, enterprise, opts)
+}
+func (e *EnterpriseService) GetCodeSecurityAndAnalysis(ctx context.Context, enterprise string) (*github.EnterpriseSecurityAnalysisSettings, *github.Response, error) {
+	return e.f.GetCodeSecurityAndAnalysis(ctx, enterprise)
+}
+func (e *EnterpriseService) GetEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*github.EnterpriseRunnerGroup, *github.Response, error) {
+	return e.f.GetEnterpriseRunnerGroup(ctx, enterprise, groupID)
+}
+func (e *EnterpriseService) ListOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, opts *github.ListOptions) (*github.ListOrganizations, *github.Response, error) {
+	return e.f.ListOrganizationAccessRunnerGroup(ctx, enterprise, groupID, opts)
+}
+func (e *EnterpriseService) ListRunnerApplicationDownloads(ctx context.Context, enterprise string) ([]*github.RunnerApplicationDownload, *github.Response, error) {
+	return e.f.ListRunnerApplicationDownloads(ctx, enterprise)
+}
+func (e *EnterpriseService) ListRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, opts *github.ListOptions) (*github.Runners, *github.Response, error) {
+	return e.f.ListRunnerGroupRunners(ctx, enterprise, groupID, opts)
+}
+func (e *EnterpriseService) ListRunnerGroups(ctx context.Context, enterprise string, opts *github.ListEnterpriseRunnerGroupOptions) (*github.EnterpriseRunnerGroups, *github.Response, error) {
+	return e.f.ListRunnerGroups(ctx, enterprise, opts)
+}
+func (e *EnterpriseService) ListRunners(ctx context.Context, enterprise string, opts *github.ListOptions) (*github.Runners, *github.Response, error) {
+	return e.f.ListRunners(ctx, enterprise, opts)
+}
+func (e *EnterpriseService) RemoveOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, orgID int64) (*github.Response, error) {
+	return e.f.RemoveOrganizationAccessRunnerGroup(ctx, enterprise, groupID, orgID)
+}
+func (e *EnterpriseService) RemoveRunner(ctx context.Context, enterprise string, runnerID int64) (*github.Response, error) {
+	return e.f.RemoveRunner(ctx, enterprise, runnerID)
+}
+func (e *EnterpriseService) RemoveRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, runnerID int64) (*github.Response, error) {
+	return e.f.RemoveRunnerGroupRunners(ctx, enterprise, groupID, runnerID)
+}
+func (e *EnterpriseService) SetOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, ids github.SetOrgAccessRunnerGroupRequest) (*github.Response, error) {
+	return e.f.SetOrganizationAccessRunnerGroup(ctx, enterprise, groupID, ids)
+}
+func (e *EnterpriseService) SetRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, ids github.SetRunnerGroupRunnersRequest) (*github.Response, error) {
+	return e.f.SetRunnerGroupRunners(ctx, enterprise, groupID, ids)
+}
+func (e *EnterpriseService) UpdateCodeSecurityAndAnalysis(ctx context.Context, enterprise string, settings *github.EnterpriseSecurityAnalysisSettings) (*github.Response, error) {
+	return e.f.UpdateCodeSecurityAndAnalysis(ctx, enterprise, settings)
+}
+func (e *EnterpriseService) UpdateEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64, updateReq github.UpdateEnterpriseRunnerGroupRequest) (*github.EnterpriseRunnerGroup, *github.Response, error) {
+	return e.f.UpdateEnterpriseRunnerGroup(ctx, enterprise, groupID, updateReq)
+}
+
+// NewEnterpriseService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewEnterpriseService(f *EnterpriseServiceF) *EnterpriseService {
+	var table EnterpriseServiceF
+	if f != nil {
+		table = *f
+	}
+	return &EnterpriseService{f: &table}
+}
+
+func newEnterpriseServicePassthrough(client *github.Client) *EnterpriseService {
+	return NewEnterpriseService(&EnterpriseServiceF{
+		AddOrganizationAccessRunnerGroup:    client.Enterprise.AddOrganizationAccessRunnerGroup,
+		AddRunnerGroupRunners:               client.Enterprise.AddRunnerGroupRunners,
+		CreateEnterpriseRunnerGroup:         client.Enterprise.CreateEnterpriseRunnerGroup,
+		CreateRegistrationToken:             client.Enterprise.CreateRegistrationToken,
+		DeleteEnterpriseRunnerGroup:         client.Enterprise.DeleteEnterpriseRunnerGroup,
+		EnableDisableSecurityFeature:        client.Enterprise.EnableDisableSecurityFeature,
+		GenerateEnterpriseJITConfig:         client.Enterprise.GenerateEnterpriseJITConfig,
+		GetAuditLog:                         client.Enterprise.GetAuditLog,
+		GetCodeSecurityAndAnalysis:          client.Enterprise.GetCodeSecurityAndAnalysis,
+		GetEnterpriseRunnerGroup:            client.Enterprise.GetEnterpriseRunnerGroup,
+		ListOrganizationAccessRunnerGroup:   client.Enterprise.ListOrganizationAccessRunnerGroup,
+		ListRunnerApplicationDownloads:      client.Enterprise.ListRunnerApplicationDownloads,
+		ListRunnerGroupRunners:              client.Enterprise.ListRunnerGroupRunners,
+		ListRunnerGroups:                    client.Enterprise.ListRunnerGroups,
+		ListRunners:                         client.Enterprise.ListRunners,
+		RemoveOrganizationAccessRunnerGroup: client.Enterprise.RemoveOrganizationAccessRunnerGroup,
+		RemoveRunner:                        client.Enterprise.RemoveRunner,
+		RemoveRunnerGroupRunners:            client.Enterprise.RemoveRunnerGroupRunners,
+		SetOrganizationAccessRunnerGroup:    client.Enterprise.SetOrganizationAccessRunnerGroup,
+		SetRunnerGroupRunners:               client.Enterprise.SetRunnerGroupRunners,
+		UpdateCodeSecurityAndAnalysis:       client.Enterprise.UpdateCodeSecurityAndAnalysis,
+		UpdateEnterpriseRunnerGroup:         client.Enterprise.UpdateEnterpriseRunnerGroup,
+	})
+}
+
+type GistsServiceF struct {
+	Create        func(ctx context.Context, gist *github.Gist) (*github.Gist, *github.Response, error)
+	CreateComment func(ctx context.Context, gistID string, comment *github.GistComment) (*github.GistComment, *github.Response, error)
+	Delete        func(ctx context.Context, id string) (*github.Response, error)
+	DeleteComment func(ctx context.Context, gistID string, commentID int64) (*github.Response, error)
+	Edit          func(ctx context.Context, id string, gist *github.Gist) (*github.Gist, *github.Response, error)
+	EditComment   func(ctx context.Context, gistID string, commentID int64, comment *github.GistComment) (*github.GistComment, *github.Response, error)
+	Fork          func(ctx context.Context, id string) (*github.Gist, *github.Response, error)
+	Get           func(ctx context.Context, id string) (*github.Gist, *github.Response, error)
+	GetComment    func(ctx context.Context, gistID string, commentID int64) (*github.GistComment, *github.Response, error)
+	GetRevision   func(ctx context.Context, id string, sha string) (*github.Gist, *github.Response, error)
+	IsStarred     func(ctx context.Context, id string) (bool, *github.Response, error)
+	List          func(ctx context.Context, user string, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error)
+	ListAll       func(ctx context.Context, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error)
+	ListComments  func(ctx context.Context, gistID string, opts *github.ListOptions) ([]*github.GistComment, *github.Response, error)
+	ListCommits   func(ctx context.Context, id string, opts *github.ListOptions) ([]*github.GistCommit, *github.Response, error)
+	ListForks     func(ctx context.Context, id string, opts *github.ListOptions) ([]*github.GistFork, *github.Response, error)
+	ListStarred   func(ctx context.Context, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error)
+	Star          func(ctx context.Context, id string) (*github.Response, error)
+	Unstar        func(ctx context.Context, id string) (*github.Response, error)
+}
+
+type GistsService struct {
+	f *GistsServiceF
+}
+
+func (g *GistsService) Create(ctx context.Context, gist *github.Gist) (*github.Gist, *github.Response, error) {
+	return g.f.Create(ctx, gist)
+}
+func (g *GistsService) CreateComment(ctx context.Context, gistID string, comment *github.GistComment) (*github.GistComment, *github.Response, error) {
+	return g.f.CreateComment(ctx, gistID, comment)
+}
+func (g *GistsService) Delete(ctx context.Context, id string) (*github.Response, error) {
+	return g.f.Delete(ctx, id)
+}
+func (g *GistsService) DeleteComment(ctx context.Context, gistID string, commentID int64) (*github.Response, error) {
+	return g.f.DeleteComment(ctx, gistID, commentID)
+}
+func (g *GistsService) Edit(ctx context.Context, id string, gist *github.Gist) (*github.Gist, *github.Response, error) {
+	return g.f.Edit(ctx, id, gist)
+}
+func (g *GistsService) EditComment(ctx context.Context, gistID string, commentID int64, comment *github.GistComment) (*github.GistComment, *github.Response, error) {
+	return g.f.EditComment(ctx, gistID, commentID, comment)
+}
+func (g *GistsService) Fork(ctx context.Context, id string) (*github.Gist, *github.Response, error) {
+	return g.f.Fork(ctx, id)
+}
+func (g *GistsService) Get(ctx context.Context, id string) (*github.Gist, *github.Response, error) {
+	return g.f.Get(ctx, id)
+}
+func (g *GistsService) GetComment(ctx context.Context, gistID string, commentID int64) (*github.GistComment, *github.Response, error) {
+	return g.f.GetComment(ctx, gistID, commentID)
+}
+func (g *GistsService) GetRevision(ctx context.Context, id string, sha string) (*github.Gist, *github.Response, error) {
+	return g.f.GetRevision(ctx, id, sha)
+}
+func (g *GistsService) IsStarred(ctx context.Context, id string) (bool, *github.Response, error) {
+	return g.f.IsStarred(ctx, id)
+}
+func (g *GistsService) List(ctx context.Context, user string, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error) {
+	return g.f.List(ctx, user, opts)
+}
+func (g *GistsService) ListAll(ctx context.Context, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error) {
+	return g.f.ListAll(ctx, opts)
+}
+func (g *GistsService) ListComments(ctx context.Context, gistID string, opts *github.ListOptions) ([]*github.GistComment, *github.Response, error) {
+	return g.f.ListComments(ctx, gistID, opts)
+}
+func (g *GistsService) ListCommits(ctx context.Context, id string, opts *github.ListOptions) ([]*github.GistCommit, *github.Response, error) {
+	return g.f.ListCommits(ctx, id, opts)
+}
+func (g *GistsService) ListForks(ctx context.Context, id string, opts *github.ListOptions) ([]*github.GistFork, *github.Response, error) {
+	return g.f.ListForks(ctx, id, opts)
+}
+func (g *GistsService) ListStarred(ctx context.Context, opts *github.GistListOptions) ([]*github.Gist, *github.Response, error) {
+	return g.f.ListStarred(ctx, opts)
+}
+func (g *GistsService) Star(ctx context.Context, id string) (*github.Response, error) {
+	return g.f.Star(ctx, id)
+}
+func (g *GistsService) Unstar(ctx context.Context, id string) (*github.Response, error) {
+	return g.f.Unstar(ctx, id)
+}
+
+// NewGistsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewGistsService(f *GistsServiceF) *GistsService {
+	var table GistsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &GistsService{f: &table}
+}
+
+func newGistsServicePassthrough(client *github.Client) *GistsService {
+	return NewGistsService(&GistsServiceF{
+		Create:        client.Gists.Create,
+		CreateComment: client.Gists.CreateComment,
+		Delete:        client.Gists.Delete,
+		DeleteComment: client.Gists.DeleteComment,
+		Edit:          client.Gists.Edit,
+		EditComment:   client.Gists.EditComment,
+		Fork:          client.Gists.Fork,
+		Get:           client.Gists.Get,
+		GetComment:    client.Gists.GetComment,
+		GetRevision:   client.Gists.GetRevision,
+		IsStarred:     client.Gists.IsStarred,
+		List:          client.Gists.List,
+		ListAll:       client.Gists.ListAll,
+		ListComments:  client.Gists.ListComments,
+		ListCommits:   client.Gists.ListCommits,
+		ListForks:     client.Gists.ListForks,
+		ListStarred:   client.Gists.ListStarred,
+		Star:          client.Gists.Star,
+		Unstar:        client.Gists.Unstar,
+	})
+}
+
+type GitServiceF struct {
+	CreateBlob       func(ctx context.Context, owner string, repo string, blob *github.Blob) (*github.Blob, *github.Response, error)
+	CreateCommit     func(ctx context.Context, owner string, repo string, commit *github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error)
+	CreateRef        func(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error)
+	CreateTag        func(ctx context.Context, owner string, repo string, tag *github.Tag) (*github.Tag, *github.Response, error)
+	CreateTree       func(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error)
+	DeleteRef        func(ctx context.Context, owner string, repo string, ref string) (*github.Response, error)
+	GetBlob          func(ctx context.Context, owner string, repo string, sha string) (*github.Blob, *github.Response, error)
+	GetBlobRaw       func(ctx context.Context, owner string, repo string, sha string) ([]byte, *github.Response, error)
+	GetCommit        func(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error)
+	GetRef           func(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error)
+	GetTag           func(ctx context.Context, owner string, repo string, sha string) (*github.Tag, *github.Response, error)
+	GetTree          func(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error)
+	ListMatchingRefs func(ctx context.Context, owner string, repo string, opts *github.ReferenceListOptions) ([]*github.Reference, *github.Response, error)
+	UpdateRef        func(ctx context.Context, owner string, repo string, ref *github.Reference, force bool) (*github.Reference, *github.Response, error)
+}
+
+type GitService struct {
+	f *GitServiceF
+}
+
+func (g *GitService) CreateBlob(ctx context.Context, owner string, repo string, blob *github.Blob) (*github.Blob, *github.Response, error) {
+	return g.f.CreateBlob(ctx, owner, repo, blob)
+}
+func (g *GitService) CreateCommit(ctx context.Context, owner string, repo string, commit *github.Commit, opts *github.CreateCommitOptions) (*github.Commit, *github.Response, error) {
+	return g.f.CreateCommit(ctx, owner, repo, commit, opts)
+}
+func (g *GitService) CreateRef(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+	return g.f.CreateRef(ctx, owner, repo, ref)
+}
+func (g *GitService) CreateTag(ctx context.Context, owner string, repo string, tag *github.Tag) (*github.Tag, *github.Response, error) {
+	return g.f.CreateTag(ctx, owner, repo, tag)
+}
+func (g *GitService) CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []*github.TreeEntry) (*github.Tree, *github.Response, error) {
+	return g.f.CreateTree(ctx, owner, repo, baseTree, entries)
+}
+func (g *GitService) DeleteRef(ctx context.Context, owner string, repo string, ref string) (*github.Response, error) {
+	return g.f.DeleteRef(ctx, owner, repo, ref)
+}
+func (g *GitService) GetBlob(ctx context.Context, owner string, repo string, sha string) (*github.Blob, *github.Response, error) {
+	return g.f.GetBlob(ctx, owner, repo, sha)
+}
+func (g *GitService) GetBlobRaw(ctx context.Context, owner string, repo string, sha string) ([]byte, *github.Response, error) {
+	return g.f.GetBlobRaw(ctx, owner, repo, sha)
+}
+func (g *GitService) GetCommit(ctx context.Context, owner string, repo string, sha string) (*github.Commit, *github.Response, error) {
+	return g.f.GetCommit(ctx, owner, repo, sha)
+}
+func (g *GitService) GetRef(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error) {
+	return g.f.GetRef(ctx, owner, repo, ref)
+}
+func (g *GitService) GetTag(ctx context.Context, owner string, repo string, sha string) (*github.Tag, *github.Response, error) {
+	return g.f.GetTag(ctx, owner, repo, sha)
+}
+func (g *GitService) GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*github.Tree, *github.Response, error) {
+	return g.f.GetTree(ctx, owner, repo, sha, recursive)
+}
+func (g *GitService) ListMatchingRefs(ctx context.Context, owner string, repo string, opts *github.ReferenceListOptions) ([]*github.Reference, *github.Response, error) {
+	return g.f.ListMatchingRefs(ctx, owner, repo, opts)
+}
+func (g *GitService) UpdateRef(ctx context.Context, owner string, repo string, ref *github.Reference, force bool) (*github.Reference, *github.Response, error) {
+	return g.f.UpdateRef(ctx, owner, repo, ref, force)
+}
+
+// NewGitService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewGitService(f *GitServiceF) *GitService {
+	var table GitServiceF
+	if f != nil {
+		table = *f
+	}
+	return &GitService{f: &table}
+}
+
+func newGitServicePassthrough(client *github.Client) *GitService {
+	return NewGitService(&GitServiceF{
+		CreateBlob:       client.Git.CreateBlob,
+		CreateCommit:     client.Git.CreateCommit,
+		CreateRef:        client.Git.CreateRef,
+		CreateTag:        client.Git.CreateTag,
+		CreateTree:       client.Git.CreateTree,
+		DeleteRef:        client.Git.DeleteRef,
+		GetBlob:          client.Git.GetBlob,
+		GetBlobRaw:       client.Git.GetBlobRaw,
+		GetCommit:        client.Git.GetCommit,
+		GetRef:           client.Git.GetRef,
+		GetTag:           client.Git.GetTag,
+		GetTree:          client.Git.GetTree,
+		ListMatchingRefs: client.Git.ListMatchingRefs,
+		UpdateRef:        client.Git.UpdateRef,
+	})
+}
+
+type GitignoresServiceF struct {
+	Get  func(ctx context.Context, name string) (*github.Gitignore, *github.Response, error)
+	List func(ctx context.Context) ([]string, *github.Response, error)
+}
+
+type GitignoresService struct {
+	f *GitignoresServiceF
+}
+
+func (g *GitignoresService) Get(ctx context.Context, name string) (*github.Gitignore, *github.Response, error) {
+	return g.f.Get(ctx, name)
+}
+func (g *GitignoresService) List(ctx context.Context) ([]string, *github.Response, error) {
+	return g.f.List(ctx)
+}
+
+// NewGitignoresService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewGitignoresService(f *GitignoresServiceF) *GitignoresService {
+	var table GitignoresServiceF
+	if f != nil {
+		table = *f
+	}
+	return &GitignoresService{f: &table}
+}
+
+func newGitignoresServicePassthrough(client *github.Client) *GitignoresService {
+	return NewGitignoresService(&GitignoresServiceF{
+		Get:  client.Gitignores.Get,
+		List: client.Gitignores.List,
+	})
+}
+
+type InteractionsServiceF struct {
+	GetRestrictionsForOrg      func(ctx context.Context, organization string) (*github.InteractionRestriction, *github.Response, error)
+	GetRestrictionsForRepo     func(ctx context.Context, owner string, repo string) (*github.InteractionRestriction, *github.Response, error)
+	RemoveRestrictionsFromOrg  func(ctx context.Context, organization string) (*github.Response, error)
+	RemoveRestrictionsFromRepo func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	UpdateRestrictionsForOrg   func(ctx context.Context, organization string, limit string) (*github.InteractionRestriction, *github.Response, error)
+	UpdateRestrictionsForRepo  func(ctx context.Context, owner string, repo string, limit string) (*github.InteractionRestriction, *github.Response, error)
+}
+
+type InteractionsService struct {
+	f *InteractionsServiceF
+}
+
+func (i *InteractionsService) GetRestrictionsForOrg(ctx context.Context, organization string) (*github.InteractionRestriction, *github.Response, error) {
+	return i.f.GetRestrictionsForOrg(ctx, organization)
+}
+func (i *InteractionsService) GetRestrictionsForRepo(ctx context.Context, owner string, repo string) (*github.InteractionRestriction, *github.Response, error) {
+	return i.f.GetRestrictionsForRepo(ctx, owner, repo)
+}
+func (i *InteractionsService) RemoveRestrictionsFromOrg(ctx context.Context, organization string) (*github.Response, error) {
+	return i.f.RemoveRestrictionsFromOrg(ctx, organization)
+}
+func (i *InteractionsService) RemoveRestrictionsFromRepo(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return i.f.RemoveRestrictionsFromRepo(ctx, owner, repo)
+}
+func (i *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, organization string, limit string) (*github.InteractionRestriction, *github.Response, error) {
+	return i.f.UpdateRestrictionsForOrg(ctx, organization, limit)
+}
+func (i *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, owner string, repo string, limit string) (*github.InteractionRestriction, *github.Response, error) {
+	return i.f.UpdateRestrictionsForRepo(ctx, owner, repo, limit)
+}
+
+// NewInteractionsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewInteractionsService(f *InteractionsServiceF) *InteractionsService {
+	var table InteractionsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &InteractionsService{f: &table}
+}
+
+func newInteractionsServicePassthrough(client *github.Client) *InteractionsService {
+	return NewInteractionsService(&InteractionsServiceF{
+		GetRestrictionsForOrg:      client.Interactions.GetRestrictionsForOrg,
+		GetRestrictionsForRepo:     client.Interactions.GetRestrictionsForRepo,
+		RemoveRestrictionsFromOrg:  client.Interactions.RemoveRestrictionsFromOrg,
+		RemoveRestrictionsFromRepo: client.Interactions.RemoveRestrictionsFromRepo,
+		UpdateRestrictionsForOrg:   client.Interactions.UpdateRestrictionsForOrg,
+		UpdateRestrictionsForRepo:  client.Interactions.UpdateRestrictionsForRepo,
+	})
+}
+
+type IssueImportServiceF struct {
+	CheckStatus      func(ctx context.Context, owner string, repo string, issueID int64) (*github.IssueImportResponse, *github.Response, error)
+	CheckStatusSince func(ctx context.Context, owner string, repo string, since github.Timestamp) ([]*github.IssueImportResponse, *github.Response, error)
+	Create           func(ctx context.Context, owner string, repo string, issue *github.IssueImportRequest) (*github.IssueImportResponse, *github.Response, error)
+}
+
+type IssueImportService struct {
+	f *IssueImportServiceF
+}
+
+func (i *IssueImportService) CheckStatus(ctx context.Context, owner string, repo string, issueID int64) (*github.IssueImportResponse, *github.Response, error) {
+	return i.f.CheckStatus(ctx, owner, repo, issueID)
+}
+func (i *IssueImportService) CheckStatusSince(ctx context.Context, owner string, repo string, since github.Timestamp) ([]*github.IssueImportResponse, *github.Response, error) {
+	return i.f.CheckStatusSince(ctx, owner, repo, since)
+}
+func (i *IssueImportService) Create(ctx context.Context, owner string, repo string, issue *github.IssueImportRequest) (*github.IssueImportResponse, *github.Response, error) {
+	return i.f.Create(ctx, owner, repo, issue)
+}
+
+// NewIssueImportService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewIssueImportService(f *IssueImportServiceF) *IssueImportService {
+	var table IssueImportServiceF
+	if f != nil {
+		table = *f
+	}
+	return &IssueImportService{f: &table}
+}
+
+func newIssueImportServicePassthrough(client *github.Client) *IssueImportService {
+	return NewIssueImportService(&IssueImportServiceF{
+		CheckStatus:      client.IssueImport.CheckStatus,
+		CheckStatusSince: client.IssueImport.CheckStatusSince,
+		Create:           client.IssueImport.Create,
+	})
+}
+
+type IssuesServiceF struct {
+	AddAssignees           func(ctx context.Context, owner string, repo string, number int, assignees []string) (*github.Issue, *github.Response, error)
+	AddLabelsToIssue       func(ctx context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error)
+	Create                 func(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	CreateComment          func(ctx context.Context, owner string, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error)
+	CreateLabel            func(ctx context.Context, owner string, repo string, label *github.Label) (*github.Label, *github.Response, error)
+	CreateMilestone        func(ctx context.Context, owner string, repo string, milestone *github.Milestone) (*github.Milestone, *github.Response, error)
+	DeleteComment          func(ctx context.Context, owner string, repo string, commentID int64) (*github.Response, error)
+	DeleteLabel            func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	DeleteMilestone        func(ctx context.Context, owner string, repo string, number int) (*github.Response, error)
+	Edit                   func(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	EditComment            func(ctx context.Context, owner string, repo string, commentID int64, comment *github.IssueComment) (*github.IssueComment, *github.Response, error)
+	EditLabel              func(ctx context.Context, owner string, repo string, name string, label *github.Label) (*github.Label, *github.Response, error)
+	EditMilestone          func(ctx context.Context, owner string, repo string, number int, milestone *github.Milestone) (*github.Milestone, *github.Response, error)
+	Get                    func(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error)
+	GetComment             func(ctx context.Context, owner string, repo string, commentID int64) (*github.IssueComment, *github.Response, error)
+	GetEvent               func(ctx context.Context, owner string, repo string, id int64) (*github.IssueEvent, *github.Response, error)
+	GetLabel               func(ctx context.Context, owner string, repo string, name string) (*github.Label, *github.Response, error)
+	GetMilestone           func(ctx context.Context, owner string, repo string, number int) (*github.Milestone, *github.Response, error)
+	IsAssignee             func(ctx context.Context, owner string, repo string, user string) (bool, *github.Response, error)
+	List                   func(ctx context.Context, all bool, opts *github.IssueListOptions) ([]*github.Issue, *github.Response, error)
+	ListAssignees          func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	ListByOrg              func(ctx context.Context, org string, opts *github.IssueListOptions) ([]*github.Issue, *github.Response, error)
+	ListByRepo             func(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+	ListComments           func(ctx context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error)
+	ListIssueEvents        func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error)
+	ListIssueTimeline      func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error)
+	ListLabels             func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	ListLabelsByIssue      func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	ListLabelsForMilestone func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	ListMilestones         func(ctx context.Context, owner string, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error)
+	ListRepositoryEvents   func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error)
+	Lock                   func(ctx context.Context, owner string, repo string, number int, opts *github.LockIssueOptions) (*github.Response, error)
+	RemoveAssignees        func(ctx context.Context, owner string, repo string, number int, assignees []string) (*github.Issue, *github.Response, error)
+	RemoveLabelForIssue    func(ctx context.Context, owner string, repo string, number int, label string) (*github.Response, error)
+	RemoveLabelsForIssue   func(ctx context.Context, owner string, repo string, number int) (*github.Response, error)
+	RemoveMilestone        func(ctx context.Context, owner string, repo string, issueNumber int) (*github.Issue, *github.Response, error)
+	ReplaceLabelsForIssue  func(ctx context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error)
+	Unlock                 func(ctx context.Context, owner string, repo string, number int) (*github.Response, error)
+
+	Close            func(ctx context.Context, owner string, repo string, number int, reason StateReason) (*github.Issue, *github.Response, error)                                          `ghx:"extension"`
+	MapByRepo        func(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions, handle IssueHandler, pageOpts ...PaginatorOption) error                      `ghx:"extension"`
+	MapComments      func(ctx context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions, handle IssueCommentHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+	MapIssueTimeline func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle TimelineHandler, pageOpts ...PaginatorOption) error                  `ghx:"extension"`
+	Reopen           func(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error)                                                              `ghx:"extension"`
+	SetState         func(ctx context.Context, owner string, repo string, number int, state State, reason StateReason) (*github.Issue, *github.Response, error)                             `ghx:"extension"`
+}
+
+type IssuesService struct {
+	f *IssuesServiceF
+}
+
+func (i *IssuesService) AddAssignees(ctx context.Context, owner string, repo string, number int, assignees []string) (*github.Issue, *github.Response, error) {
+	return i.f.AddAssignees(ctx, owner, repo, number, assignees)
+}
+func (i *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+	return i.f.AddLabelsToIssue(ctx, owner, repo, number, labels)
+}
+func (i *IssuesService) Create(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	return i.f.Create(ctx, owner, repo, issue)
+}
+func (i *IssuesService) CreateComment(ctx context.Context, owner string, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error) {
+	return i.f.CreateComment(ctx, owner, repo, number, comment)
+}
+func (i *IssuesService) CreateLabel(ctx context.Context, owner string, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+	return i.f.CreateLabel(ctx, owner, repo, label)
+}
+func (i *IssuesService) CreateMilestone(ctx context.Context, owner string, repo string, milestone *github.Milestone) (*github.Milestone, *github.Response, error) {
+	return i.f.CreateMilestone(ctx, owner, repo, milestone)
+}
+func (i *IssuesService) DeleteComment(ctx context.Context, owner string, repo string, commentID int64) (*github.Response, error) {
+	return i.f.DeleteComment(ctx, owner, repo, commentID)
+}
+func (i *IssuesService) DeleteLabel(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return i.f.DeleteLabel(ctx, owner, repo, name)
+}
+func (i *IssuesService) DeleteMilestone(ctx context.Context, owner string, repo string, number int) (*github.Response, error) {
+	return i.f.DeleteMilestone(ctx, owner, repo, number)
+}
+func (i *IssuesService) Edit(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	return i.f.Edit(ctx, owner, repo, number, issue)
+}
+func (i *IssuesService) EditComment(ctx context.Context, owner string, repo string, commentID int64, comment *github.IssueComment) (*github.IssueComment, *github.Response, error) {
+	return i.f.EditComment(ctx, owner, repo, commentID, comment)
+}
+func (i *IssuesService) EditLabel(ctx context.Context, owner string, repo string, name string, label *github.Label) (*github.Label, *github.Response, error) {
+	return i.f.EditLabel(ctx, owner, repo, name, label)
+}
+func (i *IssuesService) EditMilestone(ctx context.Context, owner string, repo string, number int, milestone *github.Milestone) (*github.Milestone, *github.Response, error) {
+	return i.f.EditMilestone(ctx, owner, repo, number, milestone)
+}
+func (i *IssuesService) Get(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+	return i.f.Get(ctx, owner, repo, number)
+}
+func (i *IssuesService) GetComment(ctx context.Context, owner string, repo string, commentID int64) (*github.IssueComment, *github.Response, error) {
+	return i.f.GetComment(ctx, owner, repo, commentID)
+}
+func (i *IssuesService) GetEvent(ctx context.Context, owner string, repo string, id int64) (*github.IssueEvent, *github.Response, error) {
+	return i.f.GetEvent(ctx, owner, repo, id)
+}
+func (i *IssuesService) GetLabel(ctx context.Context, owner string, repo string, name string) (*github.Label, *github.Response, error) {
+	return i.f.GetLabel(ctx, owner, repo, name)
+}
+func (i *IssuesService) GetMilestone(ctx context.Context, owner string, repo string, number int) (*github.Milestone, *github.Response, error) {
+	return i.f.GetMilestone(ctx, owner, repo, number)
+}
+func (i *IssuesService) IsAssignee(ctx context.Context, owner string, repo string, user string) (bool, *github.Response, error) {
+	return i.f.IsAssignee(ctx, owner, repo, user)
+}
+func (i *IssuesService) List(ctx context.Context, all bool, opts *github.IssueListOptions) ([]*github.Issue, *github.Response, error) {
+	return i.f.List(ctx, all, opts)
+}
+func (i *IssuesService) ListAssignees(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return i.f.ListAssignees(ctx, owner, repo, opts)
+}
+func (i *IssuesService) ListByOrg(ctx context.Context, org string, opts *github.IssueListOptions) ([]*github.Issue, *github.Response, error) {
+	return i.f.ListByOrg(ctx, org, opts)
+}
+func (i *IssuesService) ListByRepo(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return i.f.ListByRepo(ctx, owner, repo, opts)
+}
+func (i *IssuesService) ListComments(ctx context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+	return i.f.ListComments(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) ListIssueEvents(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error) {
+	return i.f.ListIssueEvents(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) ListIssueTimeline(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+	return i.f.ListIssueTimeline(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) ListLabels(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return i.f.ListLabels(ctx, owner, repo, opts)
+}
+func (i *IssuesService) ListLabelsByIssue(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return i.f.ListLabelsByIssue(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) ListLabelsForMilestone(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return i.f.ListLabelsForMilestone(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) ListMilestones(ctx context.Context, owner string, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error) {
+	return i.f.ListMilestones(ctx, owner, repo, opts)
+}
+func (i *IssuesService) ListRepositoryEvents(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.IssueEvent, *github.Response, error) {
+	return i.f.ListRepositoryEvents(ctx, owner, repo, opts)
+}
+func (i *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, opts *github.LockIssueOptions) (*github.Response, error) {
+	return i.f.Lock(ctx, owner, repo, number, opts)
+}
+func (i *IssuesService) RemoveAssignees(ctx context.Context, owner string, repo string, number int, assignees []string) (*github.Issue, *github.Response, error) {
+	return i.f.RemoveAssignees(ctx, owner, repo, number, assignees)
+}
+func (i *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, repo string, number int, label string) (*github.Response, error) {
+	return i.f.RemoveLabelForIssue(ctx, owner, repo, number, label)
+}
+func (i *IssuesService) RemoveLabelsForIssue(ctx context.Context, owner string, repo string, number int) (*github.Response, error) {
+	return i.f.RemoveLabelsForIssue(ctx, owner, repo, number)
+}
+func (i *IssuesService) RemoveMilestone(ctx context.Context, owner string, repo string, issueNumber int) (*github.Issue, *github.Response, error) {
+	return i.f.RemoveMilestone(ctx, owner, repo, issueNumber)
+}
+func (i *IssuesService) ReplaceLabelsForIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+	return i.f.ReplaceLabelsForIssue(ctx, owner, repo, number, labels)
+}
+func (i *IssuesService) Unlock(ctx context.Context, owner string, repo string, number int) (*github.Response, error) {
+	return i.f.Unlock(ctx, owner, repo, number)
+}
+
+func (i *IssuesService) Close(ctx context.Context, owner string, repo string, number int, reason StateReason) (*github.Issue, *github.Response, error) {
+	return i.f.Close(ctx, owner, repo, number, reason)
+}
+func (i *IssuesService) MapByRepo(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions, handle IssueHandler, pageOpts ...PaginatorOption) error {
+	return i.f.MapByRepo(ctx, owner, repo, opts, handle, pageOpts...)
+}
+func (i *IssuesService) MapComments(ctx context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions, handle IssueCommentHandler, pageOpts ...PaginatorOption) error {
+	return i.f.MapComments(ctx, owner, repo, number, opts, handle, pageOpts...)
+}
+func (i *IssuesService) MapIssueTimeline(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle TimelineHandler, pageOpts ...PaginatorOption) error {
+	return i.f.MapIssueTimeline(ctx, owner, repo, number, opts, handle, pageOpts...)
+}
+func (i *IssuesService) Reopen(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+	return i.f.Reopen(ctx, owner, repo, number)
+}
+func (i *IssuesService) SetState(ctx context.Context, owner string, repo string, number int, state State, reason StateReason) (*github.Issue, *github.Response, error) {
+	return i.f.SetState(ctx, owner, repo, number, state, reason)
+}
+
+// NewIssuesService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewIssuesService(f *IssuesServiceF) *IssuesService {
+	var table IssuesServiceF
+	if f != nil {
+		table = *f
+	}
+	i := &IssuesService{f: &table}
+	if table.Close == nil {
+		table.Close = newCloseF(i)
+	}
+	if table.MapByRepo == nil {
+		table.MapByRepo = newMapByRepoF(i)
+	}
+	if table.MapComments == nil {
+		table.MapComments = newMapCommentsF(i)
+	}
+	if table.MapIssueTimeline == nil {
+		table.MapIssueTimeline = newMapIssueTimelineF(i)
+	}
+	if table.Reopen == nil {
+		table.Reopen = newReopenF(i)
+	}
+	if table.SetState == nil {
+		table.SetState = newSetStateF(i)
+	}
+	return i
+}
+
+func newIssuesServicePassthrough(client *github.Client) *IssuesService {
+	return NewIssuesService(&IssuesServiceF{
+		AddAssignees:           client.Issues.AddAssignees,
+		AddLabelsToIssue:       client.Issues.AddLabelsToIssue,
+		Create:                 client.Issues.Create,
+		CreateComment:          client.Issues.CreateComment,
+		CreateLabel:            client.Issues.CreateLabel,
+		CreateMilestone:        client.Issues.CreateMilestone,
+		DeleteComment:          client.Issues.DeleteComment,
+		DeleteLabel:            client.Issues.DeleteLabel,
+		DeleteMilestone:        client.Issues.DeleteMilestone,
+		Edit:                   client.Issues.Edit,
+		EditComment:            client.Issues.EditComment,
+		EditLabel:              client.Issues.EditLabel,
+		EditMilestone:          client.Issues.EditMilestone,
+		Get:                    client.Issues.Get,
+		GetComment:             client.Issues.GetComment,
+		GetEvent:               client.Issues.GetEvent,
+		GetLabel:               client.Issues.GetLabel,
+		GetMilestone:           client.Issues.GetMilestone,
+		IsAssignee:             client.Issues.IsAssignee,
+		List:                   client.Issues.List,
+		ListAssignees:          client.Issues.ListAssignees,
+		ListByOrg:              client.Issues.ListByOrg,
+		ListByRepo:             client.Issues.ListByRepo,
+		ListComments:           client.Issues.ListComments,
+		ListIssueEvents:        client.Issues.ListIssueEvents,
+		ListIssueTimeline:      client.Issues.ListIssueTimeline,
+		ListLabels:             client.Issues.ListLabels,
+		ListLabelsByIssue:      client.Issues.ListLabelsByIssue,
+		ListLabelsForMilestone: client.Issues.ListLabelsForMilestone,
+		ListMilestones:         client.Issues.ListMilestones,
+		ListRepositoryEvents:   client.Issues.ListRepositoryEvents,
+		Lock:                   client.Issues.Lock,
+		RemoveAssignees:        client.Issues.RemoveAssignees,
+		RemoveLabelForIssue:    client.Issues.RemoveLabelForIssue,
+		RemoveLabelsForIssue:   client.Issues.RemoveLabelsForIssue,
+		RemoveMilestone:        client.Issues.RemoveMilestone,
+		ReplaceLabelsForIssue:  client.Issues.ReplaceLabelsForIssue,
+		Unlock:                 client.Issues.Unlock,
+	})
+}
+
+type LicensesServiceF struct {
+	Get  func(ctx context.Context, licenseName string) (*github.License, *github.Response, error)
+	List func(ctx context.Context) ([]*github.License, *github.Response, error)
+}
+
+type LicensesService struct {
+	f *LicensesServiceF
+}
+
+func (l *LicensesService) Get(ctx context.Context, licenseName string) (*github.License, *github.Response, error) {
+	return l.f.Get(ctx, licenseName)
+}
+func (l *LicensesService) List(ctx context.Context) ([]*github.License, *github.Response, error) {
+	return l.f.List(ctx)
+}
+
+// NewLicensesService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewLicensesService(f *LicensesServiceF) *LicensesService {
+	var table LicensesServiceF
+	if f != nil {
+		table = *f
+	}
+	return &LicensesService{f: &table}
+}
+
+func newLicensesServicePassthrough(client *github.Client) *LicensesService {
+	return NewLicensesService(&LicensesServiceF{
+		Get:  client.Licenses.Get,
+		List: client.Licenses.List,
+	})
+}
+
+type MarkdownServiceF struct {
+	Render func(ctx context.Context, text string, opts *github.MarkdownOptions) (string, *github.Response, error)
+}
+
+type MarkdownService struct {
+	f *MarkdownServiceF
+}
+
+func (m *MarkdownService) Render(ctx context.Context, text string, opts *github.MarkdownOptions) (string, *github.Response, error) {
+	return m.f.Render(ctx, text, opts)
+}
+
+// NewMarkdownService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewMarkdownService(f *MarkdownServiceF) *MarkdownService {
+	var table MarkdownServiceF
+	if f != nil {
+		table = *f
+	}
+	return &MarkdownService{f: &table}
+}
+
+func newMarkdownServicePassthrough(client *github.Client) *MarkdownService {
+	return NewMarkdownService(&MarkdownServiceF{
+		Render: client.Markdown.Render,
+	})
+}
+
+type MarketplaceServiceF struct {
+	GetPlanAccountForAccount        func(ctx context.Context, accountID int64) (*github.MarketplacePlanAccount, *github.Response, error)
+	ListMarketplacePurchasesForUser func(ctx context.Context, opts *github.ListOptions) ([]*github.MarketplacePurchase, *github.Response, error)
+	ListPlanAccountsForPlan         func(ctx context.Context, planID int64, opts *github.ListOptions) ([]*github.MarketplacePlanAccount, *github.Response, error)
+	ListPlans                       func(ctx context.Context, opts *github.ListOptions) ([]*github.MarketplacePlan, *github.Response, error)
+}
+
+type MarketplaceService struct {
+	f *MarketplaceServiceF
+}
+
+func (m *MarketplaceService) GetPlanAccountForAccount(ctx context.Context, accountID int64) (*github.MarketplacePlanAccount, *github.Response, error) {
+	return m.f.GetPlanAccountForAccount(ctx, accountID)
+}
+func (m *MarketplaceService) ListMarketplacePurchasesForUser(ctx context.Context, opts *github.ListOptions) ([]*github.MarketplacePurchase, *github.Response, error) {
+	return m.f.ListMarketplacePurchasesForUser(ctx, opts)
+}
+func (m *MarketplaceService) ListPlanAccountsForPlan(ctx context.Context, planID int64, opts *github.ListOptions) ([]*github.MarketplacePlanAccount, *github.Response, error) {
+	return m.f.ListPlanAccountsForPlan(ctx, planID, opts)
+}
+func (m *MarketplaceService) ListPlans(ctx context.Context, opts *github.ListOptions) ([]*github.MarketplacePlan, *github.Response, error) {
+	return m.f.ListPlans(ctx, opts)
+}
+
+// NewMarketplaceService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewMarketplaceService(f *MarketplaceServiceF) *MarketplaceService {
+	var table MarketplaceServiceF
+	if f != nil {
+		table = *f
+	}
+	return &MarketplaceService{f: &table}
+}
+
+func newMarketplaceServicePassthrough(client *github.Client) *MarketplaceService {
+	return NewMarketplaceService(&MarketplaceServiceF{
+		GetPlanAccountForAccount:        client.Marketplace.GetPlanAccountForAccount,
+		ListMarketplacePurchasesForUser: client.Marketplace.ListMarketplacePurchasesForUser,
+		ListPlanAccountsForPlan:         client.Marketplace.ListPlanAccountsForPlan,
+		ListPlans:                       client.Marketplace.ListPlans,
+	})
+}
+
+type MetaServiceF struct {
+	Get     func(ctx context.Context) (*github.APIMeta, *github.Response, error)
+	Octocat func(ctx context.Context, message string) (string, *github.Response, error)
+	Zen     func(ctx context.Context) (string, *github.Response, error)
+}
+
+type MetaService struct {
+	f *MetaServiceF
+}
+
+func (m *MetaService) Get(ctx context.Context) (*github.APIMeta, *github.Response, error) {
+	return m.f.Get(ctx)
+}
+func (m *MetaService) Octocat(ctx context.Context, message string) (string, *github.Response, error) {
+	return m.f.Octocat(ctx, message)
+}
+func (m *MetaService) Zen(ctx context.Context) (string, *github.Response, error) {
+	return m.f.Zen(ctx)
+}
+
+// NewMetaService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewMetaService(f *MetaServiceF) *MetaService {
+	var table MetaServiceF
+	if f != nil {
+		table = *f
+	}
+	return &MetaService{f: &table}
+}
+
+func newMetaServicePassthrough(client *github.Client) *MetaService {
+	return NewMetaService(&MetaServiceF{
+		Get:     client.Meta.Get,
+		Octocat: client.Meta.Octocat,
+		Zen:     client.Meta.Zen,
+	})
+}
+
+type MigrationServiceF struct {
+	CancelImport            func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	CommitAuthors           func(ctx context.Context, owner string, repo string) ([]*github.SourceImportAuthor, *github.Response, error)
+	DeleteMigration         func(ctx context.Context, org string, id int64) (*github.Response, error)
+	DeleteUserMigration     func(ctx context.Context, id int64) (*github.Response, error)
+	ImportProgress          func(ctx context.Context, owner string, repo string) (*github.Import, *github.Response, error)
+	LargeFiles              func(ctx context.Context, owner string, repo string) ([]*github.LargeFile, *github.Response, error)
+	ListMigrations          func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Migration, *github.Response, error)
+	ListUserMigrations      func(ctx context.Context, opts *github.ListOptions) ([]*github.UserMigration, *github.Response, error)
+	MapCommitAuthor         func(ctx context.Context, owner string, repo string, id int64, author *github.SourceImportAuthor) (*github.SourceImportAuthor, *github.Response, error)
+	MigrationArchiveURL     func(ctx context.Context, org string, id int64) (string, error)
+	MigrationStatus         func(ctx context.Context, org string, id int64) (*github.Migration, *github.Response, error)
+	SetLFSPreference        func(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error)
+	StartImport             func(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error)
+	StartMigration          func(ctx context.Context, org string, repos []string, opts *github.MigrationOptions) (*github.Migration, *github.Response, error)
+	StartUserMigration      func(ctx context.Context, repos []string, opts *github.UserMigrationOptions) (*github.UserMigration, *github.Response, error)
+	UnlockRepo              func(ctx context.Context, org string, id int64, repo string) (*github.Response, error)
+	UnlockUserRepo          func(ctx context.Context, id int64, repo string) (*github.Response, error)
+	UpdateImport            func(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error)
+	UserMigrationArchiveURL func(ctx context.Context, id int64) (string, error)
+	UserMigrationStatus     func(ctx context.Context, id int64) (*github.UserMigration, *github.Response, error)
+}
+
+type MigrationService struct {
+	f *MigrationServiceF
+}
+
+func (m *MigrationService) CancelImport(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return m.f.CancelImport(ctx, owner, repo)
+}
+func (m *MigrationService) CommitAuthors(ctx context.Context, owner string, repo string) ([]*github.SourceImportAuthor, *github.Response, error) {
+	return m.f.CommitAuthors(ctx, owner, repo)
+}
+func (m *MigrationService) DeleteMigration(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return m.f.DeleteMigration(ctx, org, id)
+}
+func (m *MigrationService) DeleteUserMigration(ctx context.Context, id int64) (*github.Response, error) {
+	return m.f.DeleteUserMigration(ctx, id)
+}
+func (m *MigrationService) ImportProgress(ctx context.Context, owner string, repo string) (*github.Import, *github.Response, error) {
+	return m.f.ImportProgress(ctx, owner, repo)
+}
+func (m *MigrationService) LargeFiles(ctx context.Context, owner string, repo string) ([]*github.LargeFile, *github.Response, error) {
+	return m.f.LargeFiles(ctx, owner, repo)
+}
+func (m *MigrationService) ListMigrations(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Migration, *github.Response, error) {
+	return m.f.ListMigrations(ctx, org, opts)
+}
+func (m *MigrationService) ListUserMigrations(ctx context.Context, opts *github.ListOptions) ([]*github.UserMigration, *github.Response, error) {
+	return m.f.ListUserMigrations(ctx, opts)
+}
+func (m *MigrationService) MapCommitAuthor(ctx context.Context, owner string, repo string, id int64, author *github.SourceImportAuthor) (*github.SourceImportAuthor, *github.Response, error) {
+	return m.f.MapCommitAuthor(ctx, owner, repo, id, author)
+}
+func (m *MigrationService) MigrationArchiveURL(ctx context.Context, org string, id int64) (string, error) {
+	return m.f.MigrationArchiveURL(ctx, org, id)
+}
+func (m *MigrationService) MigrationStatus(ctx context.Context, org string, id int64) (*github.Migration, *github.Response, error) {
+	return m.f.MigrationStatus(ctx, org, id)
+}
+func (m *MigrationService) SetLFSPreference(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error) {
+	return m.f.SetLFSPreference(ctx, owner, repo, in)
+}
+func (m *MigrationService) StartImport(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error) {
+	return m.f.StartImport(ctx, owner, repo, in)
+}
+func (m *MigrationService) StartMigration(ctx context.Context, org string, repos []string, opts *github.MigrationOptions) (*github.Migration, *github.Response, error) {
+	return m.f.StartMigration(ctx, org, repos, opts)
+}
+func (m *MigrationService) StartUserMigration(ctx context.Context, repos []string, opts *github.UserMigrationOptions) (*github.UserMigration, *github.Response, error) {
+	return m.f.StartUserMigration(ctx, repos, opts)
+}
+func (m *MigrationService) UnlockRepo(ctx context.Context, org string, id int64, repo string) (*github.Response, error) {
+	return m.f.UnlockRepo(ctx, org, id, repo)
+}
+func (m *MigrationService) UnlockUserRepo(ctx context.Context, id int64, repo string) (*github.Response, error) {
+	return m.f.UnlockUserRepo(ctx, id, repo)
+}
+func (m *MigrationService) UpdateImport(ctx context.Context, owner string, repo string, in *github.Import) (*github.Import, *github.Response, error) {
+	return m.f.UpdateImport(ctx, owner, repo, in)
+}
+func (m *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64) (string, error) {
+	return m.f.UserMigrationArchiveURL(ctx, id)
+}
+func (m *MigrationService) UserMigrationStatus(ctx context.Context, id int64) (*github.UserMigration, *github.Response, error) {
+	return m.f.UserMigrationStatus(ctx, id)
+}
+
+// NewMigrationService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewMigrationService(f *MigrationServiceF) *MigrationService {
+	var table MigrationServiceF
+	if f != nil {
+		table = *f
+	}
+	return &MigrationService{f: &table}
+}
+
+func newMigrationServicePassthrough(client *github.Client) *MigrationService {
+	return NewMigrationService(&MigrationServiceF{
+		CancelImport:            client.Migrations.CancelImport,
+		CommitAuthors:           client.Migrations.CommitAuthors,
+		DeleteMigration:         client.Migrations.DeleteMigration,
+		DeleteUserMigration:     client.Migrations.DeleteUserMigration,
+		ImportProgress:          client.Migrations.ImportProgress,
+		LargeFiles:              client.Migrations.LargeFiles,
+		ListMigrations:          client.Migrations.ListMigrations,
+		ListUserMigrations:      client.Migrations.ListUserMigrations,
+		MapCommitAuthor:         client.Migrations.MapCommitAuthor,
+		MigrationArchiveURL:     client.Migrations.MigrationArchiveURL,
+		MigrationStatus:         client.Migrations.MigrationStatus,
+		SetLFSPreference:        client.Migrations.SetLFSPreference,
+		StartImport:             client.Migrations.StartImport,
+		StartMigration:          client.Migrations.StartMigration,
+		StartUserMigration:      client.Migrations.StartUserMigration,
+		UnlockRepo:              client.Migrations.UnlockRepo,
+		UnlockUserRepo:          client.Migrations.UnlockUserRepo,
+		UpdateImport:            client.Migrations.UpdateImport,
+		UserMigrationArchiveURL: client.Migrations.UserMigrationArchiveURL,
+		UserMigrationStatus:     client.Migrations.UserMigrationStatus,
+	})
+}
+
+type OrganizationsServiceF struct {
+	AddSecurityManagerTeam                 func(ctx context.Context, org string, team string) (*github.Response, error)
+	BlockUser                              func(ctx context.Context, org string, user string) (*github.Response, error)
+	ConcealMembership                      func(ctx context.Context, org string, user string) (*github.Response, error)
+	ConvertMemberToOutsideCollaborator     func(ctx context.Context, org string, user string) (*github.Response, error)
+	CreateCustomRepoRole                   func(ctx context.Context, org string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	CreateHook                             func(ctx context.Context, org string, hook *github.Hook) (*github.Hook, *github.Response, error)
+	CreateOrUpdateCustomProperties         func(ctx context.Context, org string, properties []*github.CustomProperty) ([]*github.CustomProperty, *github.Response, error)
+	CreateOrUpdateCustomProperty           func(ctx context.Context, org string, customPropertyName string, property *github.CustomProperty) (*github.CustomProperty, *github.Response, error)
+	CreateOrUpdateRepoCustomPropertyValues func(ctx context.Context, org string, repoNames []string, properties []*github.CustomPropertyValue) (*github.Response, error)
+	CreateOrgInvitation                    func(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error)
+	CreateOrganizationRuleset              func(ctx context.Context, org string, rs *github.Ruleset) (*github.Ruleset, *github.Response, error)
+	CreateProject                          func(ctx context.Context, org string, opts *github.ProjectOptions) (*github.Project, *github.Response, error)
+	Delete                                 func(ctx context.Context, org string) (*github.Response, error)
+	DeleteCustomRepoRole                   func(ctx context.Context, org string, roleID string) (*github.Response, error)
+	DeleteHook                             func(ctx context.Context, org string, id int64) (*github.Response, error)
+	DeleteOrganizationRuleset              func(ctx context.Context, org string, rulesetID int64) (*github.Response, error)
+	DeletePackage                          func(ctx context.Context, org string, packageType string, packageName string) (*github.Response, error)
+	Edit                                   func(ctx context.Context, name string, org *github.Organization) (*github.Organization, *github.Response, error)
+	EditActionsAllowed                     func(ctx context.Context, org string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error)
+	EditActionsPermissions                 func(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error)
+	EditHook                               func(ctx context.Context, org string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error)
+	EditHookConfiguration                  func(ctx context.Context, org string, id int64, config *github.HookConfig) (*github.HookConfig, *github.Response, error)
+	EditOrgMembership                      func(ctx context.Context, user string, org string, membership *github.Membership) (*github.Membership, *github.Response, error)
+	Get                                    func(ctx context.Context, org string) (*github.Organization, *github.Response, error)
+	GetActionsAllowed                      func(ctx context.Context, org string) (*github.ActionsAllowed, *github.Response, error)
+	GetActionsPermissions                  func(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error)
+	GetAllCustomProperties                 func(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error)
+	GetAllOrganizationRulesets             func(ctx context.Context, org string) ([]*github.Ruleset, *github.Response, error)
+	GetAuditLog                            func(ctx context.Context, org string, opts *github.GetAuditLogOptions) ([]*github.AuditEntry, *github.Response, error)
+	GetByID                                func(ctx context.Context, id int64) (*github.Organization, *github.Response, error)
+	GetCustomProperty                      func(ctx context.Context, org string, name string) (*github.CustomProperty, *github.Response, error)
+	GetHook                                func(ctx context.Context, org string, id int64) (*github.Hook, *github.Response, error)
+	GetHookConfiguration                   func(ctx context.Context, org string, id int64) (*github.HookConfig, *github.Response, error)
+	GetHookDelivery                        func(ctx context.Context, owner string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	GetOrgMembership                       func(ctx context.Context, user string, org string) (*github.Membership, *github.Response, error)
+	GetOrganizationRuleset                 func(ctx context.Context, org string, rulesetID int64) (*github.Ruleset, *github.Response, error)
+	GetPackage                             func(ctx context.Context, org string, packageType string, packageName string) (*github.Package, *github.Response, error)
+	IsBlocked                              func(ctx context.Context, org string, user string) (bool, *github.Response, error)
+	IsMember                               func(ctx context.Context, org string, user string) (bool, *github.Response, error)
+	IsPublicMember                         func(ctx context.Context, org string, user string) (bool, *github.Response, error)
+	List                                   func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Organization, *github.Response, error)
+	ListAll                                func(ctx context.Context, opts *github.OrganizationsListOptions) ([]*github.Organization, *github.Response, error)
+	ListBlockedUsers                       func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	ListCredentialAuthorizations           func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.CredentialAuthorization, *github.Response, error)
+	ListCustomPropertyValues               func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.RepoCustomPropertyValue, *github.Response, error)
+	ListCustomRepoRoles                    func(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error)
+	ListFailedOrgInvitations               func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error)
+	ListHookDeliveries                     func(ctx context.Context, org string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
+	ListHooks                              func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error)
+	ListInstallations                      func(ctx context.Context, org string, opts *github.ListOptions) (*github.OrganizationInstallations, *github.Response, error)
+	ListMembers                            func(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error)
+	ListOrgInvitationTeams                 func(ctx context.Context, org string, invitationID string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListOrgMemberships                     func(ctx context.Context, opts *github.ListOrgMembershipsOptions) ([]*github.Membership, *github.Response, error)
+	ListOutsideCollaborators               func(ctx context.Context, org string, opts *github.ListOutsideCollaboratorsOptions) ([]*github.User, *github.Response, error)
+	ListPackages                           func(ctx context.Context, org string, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error)
+	ListPendingOrgInvitations              func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error)
+	ListProjects                           func(ctx context.Context, org string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error)
+	ListSecurityManagerTeams               func(ctx context.Context, org string) ([]*github.Team, *github.Response, error)
+	ListTeamsAssignedToOrgRole             func(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListUsersAssignedToOrgRole             func(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	PackageDeleteVersion                   func(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.Response, error)
+	PackageGetAllVersions                  func(ctx context.Context, org string, packageType string, packageName string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error)
+	PackageGetVersion                      func(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.PackageVersion, *github.Response, error)
+	PackageRestoreVersion                  func(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.Response, error)
+	PingHook                               func(ctx context.Context, org string, id int64) (*github.Response, error)
+	PublicizeMembership                    func(ctx context.Context, org string, user string) (*github.Response, error)
+	RedeliverHookDelivery                  func(ctx context.Context, owner string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	RemoveCredentialAuthorization          func(ctx context.Context, org string, credentialID int64) (*github.Response, error)
+	RemoveCustomProperty                   func(ctx context.Context, org string, customPropertyName string) (*github.Response, error)
+	RemoveMember                           func(ctx context.Context, org string, user string) (*github.Response, error)
+	RemoveOrgMembership                    func(ctx context.Context, user string, org string) (*github.Response, error)
+	RemoveOutsideCollaborator              func(ctx context.Context, org string, user string) (*github.Response, error)
+	RemoveSecurityManagerTeam              func(ctx context.Context, org string, team string) (*github.Response, error)
+	RestorePackage                         func(ctx context.Context, org string, packageType string, packageName string) (*github.Response, error)
+	ReviewPersonalAccessTokenRequest       func(ctx context.Context, org string, requestID int64, opts github.ReviewPersonalAccessTokenRequestOptions) (*github.Response, error)
+	UnblockUser                            func(ctx context.Context, org string, user string) (*github.Response, error)
+	UpdateCustomRepoRole                   func(ctx context.Context, org string, roleID string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	UpdateOrganizationRuleset              func(ctx context.Context, org string, rulesetID int64, rs *github.Ruleset) (*github.Ruleset, *github.Response, error)
+
+	MapMembers func(ctx context.Context, org string, opts *github.ListMembersOptions, handle UserHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+}
+
+type OrganizationsService struct {
+	f *OrganizationsServiceF
+}
+
+func (o *OrganizationsService) AddSecurityManagerTeam(ctx context.Context, org string, team string) (*github.Response, error) {
+	return o.f.AddSecurityManagerTeam(ctx, org, team)
+}
+func (o *OrganizationsService) BlockUser(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.BlockUser(ctx, org, user)
+}
+func (o *OrganizationsService) ConcealMembership(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.ConcealMembership(ctx, org, user)
+}
+func (o *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.ConvertMemberToOutsideCollaborator(ctx, org, user)
+}
+func (o *OrganizationsService) CreateCustomRepoRole(ctx context.Context, org string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error) {
+	return o.f.CreateCustomRepoRole(ctx, org, opts)
+}
+func (o *OrganizationsService) CreateHook(ctx context.Context, org string, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	return o.f.CreateHook(ctx, org, hook)
+}
+func (o *OrganizationsService) CreateOrUpdateCustomProperties(ctx context.Context, org string, properties []*github.CustomProperty) ([]*github.CustomProperty, *github.Response, error) {
+	return o.f.CreateOrUpdateCustomProperties(ctx, org, properties)
+}
+func (o *OrganizationsService) CreateOrUpdateCustomProperty(ctx context.Context, org string, customPropertyName string, property *github.CustomProperty) (*github.CustomProperty, *github.Response, error) {
+	return o.f.CreateOrUpdateCustomProperty(ctx, org, customPropertyName, property)
+}
+func (o *OrganizationsService) CreateOrUpdateRepoCustomPropertyValues(ctx context.Context, org string, repoNames []string, properties []*github.CustomPropertyValue) (*github.Response, error) {
+	return o.f.CreateOrUpdateRepoCustomPropertyValues(ctx, org, repoNames, properties)
+}
+func (o *OrganizationsService) CreateOrgInvitation(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error) {
+	return o.f.CreateOrgInvitation(ctx, org, opts)
+}
+func (o *OrganizationsService) CreateOrganizationRuleset(ctx context.Context, org string, rs *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+	return o.f.CreateOrganizationRuleset(ctx, org, rs)
+}
+func (o *OrganizationsService) CreateProject(ctx context.Context, org string, opts *github.ProjectOptions) (*github.Project, *github.Response, error) {
+	return o.f.CreateProject(ctx, org, opts)
+}
+func (o *OrganizationsService) Delete(ctx context.Context, org string) (*github.Response, error) {
+	return o.f.Delete(ctx, org)
+}
+func (o *OrganizationsService) DeleteCustomRepoRole(ctx context.Context, org string, roleID string) (*github.Response, error) {
+	return o.f.DeleteCustomRepoRole(ctx, org, roleID)
+}
+func (o *OrganizationsService) DeleteHook(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return o.f.DeleteHook(ctx, org, id)
+}
+func (o *OrganizationsService) DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*github.Response, error) {
+	return o.f.DeleteOrganizationRuleset(ctx, org, rulesetID)
+}
+func (o *OrganizationsService) DeletePackage(ctx context.Context, org string, packageType string, packageName string) (*github.Response, error) {
+	return o.f.DeletePackage(ctx, org, packageType, packageName)
+}
+func (o *OrganizationsService) Edit(ctx context.Context, name string, org *github.Organization) (*github.Organization, *github.Response, error) {
+	return o.f.Edit(ctx, name, org)
+}
+func (o *OrganizationsService) EditActionsAllowed(ctx context.Context, org string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error) {
+	return o.f.EditActionsAllowed(ctx, org, actionsAllowed)
+}
+func (o *OrganizationsService) EditActionsPermissions(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error) {
+	return o.f.EditActionsPermissions(ctx, org, actionsPermissions)
+}
+func (o *OrganizationsService) EditHook(ctx context.Context, org string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	return o.f.EditHook(ctx, org, id, hook)
+}
+func (o *OrganizationsService) EditHookConfiguration(ctx context.Context, org string, id int64, config *github.HookConfig) (*github.HookConfig, *github.Response, error) {
+	return o.f.EditHookConfiguration(ctx, org, id, config)
+}
+func (o *OrganizationsService) EditOrgMembership(ctx context.Context, user string, org string, membership *github.Membership) (*github.Membership, *github.Response, error) {
+	return o.f.EditOrgMembership(ctx, user, org, membership)
+}
+func (o *OrganizationsService) Get(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+	return o.f.Get(ctx, org)
+}
+func (o *OrganizationsService) GetActionsAllowed(ctx context.Context, org string) (*github.ActionsAllowed, *github.Response, error) {
+	return o.f.GetActionsAllowed(ctx, org)
+}
+func (o *OrganizationsService) GetActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error) {
+	return o.f.GetActionsPermissions(ctx, org)
+}
+func (o *OrganizationsService) GetAllCustomProperties(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error) {
+	return o.f.GetAllCustomProperties(ctx, org)
+}
+func (o *OrganizationsService) GetAllOrganizationRulesets(ctx context.Context, org string) ([]*github.Ruleset, *github.Response, error) {
+	return o.f.GetAllOrganizationRulesets(ctx, org)
+}
+func (o *OrganizationsService) GetAuditLog(ctx context.Context, org string, opts *github.GetAuditLogOptions) ([]*github.AuditEntry, *github.Response, error) {
+	return o.f.GetAuditLog(ctx, org, opts)
+}
+func (o *OrganizationsService) GetByID(ctx context.Context, id int64) (*github.Organization, *github.Response, error) {
+	return o.f.GetByID(ctx, id)
+}
+func (o *OrganizationsService) GetCustomProperty(ctx context.Context, org string, name string) (*github.CustomProperty, *github.Response, error) {
+	return o.f.GetCustomProperty(ctx, org, name)
+}
+func (o *OrganizationsService) GetHook(ctx context.Context, org string, id int64) (*github.Hook, *github.Response, error) {
+	return o.f.GetHook(ctx, org, id)
+}
+func (o *OrganizationsService) GetHookConfiguration(ctx context.Context, org string, id int64) (*github.HookConfig, *github.Response, error) {
+	return o.f.GetHookConfiguration(ctx, org, id)
+}
+func (o *OrganizationsService) GetHookDelivery(ctx context.Context, owner string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return o.f.GetHookDelivery(ctx, owner, hookID, deliveryID)
+}
+func (o *OrganizationsService) GetOrgMembership(ctx context.Context, user string, org string) (*github.Membership, *github.Response, error) {
+	return o.f.GetOrgMembership(ctx, user, org)
+}
+func (o *OrganizationsService) GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*github.Ruleset, *github.Response, error) {
+	return o.f.GetOrganizationRuleset(ctx, org, rulesetID)
+}
+func (o *OrganizationsService) GetPackage(ctx context.Context, org string, packageType string, packageName string) (*github.Package, *github.Response, error) {
+	return o.f.GetPackage(ctx, org, packageType, packageName)
+}
+func (o *OrganizationsService) IsBlocked(ctx context.Context, org string, user string) (bool, *github.Response, error) {
+	return o.f.IsBlocked(ctx, org, user)
+}
+func (o *OrganizationsService) IsMember(ctx context.Context, org string, user string) (bool, *github.Response, error) {
+	return o.f.IsMember(ctx, org, user)
+}
+func (o *OrganizationsService) IsPublicMember(ctx context.Context, org string, user string) (bool, *github.Response, error) {
+	return o.f.IsPublicMember(ctx, org, user)
+}
+func (o *OrganizationsService) List(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Organization, *github.Response, error) {
+	return o.f.List(ctx, user, opts)
+}
+func (o *OrganizationsService) ListAll(ctx context.Context, opts *github.OrganizationsListOptions) ([]*github.Organization, *github.Response, error) {
+	return o.f.ListAll(ctx, opts)
+}
+func (o *OrganizationsService) ListBlockedUsers(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return o.f.ListBlockedUsers(ctx, org, opts)
+}
+func (o *OrganizationsService) ListCredentialAuthorizations(ctx context.Context, org string, opts *github.ListOptions) ([]*github.CredentialAuthorization, *github.Response, error) {
+	return o.f.ListCredentialAuthorizations(ctx, org, opts)
+}
+func (o *OrganizationsService) ListCustomPropertyValues(ctx context.Context, org string, opts *github.ListOptions) ([]*github.RepoCustomPropertyValue, *github.Response, error) {
+	return o.f.ListCustomPropertyValues(ctx, org, opts)
+}
+func (o *OrganizationsService) ListCustomRepoRoles(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error) {
+	return o.f.ListCustomRepoRoles(ctx, org)
+}
+func (o *OrganizationsService) ListFailedOrgInvitations(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	return o.f.ListFailedOrgInvitations(ctx, org, opts)
+}
+func (o *OrganizationsService) ListHookDeliveries(ctx context.Context, org string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	return o.f.ListHookDeliveries(ctx, org, id, opts)
+}
+func (o *OrganizationsService) ListHooks(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+	return o.f.ListHooks(ctx, org, opts)
+}
+func (o *OrganizationsService) ListInstallations(ctx context.Context, org string, opts *github.ListOptions) (*github.OrganizationInstallations, *github.Response, error) {
+	return o.f.ListInstallations(ctx, org, opts)
+}
+func (o *OrganizationsService) ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+	return o.f.ListMembers(ctx, org, opts)
+}
+func (o *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org string, invitationID string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return o.f.ListOrgInvitationTeams(ctx, org, invitationID, opts)
+}
+func (o *OrganizationsService) ListOrgMemberships(ctx context.Context, opts *github.ListOrgMembershipsOptions) ([]*github.Membership, *github.Response, error) {
+	return o.f.ListOrgMemberships(ctx, opts)
+}
+func (o *OrganizationsService) ListOutsideCollaborators(ctx context.Context, org string, opts *github.ListOutsideCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+	return o.f.ListOutsideCollaborators(ctx, org, opts)
+}
+func (o *OrganizationsService) ListPackages(ctx context.Context, org string, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error) {
+	return o.f.ListPackages(ctx, org, opts)
+}
+func (o *OrganizationsService) ListPendingOrgInvitations(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	return o.f.ListPendingOrgInvitations(ctx, org, opts)
+}
+func (o *OrganizationsService) ListProjects(ctx context.Context, org string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error) {
+	return o.f.ListProjects(ctx, org, opts)
+}
+func (o *OrganizationsService) ListSecurityManagerTeams(ctx context.Context, org string) ([]*github.Team, *github.Response, error) {
+	return o.f.ListSecurityManagerTeams(ctx, org)
+}
+func (o *OrganizationsService) ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return o.f.ListTeamsAssignedToOrgRole(ctx, org, roleID, opts)
+}
+func (o *OrganizationsService) ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return o.f.ListUsersAssignedToOrgRole(ctx, org, roleID, opts)
+}
+func (o *OrganizationsService) PackageDeleteVersion(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.Response, error) {
+	return o.f.PackageDeleteVersion(ctx, org, packageType, packageName, packageVersionID)
+}
+func (o *OrganizationsService) PackageGetAllVersions(ctx context.Context, org string, packageType string, packageName string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error) {
+	return o.f.PackageGetAllVersions(ctx, org, packageType, packageName, opts)
+}
+func (o *OrganizationsService) PackageGetVersion(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.PackageVersion, *github.Response, error) {
+	return o.f.PackageGetVersion(ctx, org, packageType, packageName, packageVersionID)
+}
+func (o *OrganizationsService) PackageRestoreVersion(ctx context.Context, org string, packageType string, packageName string, packageVersionID int64) (*github.Response, error) {
+	return o.f.PackageRestoreVersion(ctx, org, packageType, packageName, packageVersionID)
+}
+func (o *OrganizationsService) PingHook(ctx context.Context, org string, id int64) (*github.Response, error) {
+	return o.f.PingHook(ctx, org, id)
+}
+func (o *OrganizationsService) PublicizeMembership(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.PublicizeMembership(ctx, org, user)
+}
+func (o *OrganizationsService) RedeliverHookDelivery(ctx context.Context, owner string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return o.f.RedeliverHookDelivery(ctx, owner, hookID, deliveryID)
+}
+func (o *OrganizationsService) RemoveCredentialAuthorization(ctx context.Context, org string, credentialID int64) (*github.Response, error) {
+	return o.f.RemoveCredentialAuthorization(ctx, org, credentialID)
+}
+func (o *OrganizationsService) RemoveCustomProperty(ctx context.Context, org string, customPropertyName string) (*github.Response, error) {
+	return o.f.RemoveCustomProperty(ctx, org, customPropertyName)
+}
+func (o *OrganizationsService) RemoveMember(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.RemoveMember(ctx, org, user)
+}
+func (o *OrganizationsService) RemoveOrgMembership(ctx context.Context, user string, org string) (*github.Response, error) {
+	return o.f.RemoveOrgMembership(ctx, user, org)
+}
+func (o *OrganizationsService) RemoveOutsideCollaborator(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.RemoveOutsideCollaborator(ctx, org, user)
+}
+func (o *OrganizationsService) RemoveSecurityManagerTeam(ctx context.Context, org string, team string) (*github.Response, error) {
+	return o.f.RemoveSecurityManagerTeam(ctx, org, team)
+}
+func (o *OrganizationsService) RestorePackage(ctx context.Context, org string, packageType string, packageName string) (*github.Response, error) {
+	return o.f.RestorePackage(ctx, org, packageType, packageName)
+}
+func (o *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Context, org string, requestID int64, opts github.ReviewPersonalAccessTokenRequestOptions) (*github.Response, error) {
+	return o.f.ReviewPersonalAccessTokenRequest(ctx, org, requestID, opts)
+}
+func (o *OrganizationsService) UnblockUser(ctx context.Context, org string, user string) (*github.Response, error) {
+	return o.f.UnblockUser(ctx, org, user)
+}
+func (o *OrganizationsService) UpdateCustomRepoRole(ctx context.Context, org string, roleID string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error) {
+	return o.f.UpdateCustomRepoRole(ctx, org, roleID, opts)
+}
+func (o *OrganizationsService) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+	return o.f.UpdateOrganizationRuleset(ctx, org, rulesetID, rs)
+}
+
+func (o *OrganizationsService) MapMembers(ctx context.Context, org string, opts *github.ListMembersOptions, handle UserHandler, pageOpts ...PaginatorOption) error {
+	return o.f.MapMembers(ctx, org, opts, handle, pageOpts...)
+}
+
+// NewOrganizationsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewOrganizationsService(f *OrganizationsServiceF) *OrganizationsService {
+	var table OrganizationsServiceF
+	if f != nil {
+		table = *f
+	}
+	o := &OrganizationsService{f: &table}
+	if table.MapMembers == nil {
+		table.MapMembers = newMapMembersF(o)
+	}
+	return o
+}
+
+func newOrganizationsServicePassthrough(client *github.Client) *OrganizationsService {
+	return NewOrganizationsService(&OrganizationsServiceF{
+		AddSecurityManagerTeam:                 client.Organizations.AddSecurityManagerTeam,
+		BlockUser:                              client.Organizations.BlockUser,
+		ConcealMembership:                      client.Organizations.ConcealMembership,
+		ConvertMemberToOutsideCollaborator:     client.Organizations.ConvertMemberToOutsideCollaborator,
+		CreateCustomRepoRole:                   client.Organizations.CreateCustomRepoRole,
+		CreateHook:                             client.Organizations.CreateHook,
+		CreateOrUpdateCustomProperties:         client.Organizations.CreateOrUpdateCustomProperties,
+		CreateOrUpdateCustomProperty:           client.Organizations.CreateOrUpdateCustomProperty,
+		CreateOrUpdateRepoCustomPropertyValues: client.Organizations.CreateOrUpdateRepoCustomPropertyValues,
+		CreateOrgInvitation:                    client.Organizations.CreateOrgInvitation,
+		CreateOrganizationRuleset:              client.Organizations.CreateOrganizationRuleset,
+		CreateProject:                          client.Organizations.CreateProject,
+		Delete:                                 client.Organizations.Delete,
+		DeleteCustomRepoRole:                   client.Organizations.DeleteCustomRepoRole,
+		DeleteHook:                             client.Organizations.DeleteHook,
+		DeleteOrganizationRuleset:              client.Organizations.DeleteOrganizationRuleset,
+		DeletePackage:                          client.Organizations.DeletePackage,
+		Edit:                                   client.Organizations.Edit,
+		EditActionsAllowed:                     client.Organizations.EditActionsAllowed,
+		EditActionsPermissions:                 client.Organizations.EditActionsPermissions,
+		EditHook:                               client.Organizations.EditHook,
+		EditHookConfiguration:                  client.Organizations.EditHookConfiguration,
+		EditOrgMembership:                      client.Organizations.EditOrgMembership,
+		Get:                                    client.Organizations.Get,
+		GetActionsAllowed:                      client.Organizations.GetActionsAllowed,
+		GetActionsPermissions:                  client.Organizations.GetActionsPermissions,
+		GetAllCustomProperties:                 client.Organizations.GetAllCustomProperties,
+		GetAllOrganizationRulesets:             client.Organizations.GetAllOrganizationRulesets,
+		GetAuditLog:                            client.Organizations.GetAuditLog,
+		GetByID:                                client.Organizations.GetByID,
+		GetCustomProperty:                      client.Organizations.GetCustomProperty,
+		GetHook:                                client.Organizations.GetHook,
+		GetHookConfiguration:                   client.Organizations.GetHookConfiguration,
+		GetHookDelivery:                        client.Organizations.GetHookDelivery,
+		GetOrgMembership:                       client.Organizations.GetOrgMembership,
+		GetOrganizationRuleset:                 client.Organizations.GetOrganizationRuleset,
+		GetPackage:                             client.Organizations.GetPackage,
+		IsBlocked:                              client.Organizations.IsBlocked,
+		IsMember:                               client.Organizations.IsMember,
+		IsPublicMember:                         client.Organizations.IsPublicMember,
+		List:                                   client.Organizations.List,
+		ListAll:                                client.Organizations.ListAll,
+		ListBlockedUsers:                       client.Organizations.ListBlockedUsers,
+		ListCredentialAuthorizations:           client.Organizations.ListCredentialAuthorizations,
+		ListCustomPropertyValues:               client.Organizations.ListCustomPropertyValues,
+		ListCustomRepoRoles:                    client.Organizations.ListCustomRepoRoles,
+		ListFailedOrgInvitations:               client.Organizations.ListFailedOrgInvitations,
+		ListHookDeliveries:                     client.Organizations.ListHookDeliveries,
+		ListHooks:                              client.Organizations.ListHooks,
+		ListInstallations:                      client.Organizations.ListInstallations,
+		ListMembers:                            client.Organizations.ListMembers,
+		ListOrgInvitationTeams:                 client.Organizations.ListOrgInvitationTeams,
+		ListOrgMemberships:                     client.Organizations.ListOrgMemberships,
+		ListOutsideCollaborators:               client.Organizations.ListOutsideCollaborators,
+		ListPackages:                           client.Organizations.ListPackages,
+		ListPendingOrgInvitations:              client.Organizations.ListPendingOrgInvitations,
+		ListProjects:                           client.Organizations.ListProjects,
+		ListSecurityManagerTeams:               client.Organizations.ListSecurityManagerTeams,
+		ListTeamsAssignedToOrgRole:             client.Organizations.ListTeamsAssignedToOrgRole,
+		ListUsersAssignedToOrgRole:             client.Organizations.ListUsersAssignedToOrgRole,
+		PackageDeleteVersion:                   client.Organizations.PackageDeleteVersion,
+		PackageGetAllVersions:                  client.Organizations.PackageGetAllVersions,
+		PackageGetVersion:                      client.Organizations.PackageGetVersion,
+		PackageRestoreVersion:                  client.Organizations.PackageRestoreVersion,
+		PingHook:                               client.Organizations.PingHook,
+		PublicizeMembership:                    client.Organizations.PublicizeMembership,
+		RedeliverHookDelivery:                  client.Organizations.RedeliverHookDelivery,
+		RemoveCredentialAuthorization:          client.Organizations.RemoveCredentialAuthorization,
+		RemoveCustomProperty:                   client.Organizations.RemoveCustomProperty,
+		RemoveMember:                           client.Organizations.RemoveMember,
+		RemoveOrgMembership:                    client.Organizations.RemoveOrgMembership,
+		RemoveOutsideCollaborator:              client.Organizations.RemoveOutsideCollaborator,
+		RemoveSecurityManagerTeam:              client.Organizations.RemoveSecurityManagerTeam,
+		RestorePackage:                         client.Organizations.RestorePackage,
+		ReviewPersonalAccessTokenRequest:       client.Organizations.ReviewPersonalAccessTokenRequest,
+		UnblockUser:                            client.Organizations.UnblockUser,
+		UpdateCustomRepoRole:                   client.Organizations.UpdateCustomRepoRole,
+		UpdateOrganizationRuleset:              client.Organizations.UpdateOrganizationRuleset,
+	})
+}
+
+type ProjectsServiceF struct {
+	AddProjectCollaborator              func(ctx context.Context, id int64, username string, opts *github.ProjectCollaboratorOptions) (*github.Response, error)
+	CreateProjectCard                   func(ctx context.Context, columnID int64, opts *github.ProjectCardOptions) (*github.ProjectCard, *github.Response, error)
+	CreateProjectColumn                 func(ctx context.Context, projectID int64, opts *github.ProjectColumnOptions) (*github.ProjectColumn, *github.Response, error)
+	DeleteProject                       func(ctx context.Context, id int64) (*github.Response, error)
+	DeleteProjectCard                   func(ctx context.Context, cardID int64) (*github.Response, error)
+	DeleteProjectColumn                 func(ctx context.Context, columnID int64) (*github.Response, error)
+	GetProject                          func(ctx context.Context, id int64) (*github.Project, *github.Response, error)
+	GetProjectCard                      func(ctx context.Context, cardID int64) (*github.ProjectCard, *github.Response, error)
+	GetProjectColumn                    func(ctx context.Context, id int64) (*github.ProjectColumn, *github.Response, error)
+	ListProjectCards                    func(ctx context.Context, columnID int64, opts *github.ProjectCardListOptions) ([]*github.ProjectCard, *github.Response, error)
+	ListProjectCollaborators            func(ctx context.Context, id int64, opts *github.ListCollaboratorOptions) ([]*github.User, *github.Response, error)
+	ListProjectColumns                  func(ctx context.Context, projectID int64, opts *github.ListOptions) ([]*github.ProjectColumn, *github.Response, error)
+	MoveProjectCard                     func(ctx context.Context, cardID int64, opts *github.ProjectCardMoveOptions) (*github.Response, error)
+	MoveProjectColumn                   func(ctx context.Context, columnID int64, opts *github.ProjectColumnMoveOptions) (*github.Response, error)
+	RemoveProjectCollaborator           func(ctx context.Context, id int64, username string) (*github.Response, error)
+	ReviewProjectCollaboratorPermission func(ctx context.Context, id int64, username string) (*github.ProjectPermissionLevel, *github.Response, error)
+	UpdateProject                       func(ctx context.Context, id int64, opts *github.ProjectOptions) (*github.Project, *github.Response, error)
+	UpdateProjectCard                   func(ctx context.Context, cardID int64, opts *github.ProjectCardOptions) (*github.ProjectCard, *github.Response, error)
+	UpdateProjectColumn                 func(ctx context.Context, columnID int64, opts *github.ProjectColumnOptions) (*github.ProjectColumn, *github.Response, error)
+}
+
+type ProjectsService struct {
+	f *ProjectsServiceF
+}
+
+func (p *ProjectsService) AddProjectCollaborator(ctx context.Context, id int64, username string, opts *github.ProjectCollaboratorOptions) (*github.Response, error) {
+	return p.f.AddProjectCollaborator(ctx, id, username, opts)
+}
+func (p *ProjectsService) CreateProjectCard(ctx context.Context, columnID int64, opts *github.ProjectCardOptions) (*github.ProjectCard, *github.Response, error) {
+	return p.f.CreateProjectCard(ctx, columnID, opts)
+}
+func (p *ProjectsService) CreateProjectColumn(ctx context.Context, projectID int64, opts *github.ProjectColumnOptions) (*github.ProjectColumn, *github.Response, error) {
+	return p.f.CreateProjectColumn(ctx, projectID, opts)
+}
+func (p *ProjectsService) DeleteProject(ctx context.Context, id int64) (*github.Response, error) {
+	return p.f.DeleteProject(ctx, id)
+}
+func (p *ProjectsService) DeleteProjectCard(ctx context.Context, cardID int64) (*github.Response, error) {
+	return p.f.DeleteProjectCard(ctx, cardID)
+}
+func (p *ProjectsService) DeleteProjectColumn(ctx context.Context, columnID int64) (*github.Response, error) {
+	return p.f.DeleteProjectColumn(ctx, columnID)
+}
+func (p *ProjectsService) GetProject(ctx context.Context, id int64) (*github.Project, *github.Response, error) {
+	return p.f.GetProject(ctx, id)
+}
+func (p *ProjectsService) GetProjectCard(ctx context.Context, cardID int64) (*github.ProjectCard, *github.Response, error) {
+	return p.f.GetProjectCard(ctx, cardID)
+}
+func (p *ProjectsService) GetProjectColumn(ctx context.Context, id int64) (*github.ProjectColumn, *github.Response, error) {
+	return p.f.GetProjectColumn(ctx, id)
+}
+func (p *ProjectsService) ListProjectCards(ctx context.Context, columnID int64, opts *github.ProjectCardListOptions) ([]*github.ProjectCard, *github.Response, error) {
+	return p.f.ListProjectCards(ctx, columnID, opts)
+}
+func (p *ProjectsService) ListProjectCollaborators(ctx context.Context, id int64, opts *github.ListCollaboratorOptions) ([]*github.User, *github.Response, error) {
+	return p.f.ListProjectCollaborators(ctx, id, opts)
+}
+func (p *ProjectsService) ListProjectColumns(ctx context.Context, projectID int64, opts *github.ListOptions) ([]*github.ProjectColumn, *github.Response, error) {
+	return p.f.ListProjectColumns(ctx, projectID, opts)
+}
+func (p *ProjectsService) MoveProjectCard(ctx context.Context, cardID int64, opts *github.ProjectCardMoveOptions) (*github.Response, error) {
+	return p.f.MoveProjectCard(ctx, cardID, opts)
+}
+func (p *ProjectsService) MoveProjectColumn(ctx context.Context, columnID int64, opts *github.ProjectColumnMoveOptions) (*github.Response, error) {
+	return p.f.MoveProjectColumn(ctx, columnID, opts)
+}
+func (p *ProjectsService) RemoveProjectCollaborator(ctx context.Context, id int64, username string) (*github.Response, error) {
+	return p.f.RemoveProjectCollaborator(ctx, id, username)
+}
+func (p *ProjectsService) ReviewProjectCollaboratorPermission(ctx context.Context, id int64, username string) (*github.ProjectPermissionLevel, *github.Response, error) {
+	return p.f.ReviewProjectCollaboratorPermission(ctx, id, username)
+}
+func (p *ProjectsService) UpdateProject(ctx context.Context, id int64, opts *github.ProjectOptions) (*github.Project, *github.Response, error) {
+	return p.f.UpdateProject(ctx, id, opts)
+}
+func (p *ProjectsService) UpdateProjectCard(ctx context.Context, cardID int64, opts *github.ProjectCardOptions) (*github.ProjectCard, *github.Response, error) {
+	return p.f.UpdateProjectCard(ctx, cardID, opts)
+}
+func (p *ProjectsService) UpdateProjectColumn(ctx context.Context, columnID int64, opts *github.ProjectColumnOptions) (*github.ProjectColumn, *github.Response, error) {
+	return p.f.UpdateProjectColumn(ctx, columnID, opts)
+}
+
+// NewProjectsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewProjectsService(f *ProjectsServiceF) *ProjectsService {
+	var table ProjectsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &ProjectsService{f: &table}
+}
+
+func newProjectsServicePassthrough(client *github.Client) *ProjectsService {
+	return NewProjectsService(&ProjectsServiceF{
+		AddProjectCollaborator:              client.Projects.AddProjectCollaborator,
+		CreateProjectCard:                   client.Projects.CreateProjectCard,
+		CreateProjectColumn:                 client.Projects.CreateProjectColumn,
+		DeleteProject:                       client.Projects.DeleteProject,
+		DeleteProjectCard:                   client.Projects.DeleteProjectCard,
+		DeleteProjectColumn:                 client.Projects.DeleteProjectColumn,
+		GetProject:                          client.Projects.GetProject,
+		GetProjectCard:                      client.Projects.GetProjectCard,
+		GetProjectColumn:                    client.Projects.GetProjectColumn,
+		ListProjectCards:                    client.Projects.ListProjectCards,
+		ListProjectCollaborators:            client.Projects.ListProjectCollaborators,
+		ListProjectColumns:                  client.Projects.ListProjectColumns,
+		MoveProjectCard:                     client.Projects.MoveProjectCard,
+		MoveProjectColumn:                   client.Projects.MoveProjectColumn,
+		RemoveProjectCollaborator:           client.Projects.RemoveProjectCollaborator,
+		ReviewProjectCollaboratorPermission: client.Projects.ReviewProjectCollaboratorPermission,
+		UpdateProject:                       client.Projects.UpdateProject,
+		UpdateProjectCard:                   client.Projects.UpdateProjectCard,
+		UpdateProjectColumn:                 client.Projects.UpdateProjectColumn,
+	})
+}
+
+type PullRequestsServiceF struct {
+	Create                     func(ctx context.Context, owner string, repo string, pull *github.NewPullRequest) (*github.PullRequest, *github.Response, error)
+	CreateComment              func(ctx context.Context, owner string, repo string, number int, comment *github.PullRequestComment) (*github.PullRequestComment, *github.Response, error)
+	CreateCommentInReplyTo     func(ctx context.Context, owner string, repo string, number int, body string, commentID int64) (*github.PullRequestComment, *github.Response, error)
+	CreateReview               func(ctx context.Context, owner string, repo string, number int, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error)
+	DeleteComment              func(ctx context.Context, owner string, repo string, commentID int64) (*github.Response, error)
+	DeletePendingReview        func(ctx context.Context, owner string, repo string, number int, reviewID int64) (*github.PullRequestReview, *github.Response, error)
+	DismissReview              func(ctx context.Context, owner string, repo string, number int, reviewID int64, review *github.PullRequestReviewDismissalRequest) (*github.PullRequestReview, *github.Response, error)
+	Edit                       func(ctx context.Context, owner string, repo string, number int, pull *github.PullRequest) (*github.PullRequest, *github.Response, error)
+	EditComment                func(ctx context.Context, owner string, repo string, commentID int64, comment *github.PullRequestComment) (*github.PullRequestComment, *github.Response, error)
+	Get                        func(ctx context.Context, owner string, repo string, number int) (*github.PullRequest, *github.Response, error)
+	GetComment                 func(ctx context.Context, owner string, repo string, commentID int64) (*github.PullRequestComment, *github.Response, error)
+	GetRaw                     func(ctx context.Context, owner string, repo string, number int, opts github.RawOptions) (string, *github.Response, error)
+	GetReview                  func(ctx context.Context, owner string, repo string, number int, reviewID int64) (*github.PullRequestReview, *github.Response, error)
+	IsMerged                   func(ctx context.Context, owner string, repo string, number int) (bool, *github.Response, error)
+	List                       func(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error)
+	ListComments               func(ctx context.Context, owner string, repo string, number int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error)
+	ListCommits                func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error)
+	ListFiles                  func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error)
+	ListPullRequestsWithCommit func(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) ([]*github.PullRequest, *github.Response, error)
+	ListReviewComments         func(ctx context.Context, owner string, repo string, number int, reviewID int64, opts *github.ListOptions) ([]*github.PullRequestComment, *github.Response, error)
+	ListReviewers              func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) (*github.Reviewers, *github.Response, error)
+	ListReviews                func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error)
+	Merge                      func(ctx context.Context, owner string, repo string, number int, commitMessage string, options *github.PullRequestOptions) (*github.PullRequestMergeResult, *github.Response, error)
+	RemoveReviewers            func(ctx context.Context, owner string, repo string, number int, reviewers github.ReviewersRequest) (*github.Response, error)
+	RequestReviewers           func(ctx context.Context, owner string, repo string, number int, reviewers github.ReviewersRequest) (*github.PullRequest, *github.Response, error)
+	SubmitReview               func(ctx context.Context, owner string, repo string, number int, reviewID int64, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error)
+	UpdateBranch               func(ctx context.Context, owner string, repo string, number int, opts *github.PullRequestBranchUpdateOptions) (*github.PullRequestBranchUpdateResponse, *github.Response, error)
+	UpdateReview               func(ctx context.Context, owner string, repo string, number int, reviewID int64, body string) (*github.PullRequestReview, *github.Response, error)
+
+	Map        func(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions, handle PullRequestHandler, pageOpts ...PaginatorOption) error        `ghx:"extension"`
+	MapCommits func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error  `ghx:"extension"`
+	MapFiles   func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle CommitFileHandler, pageOpts ...PaginatorOption) error        `ghx:"extension"`
+	MapReviews func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle PullRequestReviewHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+}
+
+type PullRequestsService struct {
+	f *PullRequestsServiceF
+}
+
+func (p *PullRequestsService) Create(ctx context.Context, owner string, repo string, pull *github.NewPullRequest) (*github.PullRequest, *github.Response, error) {
+	return p.f.Create(ctx, owner, repo, pull)
+}
+func (p *PullRequestsService) CreateComment(ctx context.Context, owner string, repo string, number int, comment *github.PullRequestComment) (*github.PullRequestComment, *github.Response, error) {
+	return p.f.CreateComment(ctx, owner, repo, number, comment)
+}
+func (p *PullRequestsService) CreateCommentInReplyTo(ctx context.Context, owner string, repo string, number int, body string, commentID int64) (*github.PullRequestComment, *github.Response, error) {
+	return p.f.CreateCommentInReplyTo(ctx, owner, repo, number, body, commentID)
+}
+func (p *PullRequestsService) CreateReview(ctx context.Context, owner string, repo string, number int, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.CreateReview(ctx, owner, repo, number, review)
+}
+func (p *PullRequestsService) DeleteComment(ctx context.Context, owner string, repo string, commentID int64) (*github.Response, error) {
+	return p.f.DeleteComment(ctx, owner, repo, commentID)
+}
+func (p *PullRequestsService) DeletePendingReview(ctx context.Context, owner string, repo string, number int, reviewID int64) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.DeletePendingReview(ctx, owner, repo, number, reviewID)
+}
+func (p *PullRequestsService) DismissReview(ctx context.Context, owner string, repo string, number int, reviewID int64, review *github.PullRequestReviewDismissalRequest) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.DismissReview(ctx, owner, repo, number, reviewID, review)
+}
+func (p *PullRequestsService) Edit(ctx context.Context, owner string, repo string, number int, pull *github.PullRequest) (*github.PullRequest, *github.Response, error) {
+	return p.f.Edit(ctx, owner, repo, number, pull)
+}
+func (p *PullRequestsService) EditComment(ctx context.Context, owner string, repo string, commentID int64, comment *github.PullRequestComment) (*github.PullRequestComment, *github.Response, error) {
+	return p.f.EditComment(ctx, owner, repo, commentID, comment)
+}
+func (p *PullRequestsService) Get(ctx context.Context, owner string, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	return p.f.Get(ctx, owner, repo, number)
+}
+func (p *PullRequestsService) GetComment(ctx context.Context, owner string, repo string, commentID int64) (*github.PullRequestComment, *github.Response, error) {
+	return p.f.GetComment(ctx, owner, repo, commentID)
+}
+func (p *PullRequestsService) GetRaw(ctx context.Context, owner string, repo string, number int, opts github.RawOptions) (string, *github.Response, error) {
+	return p.f.GetRaw(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) GetReview(ctx context.Context, owner string, repo string, number int, reviewID int64) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.GetReview(ctx, owner, repo, number, reviewID)
+}
+func (p *PullRequestsService) IsMerged(ctx context.Context, owner string, repo string, number int) (bool, *github.Response, error) {
+	return p.f.IsMerged(ctx, owner, repo, number)
+}
+func (p *PullRequestsService) List(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return p.f.List(ctx, owner, repo, opts)
+}
+func (p *PullRequestsService) ListComments(ctx context.Context, owner string, repo string, number int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return p.f.ListComments(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) ListCommits(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+	return p.f.ListCommits(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) ListFiles(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	return p.f.ListFiles(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return p.f.ListPullRequestsWithCommit(ctx, owner, repo, sha, opts)
+}
+func (p *PullRequestsService) ListReviewComments(ctx context.Context, owner string, repo string, number int, reviewID int64, opts *github.ListOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return p.f.ListReviewComments(ctx, owner, repo, number, reviewID, opts)
+}
+func (p *PullRequestsService) ListReviewers(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) (*github.Reviewers, *github.Response, error) {
+	return p.f.ListReviewers(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) ListReviews(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return p.f.ListReviews(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) Merge(ctx context.Context, owner string, repo string, number int, commitMessage string, options *github.PullRequestOptions) (*github.PullRequestMergeResult, *github.Response, error) {
+	return p.f.Merge(ctx, owner, repo, number, commitMessage, options)
+}
+func (p *PullRequestsService) RemoveReviewers(ctx context.Context, owner string, repo string, number int, reviewers github.ReviewersRequest) (*github.Response, error) {
+	return p.f.RemoveReviewers(ctx, owner, repo, number, reviewers)
+}
+func (p *PullRequestsService) RequestReviewers(ctx context.Context, owner string, repo string, number int, reviewers github.ReviewersRequest) (*github.PullRequest, *github.Response, error) {
+	return p.f.RequestReviewers(ctx, owner, repo, number, reviewers)
+}
+func (p *PullRequestsService) SubmitReview(ctx context.Context, owner string, repo string, number int, reviewID int64, review *github.PullRequestReviewRequest) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.SubmitReview(ctx, owner, repo, number, reviewID, review)
+}
+func (p *PullRequestsService) UpdateBranch(ctx context.Context, owner string, repo string, number int, opts *github.PullRequestBranchUpdateOptions) (*github.PullRequestBranchUpdateResponse, *github.Response, error) {
+	return p.f.UpdateBranch(ctx, owner, repo, number, opts)
+}
+func (p *PullRequestsService) UpdateReview(ctx context.Context, owner string, repo string, number int, reviewID int64, body string) (*github.PullRequestReview, *github.Response, error) {
+	return p.f.UpdateReview(ctx, owner, repo, number, reviewID, body)
+}
+
+func (p *PullRequestsService) Map(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions, handle PullRequestHandler, pageOpts ...PaginatorOption) error {
+	return p.f.Map(ctx, owner, repo, opts, handle, pageOpts...)
+}
+func (p *PullRequestsService) MapCommits(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+	return p.f.MapCommits(ctx, owner, repo, number, opts, handle, pageOpts...)
+}
+func (p *PullRequestsService) MapFiles(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle CommitFileHandler, pageOpts ...PaginatorOption) error {
+	return p.f.MapFiles(ctx, owner, repo, number, opts, handle, pageOpts...)
+}
+func (p *PullRequestsService) MapReviews(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle PullRequestReviewHandler, pageOpts ...PaginatorOption) error {
+	return p.f.MapReviews(ctx, owner, repo, number, opts, handle, pageOpts...)
+}
+
+// NewPullRequestsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewPullRequestsService(f *PullRequestsServiceF) *PullRequestsService {
+	var table PullRequestsServiceF
+	if f != nil {
+		table = *f
+	}
+	p := &PullRequestsService{f: &table}
+	if table.Map == nil {
+		table.Map = newMapPullRequestsF(p)
+	}
+	if table.MapCommits == nil {
+		table.MapCommits = newMapPullRequestCommitsF(p)
+	}
+	if table.MapFiles == nil {
+		table.MapFiles = newMapPullRequestFilesF(p)
+	}
+	if table.MapReviews == nil {
+		table.MapReviews = newMapPullRequestReviewsF(p)
+	}
+	return p
+}
+
+func newPullRequestsServicePassthrough(client *github.Client) *PullRequestsService {
+	return NewPullRequestsService(&PullRequestsServiceF{
+		Create:                     client.PullRequests.Create,
+		CreateComment:              client.PullRequests.CreateComment,
+		CreateCommentInReplyTo:     client.PullRequests.CreateCommentInReplyTo,
+		CreateReview:               client.PullRequests.CreateReview,
+		DeleteComment:              client.PullRequests.DeleteComment,
+		DeletePendingReview:        client.PullRequests.DeletePendingReview,
+		DismissReview:              client.PullRequests.DismissReview,
+		Edit:                       client.PullRequests.Edit,
+		EditComment:                client.PullRequests.EditComment,
+		Get:                        client.PullRequests.Get,
+		GetComment:                 client.PullRequests.GetComment,
+		GetRaw:                     client.PullRequests.GetRaw,
+		GetReview:                  client.PullRequests.GetReview,
+		IsMerged:                   client.PullRequests.IsMerged,
+		List:                       client.PullRequests.List,
+		ListComments:               client.PullRequests.ListComments,
+		ListCommits:                client.PullRequests.ListCommits,
+		ListFiles:                  client.PullRequests.ListFiles,
+		ListPullRequestsWithCommit: client.PullRequests.ListPullRequestsWithCommit,
+		ListReviewComments:         client.PullRequests.ListReviewComments,
+		ListReviewers:              client.PullRequests.ListReviewers,
+		ListReviews:                client.PullRequests.ListReviews,
+		Merge:                      client.PullRequests.Merge,
+		RemoveReviewers:            client.PullRequests.RemoveReviewers,
+		RequestReviewers:           client.PullRequests.RequestReviewers,
+		SubmitReview:               client.PullRequests.SubmitReview,
+		UpdateBranch:               client.PullRequests.UpdateBranch,
+		UpdateReview:               client.PullRequests.UpdateReview,
+	})
+}
+
+type RateLimitServiceF struct {
+	Get func(ctx context.Context) (*github.RateLimits, *github.Response, error)
+}
+
+type RateLimitService struct {
+	f *RateLimitServiceF
+}
+
+func (r *RateLimitService) Get(ctx context.Context) (*github.RateLimits, *github.Response, error) {
+	return r.f.Get(ctx)
+}
+
+// NewRateLimitService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewRateLimitService(f *RateLimitServiceF) *RateLimitService {
+	var table RateLimitServiceF
+	if f != nil {
+		table = *f
+	}
+	return &RateLimitService{f: &table}
+}
+
+func newRateLimitServicePassthrough(client *github.Client) *RateLimitService {
+	return NewRateLimitService(&RateLimitServiceF{
+		Get: client.RateLimit.Get,
+	})
+}
+
+type ReactionsServiceF struct {
+	CreateCommentReaction                               func(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error)
+	CreateIssueCommentReaction                          func(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error)
+	CreateIssueReaction                                 func(ctx context.Context, owner string, repo string, number int, content string) (*github.Reaction, *github.Response, error)
+	CreatePullRequestCommentReaction                    func(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error)
+	CreateReleaseReaction                               func(ctx context.Context, owner string, repo string, releaseID int64, content string) (*github.Reaction, *github.Response, error)
+	CreateTeamDiscussionCommentReaction                 func(ctx context.Context, teamID int64, discussionNumber int, commentNumber int, content string) (*github.Reaction, *github.Response, error)
+	CreateTeamDiscussionReaction                        func(ctx context.Context, teamID int64, discussionNumber int, content string) (*github.Reaction, *github.Response, error)
+	DeleteCommentReaction                               func(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error)
+	DeleteCommentReactionByID                           func(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error)
+	DeleteIssueCommentReaction                          func(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error)
+	DeleteIssueCommentReactionByID                      func(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error)
+	DeleteIssueReaction                                 func(ctx context.Context, owner string, repo string, issueNumber int, reactionID int64) (*github.Response, error)
+	DeleteIssueReactionByID                             func(ctx context.Context, repoID int, issueNumber int, reactionID int64) (*github.Response, error)
+	DeletePullRequestCommentReaction                    func(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error)
+	DeletePullRequestCommentReactionByID                func(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error)
+	DeleteTeamDiscussionCommentReaction                 func(ctx context.Context, org string, teamSlug string, discussionNumber int, commentNumber int, reactionID int64) (*github.Response, error)
+	DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID func(ctx context.Context, orgID int, teamID int, discussionNumber int, commentNumber int, reactionID int64) (*github.Response, error)
+	DeleteTeamDiscussionReaction                        func(ctx context.Context, org string, teamSlug string, discussionNumber int, reactionID int64) (*github.Response, error)
+	DeleteTeamDiscussionReactionByOrgIDAndTeamID        func(ctx context.Context, orgID int, teamID int, discussionNumber int, reactionID int64) (*github.Response, error)
+	ListCommentReactions                                func(ctx context.Context, owner string, repo string, id int64, opts *github.ListCommentReactionOptions) ([]*github.Reaction, *github.Response, error)
+	ListIssueCommentReactions                           func(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error)
+	ListIssueReactions                                  func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error)
+	ListPullRequestCommentReactions                     func(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error)
+	ListTeamDiscussionCommentReactions                  func(ctx context.Context, teamID int64, discussionNumber int, commentNumber int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error)
+	ListTeamDiscussionReactions                         func(ctx context.Context, teamID int64, discussionNumber int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error)
+}
+
+type ReactionsService struct {
+	f *ReactionsServiceF
+}
+
+func (r *ReactionsService) CreateCommentReaction(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateCommentReaction(ctx, owner, repo, id, content)
+}
+func (r *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateIssueCommentReaction(ctx, owner, repo, id, content)
+}
+func (r *ReactionsService) CreateIssueReaction(ctx context.Context, owner string, repo string, number int, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateIssueReaction(ctx, owner, repo, number, content)
+}
+func (r *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner string, repo string, id int64, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreatePullRequestCommentReaction(ctx, owner, repo, id, content)
+}
+func (r *ReactionsService) CreateReleaseReaction(ctx context.Context, owner string, repo string, releaseID int64, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateReleaseReaction(ctx, owner, repo, releaseID, content)
+}
+func (r *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber int, commentNumber int, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateTeamDiscussionCommentReaction(ctx, teamID, discussionNumber, commentNumber, content)
+}
+func (r *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content string) (*github.Reaction, *github.Response, error) {
+	return r.f.CreateTeamDiscussionReaction(ctx, teamID, discussionNumber, content)
+}
+func (r *ReactionsService) DeleteCommentReaction(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteCommentReaction(ctx, owner, repo, commentID, reactionID)
+}
+func (r *ReactionsService) DeleteCommentReactionByID(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteCommentReactionByID(ctx, repoID, commentID, reactionID)
+}
+func (r *ReactionsService) DeleteIssueCommentReaction(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteIssueCommentReaction(ctx, owner, repo, commentID, reactionID)
+}
+func (r *ReactionsService) DeleteIssueCommentReactionByID(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteIssueCommentReactionByID(ctx, repoID, commentID, reactionID)
+}
+func (r *ReactionsService) DeleteIssueReaction(ctx context.Context, owner string, repo string, issueNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteIssueReaction(ctx, owner, repo, issueNumber, reactionID)
+}
+func (r *ReactionsService) DeleteIssueReactionByID(ctx context.Context, repoID int, issueNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteIssueReactionByID(ctx, repoID, issueNumber, reactionID)
+}
+func (r *ReactionsService) DeletePullRequestCommentReaction(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeletePullRequestCommentReaction(ctx, owner, repo, commentID, reactionID)
+}
+func (r *ReactionsService) DeletePullRequestCommentReactionByID(ctx context.Context, repoID int64, commentID int64, reactionID int64) (*github.Response, error) {
+	return r.f.DeletePullRequestCommentReactionByID(ctx, repoID, commentID, reactionID)
+}
+func (r *ReactionsService) DeleteTeamDiscussionCommentReaction(ctx context.Context, org string, teamSlug string, discussionNumber int, commentNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteTeamDiscussionCommentReaction(ctx, org, teamSlug, discussionNumber, commentNumber, reactionID)
+}
+func (r *ReactionsService) DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID(ctx context.Context, orgID int, teamID int, discussionNumber int, commentNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID(ctx, orgID, teamID, discussionNumber, commentNumber, reactionID)
+}
+func (r *ReactionsService) DeleteTeamDiscussionReaction(ctx context.Context, org string, teamSlug string, discussionNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteTeamDiscussionReaction(ctx, org, teamSlug, discussionNumber, reactionID)
+}
+func (r *ReactionsService) DeleteTeamDiscussionReactionByOrgIDAndTeamID(ctx context.Context, orgID int, teamID int, discussionNumber int, reactionID int64) (*github.Response, error) {
+	return r.f.DeleteTeamDiscussionReactionByOrgIDAndTeamID(ctx, orgID, teamID, discussionNumber, reactionID)
+}
+func (r *ReactionsService) ListCommentReactions(ctx context.Context, owner string, repo string, id int64, opts *github.ListCommentReactionOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListCommentReactions(ctx, owner, repo, id, opts)
+}
+func (r *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListIssueCommentReactions(ctx, owner, repo, id, opts)
+}
+func (r *ReactionsService) ListIssueReactions(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListIssueReactions(ctx, owner, repo, number, opts)
+}
+func (r *ReactionsService) ListPullRequestCommentReactions(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListPullRequestCommentReactions(ctx, owner, repo, id, opts)
+}
+func (r *ReactionsService) ListTeamDiscussionCommentReactions(ctx context.Context, teamID int64, discussionNumber int, commentNumber int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListTeamDiscussionCommentReactions(ctx, teamID, discussionNumber, commentNumber, opts)
+}
+func (r *ReactionsService) ListTeamDiscussionReactions(ctx context.Context, teamID int64, discussionNumber int, opts *github.ListOptions) ([]*github.Reaction, *github.Response, error) {
+	return r.f.ListTeamDiscussionReactions(ctx, teamID, discussionNumber, opts)
+}
+
+// NewReactionsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewReactionsService(f *ReactionsServiceF) *ReactionsService {
+	var table ReactionsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &ReactionsService{f: &table}
+}
+
+func newReactionsServicePassthrough(client *github.Client) *ReactionsService {
+	return NewReactionsService(&ReactionsServiceF{
+		CreateCommentReaction:                               client.Reactions.CreateCommentReaction,
+		CreateIssueCommentReaction:                          client.Reactions.CreateIssueCommentReaction,
+		CreateIssueReaction:                                 client.Reactions.CreateIssueReaction,
+		CreatePullRequestCommentReaction:                    client.Reactions.CreatePullRequestCommentReaction,
+		CreateReleaseReaction:                               client.Reactions.CreateReleaseReaction,
+		CreateTeamDiscussionCommentReaction:                 client.Reactions.CreateTeamDiscussionCommentReaction,
+		CreateTeamDiscussionReaction:                        client.Reactions.CreateTeamDiscussionReaction,
+		DeleteCommentReaction:                               client.Reactions.DeleteCommentReaction,
+		DeleteCommentReactionByID:                           client.Reactions.DeleteCommentReactionByID,
+		DeleteIssueCommentReaction:                          client.Reactions.DeleteIssueCommentReaction,
+		DeleteIssueCommentReactionByID:                      client.Reactions.DeleteIssueCommentReactionByID,
+		DeleteIssueReaction:                                 client.Reactions.DeleteIssueReaction,
+		DeleteIssueReactionByID:                             client.Reactions.DeleteIssueReactionByID,
+		DeletePullRequestCommentReaction:                    client.Reactions.DeletePullRequestCommentReaction,
+		DeletePullRequestCommentReactionByID:                client.Reactions.DeletePullRequestCommentReactionByID,
+		DeleteTeamDiscussionCommentReaction:                 client.Reactions.DeleteTeamDiscussionCommentReaction,
+		DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID: client.Reactions.DeleteTeamDiscussionCommentReactionByOrgIDAndTeamID,
+		DeleteTeamDiscussionReaction:                        client.Reactions.DeleteTeamDiscussionReaction,
+		DeleteTeamDiscussionReactionByOrgIDAndTeamID:        client.Reactions.DeleteTeamDiscussionReactionByOrgIDAndTeamID,
+		ListCommentReactions:                                client.Reactions.ListCommentReactions,
+		ListIssueCommentReactions:                           client.Reactions.ListIssueCommentReactions,
+		ListIssueReactions:                                  client.Reactions.ListIssueReactions,
+		ListPullRequestCommentReactions:                     client.Reactions.ListPullRequestCommentReactions,
+		ListTeamDiscussionCommentReactions:                  client.Reactions.ListTeamDiscussionCommentReactions,
+		ListTeamDiscussionReactions:                         client.Reactions.ListTeamDiscussionReactions,
+	})
+}
+
+type RepositoriesServiceF struct {
+	AddAdminEnforcement                   func(ctx context.Context, owner string, repo string, branch string) (*github.AdminEnforcement, *github.Response, error)
+	AddAppRestrictions                    func(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error)
+	AddAutolink                           func(ctx context.Context, owner string, repo string, opts *github.AutolinkOptions) (*github.Autolink, *github.Response, error)
+	AddCollaborator                       func(ctx context.Context, owner string, repo string, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error)
+	AddTeamRestrictions                   func(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error)
+	AddUserRestrictions                   func(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error)
+	CompareCommits                        func(ctx context.Context, owner string, repo string, base string, head string, opts *github.ListOptions) (*github.CommitsComparison, *github.Response, error)
+	CompareCommitsRaw                     func(ctx context.Context, owner string, repo string, base string, head string, opts github.RawOptions) (string, *github.Response, error)
+	Create                                func(ctx context.Context, org string, repo *github.Repository) (*github.Repository, *github.Response, error)
+	CreateComment                         func(ctx context.Context, owner string, repo string, sha string, comment *github.RepositoryComment) (*github.RepositoryComment, *github.Response, error)
+	CreateCustomDeploymentProtectionRule  func(ctx context.Context, owner string, repo string, environment string, request *github.CustomDeploymentProtectionRuleRequest) (*github.CustomDeploymentProtectionRule, *github.Response, error)
+	CreateDeployment                      func(ctx context.Context, owner string, repo string, request *github.DeploymentRequest) (*github.Deployment, *github.Response, error)
+	CreateDeploymentBranchPolicy          func(ctx context.Context, owner string, repo string, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error)
+	CreateDeploymentStatus                func(ctx context.Context, owner string, repo string, deployment int64, request *github.DeploymentStatusRequest) (*github.DeploymentStatus, *github.Response, error)
+	CreateFile                            func(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	CreateFork                            func(ctx context.Context, owner string, repo string, opts *github.RepositoryCreateForkOptions) (*github.Repository, *github.Response, error)
+	CreateFromTemplate                    func(ctx context.Context, templateOwner string, templateRepo string, templateRepoReq *github.TemplateRepoRequest) (*github.Repository, *github.Response, error)
+	CreateHook                            func(ctx context.Context, owner string, repo string, hook *github.Hook) (*github.Hook, *github.Response, error)
+	CreateKey                             func(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error)
+	CreateOrUpdateCustomProperties        func(ctx context.Context, org string, repo string, customPropertyValues []*github.CustomPropertyValue) (*github.Response, error)
+	CreateProject                         func(ctx context.Context, owner string, repo string, opts *github.ProjectOptions) (*github.Project, *github.Response, error)
+	CreateRelease                         func(ctx context.Context, owner string, repo string, release *github.RepositoryRelease) (*github.RepositoryRelease, *github.Response, error)
+	CreateRuleset                         func(ctx context.Context, owner string, repo string, rs *github.Ruleset) (*github.Ruleset, *github.Response, error)
+	CreateStatus                          func(ctx context.Context, owner string, repo string, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error)
+	CreateTagProtection                   func(ctx context.Context, owner string, repo string, pattern string) (*github.TagProtection, *github.Response, error)
+	CreateUpdateEnvironment               func(ctx context.Context, owner string, repo string, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error)
+	Delete                                func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	DeleteAutolink                        func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteComment                         func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteDeployment                      func(ctx context.Context, owner string, repo string, deploymentID int64) (*github.Response, error)
+	DeleteDeploymentBranchPolicy          func(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64) (*github.Response, error)
+	DeleteEnvironment                     func(ctx context.Context, owner string, repo string, name string) (*github.Response, error)
+	DeleteFile                            func(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	DeleteHook                            func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteInvitation                      func(ctx context.Context, owner string, repo string, invitationID int64) (*github.Response, error)
+	DeleteKey                             func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeletePreReceiveHook                  func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteRelease                         func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteReleaseAsset                    func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	DeleteRuleset                         func(ctx context.Context, owner string, repo string, rulesetID int64) (*github.Response, error)
+	DeleteTagProtection                   func(ctx context.Context, owner string, repo string, tagProtectionID int64) (*github.Response, error)
+	DisableAutomatedSecurityFixes         func(ctx context.Context, owner string, repository string) (*github.Response, error)
+	DisableCustomDeploymentProtectionRule func(ctx context.Context, owner string, repo string, environment string, protectionRuleID int64) (*github.Response, error)
+	DisableDismissalRestrictions          func(ctx context.Context, owner string, repo string, branch string) (*github.PullRequestReviewsEnforcement, *github.Response, error)
+	DisableLFS                            func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	DisablePages                          func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	DisablePrivateReporting               func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	DisableVulnerabilityAlerts            func(ctx context.Context, owner string, repository string) (*github.Response, error)
+	Dispatch                              func(ctx context.Context, owner string, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error)
+	DownloadContents                      func(ctx context.Context, owner string, repo string, filepath string, opts *github.RepositoryContentGetOptions) (io.ReadCloser, *github.Response, error)
+	DownloadContentsWithMeta              func(ctx context.Context, owner string, repo string, filepath string, opts *github.RepositoryContentGetOptions) (io.ReadCloser, *github.RepositoryContent, *github.Response, error)
+	DownloadReleaseAsset                  func(ctx context.Context, owner string, repo string, id int64, followRedirectsClient *http.Client) (io.ReadCloser, string, error)
+	Edit                                  func(ctx context.Context, owner string, repo string, repository *github.Repository) (*github.Repository, *github.Response, error)
+	EditActionsAccessLevel                func(ctx context.Context, owner string, repo string, repositoryActionsAccessLevel github.RepositoryActionsAccessLevel) (*github.Response, error)
+	EditActionsAllowed                    func(ctx context.Context, org string, repo string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error)
+	EditActionsPermissions                func(ctx context.Context, owner string, repo string, actionsPermissionsRepository github.ActionsPermissionsRepository) (*github.ActionsPermissionsRepository, *github.Response, error)
+	EditDefaultWorkflowPermissions        func(ctx context.Context, owner string, repo string, permissions github.DefaultWorkflowPermissionRepository) (*github.DefaultWorkflowPermissionRepository, *github.Response, error)
+	EditHook                              func(ctx context.Context, owner string, repo string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error)
+	EditHookConfiguration                 func(ctx context.Context, owner string, repo string, id int64, config *github.HookConfig) (*github.HookConfig, *github.Response, error)
+	EditRelease                           func(ctx context.Context, owner string, repo string, id int64, release *github.RepositoryRelease) (*github.RepositoryRelease, *github.Response, error)
+	EditReleaseAsset                      func(ctx context.Context, owner string, repo string, id int64, release *github.ReleaseAsset) (*github.ReleaseAsset, *github.Response, error)
+	EnableAutomatedSecurityFixes          func(ctx context.Context, owner string, repository string) (*github.Response, error)
+	EnableLFS                             func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	EnablePages                           func(ctx context.Context, owner string, repo string, pages *github.Pages) (*github.Pages, *github.Response, error)
+	EnablePrivateReporting                func(ctx context.Context, owner string, repo string) (*github.Response, error)
+	EnableVulnerabilityAlerts             func(ctx context.Context, owner string, repository string) (*github.Response, error)
+	GenerateReleaseNotes                  func(ctx context.Context, owner string, repo string, opts *github.GenerateNotesOptions) (*github.RepositoryReleaseNotes, *github.Response, error)
+	Get                                   func(ctx context.Context, owner string, repo string) (*github.Repository, *github.Response, error)
+	GetActionsAccessLevel                 func(ctx context.Context, owner string, repo string) (*github.RepositoryActionsAccessLevel, *github.Response, error)
+	GetActionsAllowed                     func(ctx context.Context, org string, repo string) (*github.ActionsAllowed, *github.Response, error)
+	GetActionsPermissions                 func(ctx context.Context, owner string, repo string) (*github.ActionsPermissionsRepository, *github.Response, error)
+	GetAdminEnforcement                   func(ctx context.Context, owner string, repo string, branch string) (*github.AdminEnforcement, *github.Response, error)
+	GetAllCustomPropertyValues            func(ctx context.Context, org string, repo string) ([]*github.CustomPropertyValue, *github.Response, error)
+	GetAllDeploymentProtectionRules       func(ctx context.Context, owner string, repo string, environment string) (*github.ListDeploymentProtectionRuleResponse, *github.Response, error)
+	GetAllRulesets                        func(ctx context.Context, owner string, repo string, includesParents bool) ([]*github.Ruleset, *github.Response, error)
+	GetArchiveLink                        func(ctx context.Context, owner string, repo string, archiveformat github.ArchiveFormat, opts *github.RepositoryContentGetOptions, maxRedirects int) (*url.URL, *github.Response, error)
+	GetAutolink                           func(ctx context.Context, owner string, repo string, id int64) (*github.Autolink, *github.Response, error)
+	GetAutomatedSecurityFixes             func(ctx context.Context, owner string, repository string) (*github.AutomatedSecurityFixes, *github.Response, error)
+	GetBranch                             func(ctx context.Context, owner string, repo string, branch string, maxRedirects int) (*github.Branch, *github.Response, error)
+	GetBranchProtection                   func(ctx context.Context, owner string, repo string, branch string) (*github.Protection, *github.Response, error)
+	GetByID                               func(ctx context.Context, id int64) (*github.Repository, *github.Response, error)
+	GetCodeOfConduct                      func(ctx context.Context, owner string, repo string) (*github.CodeOfConduct, *github.Response, error)
+	GetCodeownersErrors                   func(ctx context.Context, owner string, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error)
+	GetCombinedStatus                     func(ctx context.Context, owner string, repo string, ref string, opts *github.ListOptions) (*github.CombinedStatus, *github.Response, error)
+	GetComment                            func(ctx context.Context, owner string, repo string, id int64) (*github.RepositoryComment, *github.Response, error)
+	GetCommit                             func(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) (*github.RepositoryCommit, *github.Response, error)
+	GetCommitRaw                          func(ctx context.Context, owner string, repo string, sha string, opts github.RawOptions) (string, *github.Response, error)
+	GetCommitSHA1                         func(ctx context.Context, owner string, repo string, ref string, lastSHA string) (string, *github.Response, error)
+	GetCommunityHealthMetrics             func(ctx context.Context, owner string, repo string) (*github.CommunityHealthMetrics, *github.Response, error)
+	GetContents                           func(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	GetCustomDeploymentProtectionRule     func(ctx context.Context, owner string, repo string, environment string, protectionRuleID int64) (*github.CustomDeploymentProtectionRule, *github.Response, error)
+	GetDefaultWorkflowPermissions         func(ctx context.Context, owner string, repo string) (*github.DefaultWorkflowPermissionRepository, *github.Response, error)
+	GetDeployment                         func(ctx context.Context, owner string, repo string, deploymentID int64) (*github.Deployment, *github.Response, error)
+	GetDeploymentBranchPolicy             func(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64) (*github.DeploymentBranchPolicy, *github.Response, error)
+	GetDeploymentStatus                   func(ctx context.Context, owner string, repo string, deploymentID int64, deploymentStatusID int64) (*github.DeploymentStatus, *github.Response, error)
+	GetEnvironment                        func(ctx context.Context, owner string, repo string, name string) (*github.Environment, *github.Response, error)
+	GetHook                               func(ctx context.Context, owner string, repo string, id int64) (*github.Hook, *github.Response, error)
+	GetHookConfiguration                  func(ctx context.Context, owner string, repo string, id int64) (*github.HookConfig, *github.Response, error)
+	GetHookDelivery                       func(ctx context.Context, owner string, repo string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	GetKey                                func(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error)
+	GetLatestPagesBuild                   func(ctx context.Context, owner string, repo string) (*github.PagesBuild, *github.Response, error)
+	GetLatestRelease                      func(ctx context.Context, owner string, repo string) (*github.RepositoryRelease, *github.Response, error)
+	GetPageBuild                          func(ctx context.Context, owner string, repo string, id int64) (*github.PagesBuild, *github.Response, error)
+	GetPageHealthCheck                    func(ctx context.Context, owner string, repo string) (*github.PagesHealthCheckResponse, *github.Response, error)
+	GetPagesInfo                          func(ctx context.Context, owner string, repo string) (*github.Pages, *github.Response, error)
+	GetPermissionLevel                    func(ctx context.Context, owner string, repo string, user string) (*github.RepositoryPermissionLevel, *github.Response, error)
+	GetPreReceiveHook                     func(ctx context.Context, owner string, repo string, id int64) (*github.PreReceiveHook, *github.Response, error)
+	GetPullRequestReviewEnforcement       func(ctx context.Context, owner string, repo string, branch string) (*github.PullRequestReviewsEnforcement, *github.Response, error)
+	GetReadme                             func(ctx context.Context, owner string, repo string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, *github.Response, error)
+	GetRelease                            func(ctx context.Context, owner string, repo string, id int64) (*github.RepositoryRelease, *github.Response, error)
+	GetReleaseAsset                       func(ctx context.Context, owner string, repo string, id int64) (*github.ReleaseAsset, *github.Response, error)
+	GetReleaseByTag                       func(ctx context.Context, owner string, repo string, tag string) (*github.RepositoryRelease, *github.Response, error)
+	GetRequiredStatusChecks               func(ctx context.Context, owner string, repo string, branch string) (*github.RequiredStatusChecks, *github.Response, error)
+	GetRulesForBranch                     func(ctx context.Context, owner string, repo string, branch string) ([]*github.RepositoryRule, *github.Response, error)
+	GetRuleset                            func(ctx context.Context, owner string, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error)
+	GetSignaturesProtectedBranch          func(ctx context.Context, owner string, repo string, branch string) (*github.SignaturesProtectedBranch, *github.Response, error)
+	GetVulnerabilityAlerts                func(ctx context.Context, owner string, repository string) (bool, *github.Response, error)
+	IsCollaborator                        func(ctx context.Context, owner string, repo string, user string) (bool, *github.Response, error)
+	IsPrivateReportingEnabled             func(ctx context.Context, owner string, repo string) (bool, *github.Response, error)
+	License                               func(ctx context.Context, owner string, repo string) (*github.RepositoryLicense, *github.Response, error)
+	List                                  func(ctx context.Context, user string, opts *github.RepositoryListOptions) ([]*github.Repository, *github.Response, error)
+	ListAll                               func(ctx context.Context, opts *github.RepositoryListAllOptions) ([]*github.Repository, *github.Response, error)
+	ListAllTopics                         func(ctx context.Context, owner string, repo string) ([]string, *github.Response, error)
+	ListAppRestrictions                   func(ctx context.Context, owner string, repo string, branch string) ([]*github.App, *github.Response, error)
+	ListApps                              func(ctx context.Context, owner string, repo string, branch string) ([]*github.App, *github.Response, error)
+	ListAutolinks                         func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Autolink, *github.Response, error)
+	ListBranches                          func(ctx context.Context, owner string, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error)
+	ListBranchesHeadCommit                func(ctx context.Context, owner string, repo string, sha string) ([]*github.BranchCommit, *github.Response, error)
+	ListByAuthenticatedUser               func(ctx context.Context, opts *github.RepositoryListByAuthenticatedUserOptions) ([]*github.Repository, *github.Response, error)
+	ListByOrg                             func(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error)
+	ListByUser                            func(ctx context.Context, user string, opts *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error)
+	ListCodeFrequency                     func(ctx context.Context, owner string, repo string) ([]*github.WeeklyStats, *github.Response, error)
+	ListCollaborators                     func(ctx context.Context, owner string, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error)
+	ListComments                          func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryComment, *github.Response, error)
+	ListCommitActivity                    func(ctx context.Context, owner string, repo string) ([]*github.WeeklyCommitActivity, *github.Response, error)
+	ListCommitComments                    func(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) ([]*github.RepositoryComment, *github.Response, error)
+	ListCommits                           func(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error)
+	ListContributors                      func(ctx context.Context, owner string, repository string, opts *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error)
+	ListContributorsStats                 func(ctx context.Context, owner string, repo string) ([]*github.ContributorStats, *github.Response, error)
+	ListCustomDeploymentRuleIntegrations  func(ctx context.Context, owner string, repo string, environment string) (*github.ListCustomDeploymentRuleIntegrationsResponse, *github.Response, error)
+	ListDeploymentBranchPolicies          func(ctx context.Context, owner string, repo string, environment string) (*github.DeploymentBranchPolicyResponse, *github.Response, error)
+	ListDeploymentStatuses                func(ctx context.Context, owner string, repo string, deployment int64, opts *github.ListOptions) ([]*github.DeploymentStatus, *github.Response, error)
+	ListDeployments                       func(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions) ([]*github.Deployment, *github.Response, error)
+	ListEnvironments                      func(ctx context.Context, owner string, repo string, opts *github.EnvironmentListOptions) (*github.EnvResponse, *github.Response, error)
+	ListForks                             func(ctx context.Context, owner string, repo string, opts *github.RepositoryListForksOptions) ([]*github.Repository, *github.Response, error)
+	ListHookDeliveries                    func(ctx context.Context, owner string, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
+	ListHooks                             func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error)
+	ListInvitations                       func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryInvitation, *github.Response, error)
+	ListKeys                              func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Key, *github.Response, error)
+	ListLanguages                         func(ctx context.Context, owner string, repo string) (map[string]int, *github.Response, error)
+	ListPagesBuilds                       func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.PagesBuild, *github.Response, error)
+	ListParticipation                     func(ctx context.Context, owner string, repo string) (*github.RepositoryParticipation, *github.Response, error)
+	ListPreReceiveHooks                   func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.PreReceiveHook, *github.Response, error)
+	ListProjects                          func(ctx context.Context, owner string, repo string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error)
+	ListPunchCard                         func(ctx context.Context, owner string, repo string) ([]*github.PunchCard, *github.Response, error)
+	ListReleaseAssets                     func(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.ReleaseAsset, *github.Response, error)
+	ListReleases                          func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+	ListRequiredStatusChecksContexts      func(ctx context.Context, owner string, repo string, branch string) ([]string, *github.Response, error)
+	ListStatuses                          func(ctx context.Context, owner string, repo string, ref string, opts *github.ListOptions) ([]*github.RepoStatus, *github.Response, error)
+	ListTagProtection                     func(ctx context.Context, owner string, repo string) ([]*github.TagProtection, *github.Response, error)
+	ListTags                              func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryTag, *github.Response, error)
+	ListTeamRestrictions                  func(ctx context.Context, owner string, repo string, branch string) ([]*github.Team, *github.Response, error)
+	ListTeams                             func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListTrafficClones                     func(ctx context.Context, owner string, repo string, opts *github.TrafficBreakdownOptions) (*github.TrafficClones, *github.Response, error)
+	ListTrafficPaths                      func(ctx context.Context, owner string, repo string) ([]*github.TrafficPath, *github.Response, error)
+	ListTrafficReferrers                  func(ctx context.Context, owner string, repo string) ([]*github.TrafficReferrer, *github.Response, error)
+	ListTrafficViews                      func(ctx context.Context, owner string, repo string, opts *github.TrafficBreakdownOptions) (*github.TrafficViews, *github.Response, error)
+	ListUserRestrictions                  func(ctx context.Context, owner string, repo string, branch string) ([]*github.User, *github.Response, error)
+	Merge                                 func(ctx context.Context, owner string, repo string, request *github.RepositoryMergeRequest) (*github.RepositoryCommit, *github.Response, error)
+	MergeUpstream                         func(ctx context.Context, owner string, repo string, request *github.RepoMergeUpstreamRequest) (*github.RepoMergeUpstreamResult, *github.Response, error)
+	OptionalSignaturesOnProtectedBranch   func(ctx context.Context, owner string, repo string, branch string) (*github.Response, error)
+	PingHook                              func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	RedeliverHookDelivery                 func(ctx context.Context, owner string, repo string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error)
+	RemoveAdminEnforcement                func(ctx context.Context, owner string, repo string, branch string) (*github.Response, error)
+	RemoveAppRestrictions                 func(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error)
+	RemoveBranchProtection                func(ctx context.Context, owner string, repo string, branch string) (*github.Response, error)
+	RemoveCollaborator                    func(ctx context.Context, owner string, repo string, user string) (*github.Response, error)
+	RemovePullRequestReviewEnforcement    func(ctx context.Context, owner string, repo string, branch string) (*github.Response, error)
+	RemoveRequiredStatusChecks            func(ctx context.Context, owner string, repo string, branch string) (*github.Response, error)
+	RemoveTeamRestrictions                func(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error)
+	RemoveUserRestrictions                func(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error)
+	RenameBranch                          func(ctx context.Context, owner string, repo string, branch string, newName string) (*github.Branch, *github.Response, error)
+	ReplaceAllTopics                      func(ctx context.Context, owner string, repo string, topics []string) ([]string, *github.Response, error)
+	ReplaceAppRestrictions                func(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error)
+	ReplaceTeamRestrictions               func(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error)
+	ReplaceUserRestrictions               func(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error)
+	RequestPageBuild                      func(ctx context.Context, owner string, repo string) (*github.PagesBuild, *github.Response, error)
+	RequireSignaturesOnProtectedBranch    func(ctx context.Context, owner string, repo string, branch string) (*github.SignaturesProtectedBranch, *github.Response, error)
+	Subscribe                             func(ctx context.Context, owner string, repo string, event string, callback string, secret []byte) (*github.Response, error)
+	TestHook                              func(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
+	Transfer                              func(ctx context.Context, owner string, repo string, transfer github.TransferRequest) (*github.Repository, *github.Response, error)
+	Unsubscribe                           func(ctx context.Context, owner string, repo string, event string, callback string, secret []byte) (*github.Response, error)
+	UpdateBranchProtection                func(ctx context.Context, owner string, repo string, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error)
+	UpdateComment                         func(ctx context.Context, owner string, repo string, id int64, comment *github.RepositoryComment) (*github.RepositoryComment, *github.Response, error)
+	UpdateDeploymentBranchPolicy          func(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error)
+	UpdateFile                            func(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	UpdateInvitation                      func(ctx context.Context, owner string, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error)
+	UpdatePages                           func(ctx context.Context, owner string, repo string, opts *github.PagesUpdate) (*github.Response, error)
+	UpdatePreReceiveHook                  func(ctx context.Context, owner string, repo string, id int64, hook *github.PreReceiveHook) (*github.PreReceiveHook, *github.Response, error)
+	UpdatePullRequestReviewEnforcement    func(ctx context.Context, owner string, repo string, branch string, patch *github.PullRequestReviewsEnforcementUpdate) (*github.PullRequestReviewsEnforcement, *github.Response, error)
+	UpdateRequiredStatusChecks            func(ctx context.Context, owner string, repo string, branch string, sreq *github.RequiredStatusChecksRequest) (*github.RequiredStatusChecks, *github.Response, error)
+	UpdateRuleset                         func(ctx context.Context, owner string, repo string, rulesetID int64, rs *github.Ruleset) (*github.Ruleset, *github.Response, error)
+	UploadReleaseAsset                    func(ctx context.Context, owner string, repo string, id int64, opts *github.UploadOptions, file *os.File) (*github.ReleaseAsset, *github.Response, error)
+
+	MapCommits     func(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error `ghx:"extension"`
+	MapDeployments func(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions, handle DeploymentHandler, pageOpts ...PaginatorOption) error   `ghx:"extension"`
+	MapReleases    func(ctx context.Context, owner string, repo string, opts *github.ListOptions, handle RepositoryReleaseHandler, pageOpts ...PaginatorOption) error       `ghx:"extension"`
+}
+
+type RepositoriesService struct {
+	f *RepositoriesServiceF
+}
+
+func (r *RepositoriesService) AddAdminEnforcement(ctx context.Context, owner string, repo string, branch string) (*github.AdminEnforcement, *github.Response, error) {
+	return r.f.AddAdminEnforcement(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) AddAppRestrictions(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error) {
+	return r.f.AddAppRestrictions(ctx, owner, repo, branch, apps)
+}
+func (r *RepositoriesService) AddAutolink(ctx context.Context, owner string, repo string, opts *github.AutolinkOptions) (*github.Autolink, *github.Response, error) {
+	return r.f.AddAutolink(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) AddCollaborator(ctx context.Context, owner string, repo string, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+	return r.f.AddCollaborator(ctx, owner, repo, user, opts)
+}
+func (r *RepositoriesService) AddTeamRestrictions(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error) {
+	return r.f.AddTeamRestrictions(ctx, owner, repo, branch, teams)
+}
+func (r *RepositoriesService) AddUserRestrictions(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error) {
+	return r.f.AddUserRestrictions(ctx, owner, repo, branch, users)
+}
+func (r *RepositoriesService) CompareCommits(ctx context.Context, owner string, repo string, base string, head string, opts *github.ListOptions) (*github.CommitsComparison, *github.Response, error) {
+	return r.f.CompareCommits(ctx, owner, repo, base, head, opts)
+}
+func (r *RepositoriesService) CompareCommitsRaw(ctx context.Context, owner string, repo string, base string, head string, opts github.RawOptions) (string, *github.Response, error) {
+	return r.f.CompareCommitsRaw(ctx, owner, repo, base, head, opts)
+}
+func (r *RepositoriesService) Create(ctx context.Context, org string, repo *github.Repository) (*github.Repository, *github.Response, error) {
+	return r.f.Create(ctx, org, repo)
+}
+func (r *RepositoriesService) CreateComment(ctx context.Context, owner string, repo string, sha string, comment *github.RepositoryComment) (*github.RepositoryComment, *github.Response, error) {
+	return r.f.CreateComment(ctx, owner, repo, sha, comment)
+}
+func (r *RepositoriesService) CreateCustomDeploymentProtectionRule(ctx context.Context, owner string, repo string, environment string, request *github.CustomDeploymentProtectionRuleRequest) (*github.CustomDeploymentProtectionRule, *github.Response, error) {
+	return r.f.CreateCustomDeploymentProtectionRule(ctx, owner, repo, environment, request)
+}
+func (r *RepositoriesService) CreateDeployment(ctx context.Context, owner string, repo string, request *github.DeploymentRequest) (*github.Deployment, *github.Response, error) {
+	return r.f.CreateDeployment(ctx, owner, repo, request)
+}
+func (r *RepositoriesService) CreateDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error) {
+	return r.f.CreateDeploymentBranchPolicy(ctx, owner, repo, environment, request)
+}
+func (r *RepositoriesService) CreateDeploymentStatus(ctx context.Context, owner string, repo string, deployment int64, request *github.DeploymentStatusRequest) (*github.DeploymentStatus, *github.Response, error) {
+	return r.f.CreateDeploymentStatus(ctx, owner, repo, deployment, request)
+}
+func (r *RepositoriesService) CreateFile(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return r.f.CreateFile(ctx, owner, repo, path, opts)
+}
+func (r *RepositoriesService) CreateFork(ctx context.Context, owner string, repo string, opts *github.RepositoryCreateForkOptions) (*github.Repository, *github.Response, error) {
+	return r.f.CreateFork(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) CreateFromTemplate(ctx context.Context, templateOwner string, templateRepo string, templateRepoReq *github.TemplateRepoRequest) (*github.Repository, *github.Response, error) {
+	return r.f.CreateFromTemplate(ctx, templateOwner, templateRepo, templateRepoReq)
+}
+func (r *RepositoriesService) CreateHook(ctx context.Context, owner string, repo string, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	return r.f.CreateHook(ctx, owner, repo, hook)
+}
+func (r *RepositoriesService) CreateKey(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error) {
+	return r.f.CreateKey(ctx, owner, repo, key)
+}
+func (r *RepositoriesService) CreateOrUpdateCustomProperties(ctx context.Context, org string, repo string, customPropertyValues []*github.CustomPropertyValue) (*github.Response, error) {
+	return r.f.CreateOrUpdateCustomProperties(ctx, org, repo, customPropertyValues)
+}
+func (r *RepositoriesService) CreateProject(ctx context.Context, owner string, repo string, opts *github.ProjectOptions) (*github.Project, *github.Response, error) {
+	return r.f.CreateProject(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) CreateRelease(ctx context.Context, owner string, repo string, release *github.RepositoryRelease) (*github.RepositoryRelease, *github.Response, error) {
+	return r.f.CreateRelease(ctx, owner, repo, release)
+}
+func (r *RepositoriesService) CreateRuleset(ctx context.Context, owner string, repo string, rs *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+	return r.f.CreateRuleset(ctx, owner, repo, rs)
+}
+func (r *RepositoriesService) CreateStatus(ctx context.Context, owner string, repo string, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error) {
+	return r.f.CreateStatus(ctx, owner, repo, ref, status)
+}
+func (r *RepositoriesService) CreateTagProtection(ctx context.Context, owner string, repo string, pattern string) (*github.TagProtection, *github.Response, error) {
+	return r.f.CreateTagProtection(ctx, owner, repo, pattern)
+}
+func (r *RepositoriesService) CreateUpdateEnvironment(ctx context.Context, owner string, repo string, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error) {
+	return r.f.CreateUpdateEnvironment(ctx, owner, repo, name, environment)
+}
+func (r *RepositoriesService) Delete(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.Delete(ctx, owner, repo)
+}
+func (r *RepositoriesService) DeleteAutolink(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteAutolink(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteComment(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteComment(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteDeployment(ctx context.Context, owner string, repo string, deploymentID int64) (*github.Response, error) {
+	return r.f.DeleteDeployment(ctx, owner, repo, deploymentID)
+}
+func (r *RepositoriesService) DeleteDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64) (*github.Response, error) {
+	return r.f.DeleteDeploymentBranchPolicy(ctx, owner, repo, environment, branchPolicyID)
+}
+func (r *RepositoriesService) DeleteEnvironment(ctx context.Context, owner string, repo string, name string) (*github.Response, error) {
+	return r.f.DeleteEnvironment(ctx, owner, repo, name)
+}
+func (r *RepositoriesService) DeleteFile(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return r.f.DeleteFile(ctx, owner, repo, path, opts)
+}
+func (r *RepositoriesService) DeleteHook(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteInvitation(ctx context.Context, owner string, repo string, invitationID int64) (*github.Response, error) {
+	return r.f.DeleteInvitation(ctx, owner, repo, invitationID)
+}
+func (r *RepositoriesService) DeleteKey(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteKey(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeletePreReceiveHook(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeletePreReceiveHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteRelease(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteRelease(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteReleaseAsset(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.DeleteReleaseAsset(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) DeleteRuleset(ctx context.Context, owner string, repo string, rulesetID int64) (*github.Response, error) {
+	return r.f.DeleteRuleset(ctx, owner, repo, rulesetID)
+}
+func (r *RepositoriesService) DeleteTagProtection(ctx context.Context, owner string, repo string, tagProtectionID int64) (*github.Response, error) {
+	return r.f.DeleteTagProtection(ctx, owner, repo, tagProtectionID)
+}
+func (r *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context, owner string, repository string) (*github.Response, error) {
+	return r.f.DisableAutomatedSecurityFixes(ctx, owner, repository)
+}
+func (r *RepositoriesService) DisableCustomDeploymentProtectionRule(ctx context.Context, owner string, repo string, environment string, protectionRuleID int64) (*github.Response, error) {
+	return r.f.DisableCustomDeploymentProtectionRule(ctx, owner, repo, environment, protectionRuleID)
+}
+func (r *RepositoriesService) DisableDismissalRestrictions(ctx context.Context, owner string, repo string, branch string) (*github.PullRequestReviewsEnforcement, *github.Response, error) {
+	return r.f.DisableDismissalRestrictions(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) DisableLFS(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.DisableLFS(ctx, owner, repo)
+}
+func (r *RepositoriesService) DisablePages(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.DisablePages(ctx, owner, repo)
+}
+func (r *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.DisablePrivateReporting(ctx, owner, repo)
+}
+func (r *RepositoriesService) DisableVulnerabilityAlerts(ctx context.Context, owner string, repository string) (*github.Response, error) {
+	return r.f.DisableVulnerabilityAlerts(ctx, owner, repository)
+}
+func (r *RepositoriesService) Dispatch(ctx context.Context, owner string, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error) {
+	return r.f.Dispatch(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) DownloadContents(ctx context.Context, owner string, repo string, filepath string, opts *github.RepositoryContentGetOptions) (io.ReadCloser, *github.Response, error) {
+	return r.f.DownloadContents(ctx, owner, repo, filepath, opts)
+}
+func (r *RepositoriesService) DownloadContentsWithMeta(ctx context.Context, owner string, repo string, filepath string, opts *github.RepositoryContentGetOptions) (io.ReadCloser, *github.RepositoryContent, *github.Response, error) {
+	return r.f.DownloadContentsWithMeta(ctx, owner, repo, filepath, opts)
+}
+func (r *RepositoriesService) DownloadReleaseAsset(ctx context.Context, owner string, repo string, id int64, followRedirectsClient *http.Client) (io.ReadCloser, string, error) {
+	return r.f.DownloadReleaseAsset(ctx, owner, repo, id, followRedirectsClient)
+}
+func (r *RepositoriesService) Edit(ctx context.Context, owner string, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+	return r.f.Edit(ctx, owner, repo, repository)
+}
+func (r *RepositoriesService) EditActionsAccessLevel(ctx context.Context, owner string, repo string, repositoryActionsAccessLevel github.RepositoryActionsAccessLevel) (*github.Response, error) {
+	return r.f.EditActionsAccessLevel(ctx, owner, repo, repositoryActionsAccessLevel)
+}
+func (r *RepositoriesService) EditActionsAllowed(ctx context.Context, org string, repo string, actionsAllowed github.ActionsAllowed) (*github.ActionsAllowed, *github.Response, error) {
+	return r.f.EditActionsAllowed(ctx, org, repo, actionsAllowed)
+}
+func (r *RepositoriesService) EditActionsPermissions(ctx context.Context, owner string, repo string, actionsPermissionsRepository github.ActionsPermissionsRepository) (*github.ActionsPermissionsRepository, *github.Response, error) {
+	return r.f.EditActionsPermissions(ctx, owner, repo, actionsPermissionsRepository)
+}
+func (r *RepositoriesService) EditDefaultWorkflowPermissions(ctx context.Context, owner string, repo string, permissions github.DefaultWorkflowPermissionRepository) (*github.DefaultWorkflowPermissionRepository, *github.Response, error) {
+	return r.f.EditDefaultWorkflowPermissions(ctx, owner, repo, permissions)
+}
+func (r *RepositoriesService) EditHook(ctx context.Context, owner string, repo string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	return r.f.EditHook(ctx, owner, repo, id, hook)
+}
+func (r *RepositoriesService) EditHookConfiguration(ctx context.Context, owner string, repo string, id int64, config *github.HookConfig) (*github.HookConfig, *github.Response, error) {
+	return r.f.EditHookConfiguration(ctx, owner, repo, id, config)
+}
+func (r *RepositoriesService) EditRelease(ctx context.Context, owner string, repo string, id int64, release *github.RepositoryRelease) (*github.RepositoryRelease, *github.Response, error) {
+	return r.f.EditRelease(ctx, owner, repo, id, release)
+}
+func (r *RepositoriesService) EditReleaseAsset(ctx context.Context, owner string, repo string, id int64, release *github.ReleaseAsset) (*github.ReleaseAsset, *github.Response, error) {
+	return r.f.EditReleaseAsset(ctx, owner, repo, id, release)
+}
+func (r *RepositoriesService) EnableAutomatedSecurityFixes(ctx context.Context, owner string, repository string) (*github.Response, error) {
+	return r.f.EnableAutomatedSecurityFixes(ctx, owner, repository)
+}
+func (r *RepositoriesService) EnableLFS(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.EnableLFS(ctx, owner, repo)
+}
+func (r *RepositoriesService) EnablePages(ctx context.Context, owner string, repo string, pages *github.Pages) (*github.Pages, *github.Response, error) {
+	return r.f.EnablePages(ctx, owner, repo, pages)
+}
+func (r *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner string, repo string) (*github.Response, error) {
+	return r.f.EnablePrivateReporting(ctx, owner, repo)
+}
+func (r *RepositoriesService) EnableVulnerabilityAlerts(ctx context.Context, owner string, repository string) (*github.Response, error) {
+	return r.f.EnableVulnerabilityAlerts(ctx, owner, repository)
+}
+func (r *RepositoriesService) GenerateReleaseNotes(ctx context.Context, owner string, repo string, opts *github.GenerateNotesOptions) (*github.RepositoryReleaseNotes, *github.Response, error) {
+	return r.f.GenerateReleaseNotes(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) Get(ctx context.Context, owner string, repo string) (*github.Repository, *github.Response, error) {
+	return r.f.Get(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetActionsAccessLevel(ctx context.Context, owner string, repo string) (*github.RepositoryActionsAccessLevel, *github.Response, error) {
+	return r.f.GetActionsAccessLevel(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetActionsAllowed(ctx context.Context, org string, repo string) (*github.ActionsAllowed, *github.Response, error) {
+	return r.f.GetActionsAllowed(ctx, org, repo)
+}
+func (r *RepositoriesService) GetActionsPermissions(ctx context.Context, owner string, repo string) (*github.ActionsPermissionsRepository, *github.Response, error) {
+	return r.f.GetActionsPermissions(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetAdminEnforcement(ctx context.Context, owner string, repo string, branch string) (*github.AdminEnforcement, *github.Response, error) {
+	return r.f.GetAdminEnforcement(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetAllCustomPropertyValues(ctx context.Context, org string, repo string) ([]*github.CustomPropertyValue, *github.Response, error) {
+	return r.f.GetAllCustomPropertyValues(ctx, org, repo)
+}
+func (r *RepositoriesService) GetAllDeploymentProtectionRules(ctx context.Context, owner string, repo string, environment string) (*github.ListDeploymentProtectionRuleResponse, *github.Response, error) {
+	return r.f.GetAllDeploymentProtectionRules(ctx, owner, repo, environment)
+}
+func (r *RepositoriesService) GetAllRulesets(ctx context.Context, owner string, repo string, includesParents bool) ([]*github.Ruleset, *github.Response, error) {
+	return r.f.GetAllRulesets(ctx, owner, repo, includesParents)
+}
+func (r *RepositoriesService) GetArchiveLink(ctx context.Context, owner string, repo string, archiveformat github.ArchiveFormat, opts *github.RepositoryContentGetOptions, maxRedirects int) (*url.URL, *github.Response, error) {
+	return r.f.GetArchiveLink(ctx, owner, repo, archiveformat, opts, maxRedirects)
+}
+func (r *RepositoriesService) GetAutolink(ctx context.Context, owner string, repo string, id int64) (*github.Autolink, *github.Response, error) {
+	return r.f.GetAutolink(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetAutomatedSecurityFixes(ctx context.Context, owner string, repository string) (*github.AutomatedSecurityFixes, *github.Response, error) {
+	return r.f.GetAutomatedSecurityFixes(ctx, owner, repository)
+}
+func (r *RepositoriesService) GetBranch(ctx context.Context, owner string, repo string, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+	return r.f.GetBranch(ctx, owner, repo, branch, maxRedirects)
+}
+func (r *RepositoriesService) GetBranchProtection(ctx context.Context, owner string, repo string, branch string) (*github.Protection, *github.Response, error) {
+	return r.f.GetBranchProtection(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetByID(ctx context.Context, id int64) (*github.Repository, *github.Response, error) {
+	return r.f.GetByID(ctx, id)
+}
+func (r *RepositoriesService) GetCodeOfConduct(ctx context.Context, owner string, repo string) (*github.CodeOfConduct, *github.Response, error) {
+	return r.f.GetCodeOfConduct(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetCodeownersErrors(ctx context.Context, owner string, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error) {
+	return r.f.GetCodeownersErrors(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) GetCombinedStatus(ctx context.Context, owner string, repo string, ref string, opts *github.ListOptions) (*github.CombinedStatus, *github.Response, error) {
+	return r.f.GetCombinedStatus(ctx, owner, repo, ref, opts)
+}
+func (r *RepositoriesService) GetComment(ctx context.Context, owner string, repo string, id int64) (*github.RepositoryComment, *github.Response, error) {
+	return r.f.GetComment(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetCommit(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) (*github.RepositoryCommit, *github.Response, error) {
+	return r.f.GetCommit(ctx, owner, repo, sha, opts)
+}
+func (r *RepositoriesService) GetCommitRaw(ctx context.Context, owner string, repo string, sha string, opts github.RawOptions) (string, *github.Response, error) {
+	return r.f.GetCommitRaw(ctx, owner, repo, sha, opts)
+}
+func (r *RepositoriesService) GetCommitSHA1(ctx context.Context, owner string, repo string, ref string, lastSHA string) (string, *github.Response, error) {
+	return r.f.GetCommitSHA1(ctx, owner, repo, ref, lastSHA)
+}
+func (r *RepositoriesService) GetCommunityHealthMetrics(ctx context.Context, owner string, repo string) (*github.CommunityHealthMetrics, *github.Response, error) {
+	return r.f.GetCommunityHealthMetrics(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetContents(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	return r.f.GetContents(ctx, owner, repo, path, opts)
+}
+func (r *RepositoriesService) GetCustomDeploymentProtectionRule(ctx context.Context, owner string, repo string, environment string, protectionRuleID int64) (*github.CustomDeploymentProtectionRule, *github.Response, error) {
+	return r.f.GetCustomDeploymentProtectionRule(ctx, owner, repo, environment, protectionRuleID)
+}
+func (r *RepositoriesService) GetDefaultWorkflowPermissions(ctx context.Context, owner string, repo string) (*github.DefaultWorkflowPermissionRepository, *github.Response, error) {
+	return r.f.GetDefaultWorkflowPermissions(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetDeployment(ctx context.Context, owner string, repo string, deploymentID int64) (*github.Deployment, *github.Response, error) {
+	return r.f.GetDeployment(ctx, owner, repo, deploymentID)
+}
+func (r *RepositoriesService) GetDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64) (*github.DeploymentBranchPolicy, *github.Response, error) {
+	return r.f.GetDeploymentBranchPolicy(ctx, owner, repo, environment, branchPolicyID)
+}
+func (r *RepositoriesService) GetDeploymentStatus(ctx context.Context, owner string, repo string, deploymentID int64, deploymentStatusID int64) (*github.DeploymentStatus, *github.Response, error) {
+	return r.f.GetDeploymentStatus(ctx, owner, repo, deploymentID, deploymentStatusID)
+}
+func (r *RepositoriesService) GetEnvironment(ctx context.Context, owner string, repo string, name string) (*github.Environment, *github.Response, error) {
+	return r.f.GetEnvironment(ctx, owner, repo, name)
+}
+func (r *RepositoriesService) GetHook(ctx context.Context, owner string, repo string, id int64) (*github.Hook, *github.Response, error) {
+	return r.f.GetHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetHookConfiguration(ctx context.Context, owner string, repo string, id int64) (*github.HookConfig, *github.Response, error) {
+	return r.f.GetHookConfiguration(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetHookDelivery(ctx context.Context, owner string, repo string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return r.f.GetHookDelivery(ctx, owner, repo, hookID, deliveryID)
+}
+func (r *RepositoriesService) GetKey(ctx context.Context, owner string, repo string, id int64) (*github.Key, *github.Response, error) {
+	return r.f.GetKey(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetLatestPagesBuild(ctx context.Context, owner string, repo string) (*github.PagesBuild, *github.Response, error) {
+	return r.f.GetLatestPagesBuild(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetLatestRelease(ctx context.Context, owner string, repo string) (*github.RepositoryRelease, *github.Response, error) {
+	return r.f.GetLatestRelease(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetPageBuild(ctx context.Context, owner string, repo string, id int64) (*github.PagesBuild, *github.Response, error) {
+	return r.f.GetPageBuild(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetPageHealthCheck(ctx context.Context, owner string, repo string) (*github.PagesHealthCheckResponse, *github.Response, error) {
+	return r.f.GetPageHealthCheck(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetPagesInfo(ctx context.Context, owner string, repo string) (*github.Pages, *github.Response, error) {
+	return r.f.GetPagesInfo(ctx, owner, repo)
+}
+func (r *RepositoriesService) GetPermissionLevel(ctx context.Context, owner string, repo string, user string) (*github.RepositoryPermissionLevel, *github.Response, error) {
+	return r.f.GetPermissionLevel(ctx, owner, repo, user)
+}
+func (r *RepositoriesService) GetPreReceiveHook(ctx context.Context, owner string, repo string, id int64) (*github.PreReceiveHook, *github.Response, error) {
+	return r.f.GetPreReceiveHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetPullRequestReviewEnforcement(ctx context.Context, owner string, repo string, branch string) (*github.PullRequestReviewsEnforcement, *github.Response, error) {
+	return r.f.GetPullRequestReviewEnforcement(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetReadme(ctx context.Context, owner string, repo string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, *github.Response, error) {
+	return r.f.GetReadme(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) GetRelease(ctx context.Context, owner string, repo string, id int64) (*github.RepositoryRelease, *github.Response, error) {
+	return r.f.GetRelease(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetReleaseAsset(ctx context.Context, owner string, repo string, id int64) (*github.ReleaseAsset, *github.Response, error) {
+	return r.f.GetReleaseAsset(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) GetReleaseByTag(ctx context.Context, owner string, repo string, tag string) (*github.RepositoryRelease, *github.Response, error) {
+	return r.f.GetReleaseByTag(ctx, owner, repo, tag)
+}
+func (r *RepositoriesService) GetRequiredStatusChecks(ctx context.Context, owner string, repo string, branch string) (*github.RequiredStatusChecks, *github.Response, error) {
+	return r.f.GetRequiredStatusChecks(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetRulesForBranch(ctx context.Context, owner string, repo string, branch string) ([]*github.RepositoryRule, *github.Response, error) {
+	return r.f.GetRulesForBranch(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetRuleset(ctx context.Context, owner string, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+	return r.f.GetRuleset(ctx, owner, repo, rulesetID, includesParents)
+}
+func (r *RepositoriesService) GetSignaturesProtectedBranch(ctx context.Context, owner string, repo string, branch string) (*github.SignaturesProtectedBranch, *github.Response, error) {
+	return r.f.GetSignaturesProtectedBranch(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) GetVulnerabilityAlerts(ctx context.Context, owner string, repository string) (bool, *github.Response, error) {
+	return r.f.GetVulnerabilityAlerts(ctx, owner, repository)
+}
+func (r *RepositoriesService) IsCollaborator(ctx context.Context, owner string, repo string, user string) (bool, *github.Response, error) {
+	return r.f.IsCollaborator(ctx, owner, repo, user)
+}
+func (r *RepositoriesService) IsPrivateReportingEnabled(ctx context.Context, owner string, repo string) (bool, *github.Response, error) {
+	return r.f.IsPrivateReportingEnabled(ctx, owner, repo)
+}
+func (r *RepositoriesService) License(ctx context.Context, owner string, repo string) (*github.RepositoryLicense, *github.Response, error) {
+	return r.f.License(ctx, owner, repo)
+}
+func (r *RepositoriesService) List(ctx context.Context, user string, opts *github.RepositoryListOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.List(ctx, user, opts)
+}
+func (r *RepositoriesService) ListAll(ctx context.Context, opts *github.RepositoryListAllOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.ListAll(ctx, opts)
+}
+func (r *RepositoriesService) ListAllTopics(ctx context.Context, owner string, repo string) ([]string, *github.Response, error) {
+	return r.f.ListAllTopics(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListAppRestrictions(ctx context.Context, owner string, repo string, branch string) ([]*github.App, *github.Response, error) {
+	return r.f.ListAppRestrictions(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) ListApps(ctx context.Context, owner string, repo string, branch string) ([]*github.App, *github.Response, error) {
+	return r.f.ListApps(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) ListAutolinks(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Autolink, *github.Response, error) {
+	return r.f.ListAutolinks(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListBranches(ctx context.Context, owner string, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+	return r.f.ListBranches(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListBranchesHeadCommit(ctx context.Context, owner string, repo string, sha string) ([]*github.BranchCommit, *github.Response, error) {
+	return r.f.ListBranchesHeadCommit(ctx, owner, repo, sha)
+}
+func (r *RepositoriesService) ListByAuthenticatedUser(ctx context.Context, opts *github.RepositoryListByAuthenticatedUserOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.ListByAuthenticatedUser(ctx, opts)
+}
+func (r *RepositoriesService) ListByOrg(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.ListByOrg(ctx, org, opts)
+}
+func (r *RepositoriesService) ListByUser(ctx context.Context, user string, opts *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.ListByUser(ctx, user, opts)
+}
+func (r *RepositoriesService) ListCodeFrequency(ctx context.Context, owner string, repo string) ([]*github.WeeklyStats, *github.Response, error) {
+	return r.f.ListCodeFrequency(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListCollaborators(ctx context.Context, owner string, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+	return r.f.ListCollaborators(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListComments(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryComment, *github.Response, error) {
+	return r.f.ListComments(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListCommitActivity(ctx context.Context, owner string, repo string) ([]*github.WeeklyCommitActivity, *github.Response, error) {
+	return r.f.ListCommitActivity(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListCommitComments(ctx context.Context, owner string, repo string, sha string, opts *github.ListOptions) ([]*github.RepositoryComment, *github.Response, error) {
+	return r.f.ListCommitComments(ctx, owner, repo, sha, opts)
+}
+func (r *RepositoriesService) ListCommits(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+	return r.f.ListCommits(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListContributors(ctx context.Context, owner string, repository string, opts *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+	return r.f.ListContributors(ctx, owner, repository, opts)
+}
+func (r *RepositoriesService) ListContributorsStats(ctx context.Context, owner string, repo string) ([]*github.ContributorStats, *github.Response, error) {
+	return r.f.ListContributorsStats(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListCustomDeploymentRuleIntegrations(ctx context.Context, owner string, repo string, environment string) (*github.ListCustomDeploymentRuleIntegrationsResponse, *github.Response, error) {
+	return r.f.ListCustomDeploymentRuleIntegrations(ctx, owner, repo, environment)
+}
+func (r *RepositoriesService) ListDeploymentBranchPolicies(ctx context.Context, owner string, repo string, environment string) (*github.DeploymentBranchPolicyResponse, *github.Response, error) {
+	return r.f.ListDeploymentBranchPolicies(ctx, owner, repo, environment)
+}
+func (r *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner string, repo string, deployment int64, opts *github.ListOptions) ([]*github.DeploymentStatus, *github.Response, error) {
+	return r.f.ListDeploymentStatuses(ctx, owner, repo, deployment, opts)
+}
+func (r *RepositoriesService) ListDeployments(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions) ([]*github.Deployment, *github.Response, error) {
+	return r.f.ListDeployments(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListEnvironments(ctx context.Context, owner string, repo string, opts *github.EnvironmentListOptions) (*github.EnvResponse, *github.Response, error) {
+	return r.f.ListEnvironments(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListForks(ctx context.Context, owner string, repo string, opts *github.RepositoryListForksOptions) ([]*github.Repository, *github.Response, error) {
+	return r.f.ListForks(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListHookDeliveries(ctx context.Context, owner string, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	return r.f.ListHookDeliveries(ctx, owner, repo, id, opts)
+}
+func (r *RepositoriesService) ListHooks(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+	return r.f.ListHooks(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListInvitations(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryInvitation, *github.Response, error) {
+	return r.f.ListInvitations(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListKeys(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Key, *github.Response, error) {
+	return r.f.ListKeys(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListLanguages(ctx context.Context, owner string, repo string) (map[string]int, *github.Response, error) {
+	return r.f.ListLanguages(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListPagesBuilds(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.PagesBuild, *github.Response, error) {
+	return r.f.ListPagesBuilds(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListParticipation(ctx context.Context, owner string, repo string) (*github.RepositoryParticipation, *github.Response, error) {
+	return r.f.ListParticipation(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListPreReceiveHooks(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.PreReceiveHook, *github.Response, error) {
+	return r.f.ListPreReceiveHooks(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListProjects(ctx context.Context, owner string, repo string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error) {
+	return r.f.ListProjects(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListPunchCard(ctx context.Context, owner string, repo string) ([]*github.PunchCard, *github.Response, error) {
+	return r.f.ListPunchCard(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListReleaseAssets(ctx context.Context, owner string, repo string, id int64, opts *github.ListOptions) ([]*github.ReleaseAsset, *github.Response, error) {
+	return r.f.ListReleaseAssets(ctx, owner, repo, id, opts)
+}
+func (r *RepositoriesService) ListReleases(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return r.f.ListReleases(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListRequiredStatusChecksContexts(ctx context.Context, owner string, repo string, branch string) ([]string, *github.Response, error) {
+	return r.f.ListRequiredStatusChecksContexts(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) ListStatuses(ctx context.Context, owner string, repo string, ref string, opts *github.ListOptions) ([]*github.RepoStatus, *github.Response, error) {
+	return r.f.ListStatuses(ctx, owner, repo, ref, opts)
+}
+func (r *RepositoriesService) ListTagProtection(ctx context.Context, owner string, repo string) ([]*github.TagProtection, *github.Response, error) {
+	return r.f.ListTagProtection(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListTags(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryTag, *github.Response, error) {
+	return r.f.ListTags(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListTeamRestrictions(ctx context.Context, owner string, repo string, branch string) ([]*github.Team, *github.Response, error) {
+	return r.f.ListTeamRestrictions(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) ListTeams(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return r.f.ListTeams(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListTrafficClones(ctx context.Context, owner string, repo string, opts *github.TrafficBreakdownOptions) (*github.TrafficClones, *github.Response, error) {
+	return r.f.ListTrafficClones(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListTrafficPaths(ctx context.Context, owner string, repo string) ([]*github.TrafficPath, *github.Response, error) {
+	return r.f.ListTrafficPaths(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListTrafficReferrers(ctx context.Context, owner string, repo string) ([]*github.TrafficReferrer, *github.Response, error) {
+	return r.f.ListTrafficReferrers(ctx, owner, repo)
+}
+func (r *RepositoriesService) ListTrafficViews(ctx context.Context, owner string, repo string, opts *github.TrafficBreakdownOptions) (*github.TrafficViews, *github.Response, error) {
+	return r.f.ListTrafficViews(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) ListUserRestrictions(ctx context.Context, owner string, repo string, branch string) ([]*github.User, *github.Response, error) {
+	return r.f.ListUserRestrictions(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) Merge(ctx context.Context, owner string, repo string, request *github.RepositoryMergeRequest) (*github.RepositoryCommit, *github.Response, error) {
+	return r.f.Merge(ctx, owner, repo, request)
+}
+func (r *RepositoriesService) MergeUpstream(ctx context.Context, owner string, repo string, request *github.RepoMergeUpstreamRequest) (*github.RepoMergeUpstreamResult, *github.Response, error) {
+	return r.f.MergeUpstream(ctx, owner, repo, request)
+}
+func (r *RepositoriesService) OptionalSignaturesOnProtectedBranch(ctx context.Context, owner string, repo string, branch string) (*github.Response, error) {
+	return r.f.OptionalSignaturesOnProtectedBranch(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) PingHook(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.PingHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) RedeliverHookDelivery(ctx context.Context, owner string, repo string, hookID int64, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	return r.f.RedeliverHookDelivery(ctx, owner, repo, hookID, deliveryID)
+}
+func (r *RepositoriesService) RemoveAdminEnforcement(ctx context.Context, owner string, repo string, branch string) (*github.Response, error) {
+	return r.f.RemoveAdminEnforcement(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) RemoveAppRestrictions(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error) {
+	return r.f.RemoveAppRestrictions(ctx, owner, repo, branch, apps)
+}
+func (r *RepositoriesService) RemoveBranchProtection(ctx context.Context, owner string, repo string, branch string) (*github.Response, error) {
+	return r.f.RemoveBranchProtection(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) RemoveCollaborator(ctx context.Context, owner string, repo string, user string) (*github.Response, error) {
+	return r.f.RemoveCollaborator(ctx, owner, repo, user)
+}
+func (r *RepositoriesService) RemovePullRequestReviewEnforcement(ctx context.Context, owner string, repo string, branch string) (*github.Response, error) {
+	return r.f.RemovePullRequestReviewEnforcement(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) RemoveRequiredStatusChecks(ctx context.Context, owner string, repo string, branch string) (*github.Response, error) {
+	return r.f.RemoveRequiredStatusChecks(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) RemoveTeamRestrictions(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error) {
+	return r.f.RemoveTeamRestrictions(ctx, owner, repo, branch, teams)
+}
+func (r *RepositoriesService) RemoveUserRestrictions(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error) {
+	return r.f.RemoveUserRestrictions(ctx, owner, repo, branch, users)
+}
+func (r *RepositoriesService) RenameBranch(ctx context.Context, owner string, repo string, branch string, newName string) (*github.Branch, *github.Response, error) {
+	return r.f.RenameBranch(ctx, owner, repo, branch, newName)
+}
+func (r *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner string, repo string, topics []string) ([]string, *github.Response, error) {
+	return r.f.ReplaceAllTopics(ctx, owner, repo, topics)
+}
+func (r *RepositoriesService) ReplaceAppRestrictions(ctx context.Context, owner string, repo string, branch string, apps []string) ([]*github.App, *github.Response, error) {
+	return r.f.ReplaceAppRestrictions(ctx, owner, repo, branch, apps)
+}
+func (r *RepositoriesService) ReplaceTeamRestrictions(ctx context.Context, owner string, repo string, branch string, teams []string) ([]*github.Team, *github.Response, error) {
+	return r.f.ReplaceTeamRestrictions(ctx, owner, repo, branch, teams)
+}
+func (r *RepositoriesService) ReplaceUserRestrictions(ctx context.Context, owner string, repo string, branch string, users []string) ([]*github.User, *github.Response, error) {
+	return r.f.ReplaceUserRestrictions(ctx, owner, repo, branch, users)
+}
+func (r *RepositoriesService) RequestPageBuild(ctx context.Context, owner string, repo string) (*github.PagesBuild, *github.Response, error) {
+	return r.f.RequestPageBuild(ctx, owner, repo)
+}
+func (r *RepositoriesService) RequireSignaturesOnProtectedBranch(ctx context.Context, owner string, repo string, branch string) (*github.SignaturesProtectedBranch, *github.Response, error) {
+	return r.f.RequireSignaturesOnProtectedBranch(ctx, owner, repo, branch)
+}
+func (r *RepositoriesService) Subscribe(ctx context.Context, owner string, repo string, event string, callback string, secret []byte) (*github.Response, error) {
+	return r.f.Subscribe(ctx, owner, repo, event, callback, secret)
+}
+func (r *RepositoriesService) TestHook(ctx context.Context, owner string, repo string, id int64) (*github.Response, error) {
+	return r.f.TestHook(ctx, owner, repo, id)
+}
+func (r *RepositoriesService) Transfer(ctx context.Context, owner string, repo string, transfer github.TransferRequest) (*github.Repository, *github.Response, error) {
+	return r.f.Transfer(ctx, owner, repo, transfer)
+}
+func (r *RepositoriesService) Unsubscribe(ctx context.Context, owner string, repo string, event string, callback string, secret []byte) (*github.Response, error) {
+	return r.f.Unsubscribe(ctx, owner, repo, event, callback, secret)
+}
+func (r *RepositoriesService) UpdateBranchProtection(ctx context.Context, owner string, repo string, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error) {
+	return r.f.UpdateBranchProtection(ctx, owner, repo, branch, preq)
+}
+func (r *RepositoriesService) UpdateComment(ctx context.Context, owner string, repo string, id int64, comment *github.RepositoryComment) (*github.RepositoryComment, *github.Response, error) {
+	return r.f.UpdateComment(ctx, owner, repo, id, comment)
+}
+func (r *RepositoriesService) UpdateDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, branchPolicyID int64, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error) {
+	return r.f.UpdateDeploymentBranchPolicy(ctx, owner, repo, environment, branchPolicyID, request)
+}
+func (r *RepositoriesService) UpdateFile(ctx context.Context, owner string, repo string, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return r.f.UpdateFile(ctx, owner, repo, path, opts)
+}
+func (r *RepositoriesService) UpdateInvitation(ctx context.Context, owner string, repo string, invitationID int64, permissions string) (*github.RepositoryInvitation, *github.Response, error) {
+	return r.f.UpdateInvitation(ctx, owner, repo, invitationID, permissions)
+}
+func (r *RepositoriesService) UpdatePages(ctx context.Context, owner string, repo string, opts *github.PagesUpdate) (*github.Response, error) {
+	return r.f.UpdatePages(ctx, owner, repo, opts)
+}
+func (r *RepositoriesService) UpdatePreReceiveHook(ctx context.Context, owner string, repo string, id int64, hook *github.PreReceiveHook) (*github.PreReceiveHook, *github.Response, error) {
+	return r.f.UpdatePreReceiveHook(ctx, owner, repo, id, hook)
+}
+func (r *RepositoriesService) UpdatePullRequestReviewEnforcement(ctx context.Context, owner string, repo string, branch string, patch *github.PullRequestReviewsEnforcementUpdate) (*github.PullRequestReviewsEnforcement, *github.Response, error) {
+	return r.f.UpdatePullRequestReviewEnforcement(ctx, owner, repo, branch, patch)
+}
+func (r *RepositoriesService) UpdateRequiredStatusChecks(ctx context.Context, owner string, repo string, branch string, sreq *github.RequiredStatusChecksRequest) (*github.RequiredStatusChecks, *github.Response, error) {
+	return r.f.UpdateRequiredStatusChecks(ctx, owner, repo, branch, sreq)
+}
+func (r *RepositoriesService) UpdateRuleset(ctx context.Context, owner string, repo string, rulesetID int64, rs *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+	return r.f.UpdateRuleset(ctx, owner, repo, rulesetID, rs)
+}
+func (r *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner string, repo string, id int64, opts *github.UploadOptions, file *os.File) (*github.ReleaseAsset, *github.Response, error) {
+	return r.f.UploadReleaseAsset(ctx, owner, repo, id, opts, file)
+}
+
+func (r *RepositoriesService) MapCommits(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+	return r.f.MapCommits(ctx, owner, repo, opts, handle, pageOpts...)
+}
+func (r *RepositoriesService) MapDeployments(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions, handle DeploymentHandler, pageOpts ...PaginatorOption) error {
+	return r.f.MapDeployments(ctx, owner, repo, opts, handle, pageOpts...)
+}
+func (r *RepositoriesService) MapReleases(ctx context.Context, owner string, repo string, opts *github.ListOptions, handle RepositoryReleaseHandler, pageOpts ...PaginatorOption) error {
+	return r.f.MapReleases(ctx, owner, repo, opts, handle, pageOpts...)
+}
+
+// NewRepositoriesService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewRepositoriesService(f *RepositoriesServiceF) *RepositoriesService {
+	var table RepositoriesServiceF
+	if f != nil {
+		table = *f
+	}
+	r := &RepositoriesService{f: &table}
+	if table.MapCommits == nil {
+		table.MapCommits = newMapRepositoryCommitsF(r)
+	}
+	if table.MapDeployments == nil {
+		table.MapDeployments = newMapDeploymentsF(r)
+	}
+	if table.MapReleases == nil {
+		table.MapReleases = newMapReleasesF(r)
+	}
+	return r
+}
+
+func newRepositoriesServicePassthrough(client *github.Client) *RepositoriesService {
+	return NewRepositoriesService(&RepositoriesServiceF{
+		AddAdminEnforcement:                   client.Repositories.AddAdminEnforcement,
+		AddAppRestrictions:                    client.Repositories.AddAppRestrictions,
+		AddAutolink:                           client.Repositories.AddAutolink,
+		AddCollaborator:                       client.Repositories.AddCollaborator,
+		AddTeamRestrictions:                   client.Repositories.AddTeamRestrictions,
+		AddUserRestrictions:                   client.Repositories.AddUserRestrictions,
+		CompareCommits:                        client.Repositories.CompareCommits,
+		CompareCommitsRaw:                     client.Repositories.CompareCommitsRaw,
+		Create:                                client.Repositories.Create,
+		CreateComment:                         client.Repositories.CreateComment,
+		CreateCustomDeploymentProtectionRule:  client.Repositories.CreateCustomDeploymentProtectionRule,
+		CreateDeployment:                      client.Repositories.CreateDeployment,
+		CreateDeploymentBranchPolicy:          client.Repositories.CreateDeploymentBranchPolicy,
+		CreateDeploymentStatus:                client.Repositories.CreateDeploymentStatus,
+		CreateFile:                            client.Repositories.CreateFile,
+		CreateFork:                            client.Repositories.CreateFork,
+		CreateFromTemplate:                    client.Repositories.CreateFromTemplate,
+		CreateHook:                            client.Repositories.CreateHook,
+		CreateKey:                             client.Repositories.CreateKey,
+		CreateOrUpdateCustomProperties:        client.Repositories.CreateOrUpdateCustomProperties,
+		CreateProject:                         client.Repositories.CreateProject,
+		CreateRelease:                         client.Repositories.CreateRelease,
+		CreateRuleset:                         client.Repositories.CreateRuleset,
+		CreateStatus:                          client.Repositories.CreateStatus,
+		CreateTagProtection:                   client.Repositories.CreateTagProtection,
+		CreateUpdateEnvironment:               client.Repositories.CreateUpdateEnvironment,
+		Delete:                                client.Repositories.Delete,
+		DeleteAutolink:                        client.Repositories.DeleteAutolink,
+		DeleteComment:                         client.Repositories.DeleteComment,
+		DeleteDeployment:                      client.Repositories.DeleteDeployment,
+		DeleteDeploymentBranchPolicy:          client.Repositories.DeleteDeploymentBranchPolicy,
+		DeleteEnvironment:                     client.Repositories.DeleteEnvironment,
+		DeleteFile:                            client.Repositories.DeleteFile,
+		DeleteHook:                            client.Repositories.DeleteHook,
+		DeleteInvitation:                      client.Repositories.DeleteInvitation,
+		DeleteKey:                             client.Repositories.DeleteKey,
+		DeletePreReceiveHook:                  client.Repositories.DeletePreReceiveHook,
+		DeleteRelease:                         client.Repositories.DeleteRelease,
+		DeleteReleaseAsset:                    client.Repositories.DeleteReleaseAsset,
+		DeleteRuleset:                         client.Repositories.DeleteRuleset,
+		DeleteTagProtection:                   client.Repositories.DeleteTagProtection,
+		DisableAutomatedSecurityFixes:         client.Repositories.DisableAutomatedSecurityFixes,
+		DisableCustomDeploymentProtectionRule: client.Repositories.DisableCustomDeploymentProtectionRule,
+		DisableDismissalRestrictions:          client.Repositories.DisableDismissalRestrictions,
+		DisableLFS:                            client.Repositories.DisableLFS,
+		DisablePages:                          client.Repositories.DisablePages,
+		DisablePrivateReporting:               client.Repositories.DisablePrivateReporting,
+		DisableVulnerabilityAlerts:            client.Repositories.DisableVulnerabilityAlerts,
+		Dispatch:                              client.Repositories.Dispatch,
+		DownloadContents:                      client.Repositories.DownloadContents,
+		DownloadContentsWithMeta:              client.Repositories.DownloadContentsWithMeta,
+		DownloadReleaseAsset:                  client.Repositories.DownloadReleaseAsset,
+		Edit:                                  client.Repositories.Edit,
+		EditActionsAccessLevel:                client.Repositories.EditActionsAccessLevel,
+		EditActionsAllowed:                    client.Repositories.EditActionsAllowed,
+		EditActionsPermissions:                client.Repositories.EditActionsPermissions,
+		EditDefaultWorkflowPermissions:        client.Repositories.EditDefaultWorkflowPermissions,
+		EditHook:                              client.Repositories.EditHook,
+		EditHookConfiguration:                 client.Repositories.EditHookConfiguration,
+		EditRelease:                           client.Repositories.EditRelease,
+		EditReleaseAsset:                      client.Repositories.EditReleaseAsset,
+		EnableAutomatedSecurityFixes:          client.Repositories.EnableAutomatedSecurityFixes,
+		EnableLFS:                             client.Repositories.EnableLFS,
+		EnablePages:                           client.Repositories.EnablePages,
+		EnablePrivateReporting:                client.Repositories.EnablePrivateReporting,
+		EnableVulnerabilityAlerts:             client.Repositories.EnableVulnerabilityAlerts,
+		GenerateReleaseNotes:                  client.Repositories.GenerateReleaseNotes,
+		Get:                                   client.Repositories.Get,
+		GetActionsAccessLevel:                 client.Repositories.GetActionsAccessLevel,
+		GetActionsAllowed:                     client.Repositories.GetActionsAllowed,
+		GetActionsPermissions:                 client.Repositories.GetActionsPermissions,
+		GetAdminEnforcement:                   client.Repositories.GetAdminEnforcement,
+		GetAllCustomPropertyValues:            client.Repositories.GetAllCustomPropertyValues,
+		GetAllDeploymentProtectionRules:       client.Repositories.GetAllDeploymentProtectionRules,
+		GetAllRulesets:                        client.Repositories.GetAllRulesets,
+		GetArchiveLink:                        client.Repositories.GetArchiveLink,
+		GetAutolink:                           client.Repositories.GetAutolink,
+		GetAutomatedSecurityFixes:             client.Repositories.GetAutomatedSecurityFixes,
+		GetBranch:                             client.Repositories.GetBranch,
+		GetBranchProtection:                   client.Repositories.GetBranchProtection,
+		GetByID:                               client.Repositories.GetByID,
+		GetCodeOfConduct:                      client.Repositories.GetCodeOfConduct,
+		GetCodeownersErrors:                   client.Repositories.GetCodeownersErrors,
+		GetCombinedStatus:                     client.Repositories.GetCombinedStatus,
+		GetComment:                            client.Repositories.GetComment,
+		GetCommit:                             client.Repositories.GetCommit,
+		GetCommitRaw:                          client.Repositories.GetCommitRaw,
+		GetCommitSHA1:                         client.Repositories.GetCommitSHA1,
+		GetCommunityHealthMetrics:             client.Repositories.GetCommunityHealthMetrics,
+		GetContents:                           client.Repositories.GetContents,
+		GetCustomDeploymentProtectionRule:     client.Repositories.GetCustomDeploymentProtectionRule,
+		GetDefaultWorkflowPermissions:         client.Repositories.GetDefaultWorkflowPermissions,
+		GetDeployment:                         client.Repositories.GetDeployment,
+		GetDeploymentBranchPolicy:             client.Repositories.GetDeploymentBranchPolicy,
+		GetDeploymentStatus:                   client.Repositories.GetDeploymentStatus,
+		GetEnvironment:                        client.Repositories.GetEnvironment,
+		GetHook:                               client.Repositories.GetHook,
+		GetHookConfiguration:                  client.Repositories.GetHookConfiguration,
+		GetHookDelivery:                       client.Repositories.GetHookDelivery,
+		GetKey:                                client.Repositories.GetKey,
+		GetLatestPagesBuild:                   client.Repositories.GetLatestPagesBuild,
+		GetLatestRelease:                      client.Repositories.GetLatestRelease,
+		GetPageBuild:                          client.Repositories.GetPageBuild,
+		GetPageHealthCheck:                    client.Repositories.GetPageHealthCheck,
+		GetPagesInfo:                          client.Repositories.GetPagesInfo,
+		GetPermissionLevel:                    client.Repositories.GetPermissionLevel,
+		GetPreReceiveHook:                     client.Repositories.GetPreReceiveHook,
+		GetPullRequestReviewEnforcement:       client.Repositories.GetPullRequestReviewEnforcement,
+		GetReadme:                             client.Repositories.GetReadme,
+		GetRelease:                            client.Repositories.GetRelease,
+		GetReleaseAsset:                       client.Repositories.GetReleaseAsset,
+		GetReleaseByTag:                       client.Repositories.GetReleaseByTag,
+		GetRequiredStatusChecks:               client.Repositories.GetRequiredStatusChecks,
+		GetRulesForBranch:                     client.Repositories.GetRulesForBranch,
+		GetRuleset:                            client.Repositories.GetRuleset,
+		GetSignaturesProtectedBranch:          client.Repositories.GetSignaturesProtectedBranch,
+		GetVulnerabilityAlerts:                client.Repositories.GetVulnerabilityAlerts,
+		IsCollaborator:                        client.Repositories.IsCollaborator,
+		IsPrivateReportingEnabled:             client.Repositories.IsPrivateReportingEnabled,
+		License:                               client.Repositories.License,
+		List:                                  client.Repositories.List,
+		ListAll:                               client.Repositories.ListAll,
+		ListAllTopics:                         client.Repositories.ListAllTopics,
+		ListAppRestrictions:                   client.Repositories.ListAppRestrictions,
+		ListApps:                              client.Repositories.ListApps,
+		ListAutolinks:                         client.Repositories.ListAutolinks,
+		ListBranches:                          client.Repositories.ListBranches,
+		ListBranchesHeadCommit:                client.Repositories.ListBranchesHeadCommit,
+		ListByAuthenticatedUser:               client.Repositories.ListByAuthenticatedUser,
+		ListByOrg:                             client.Repositories.ListByOrg,
+		ListByUser:                            client.Repositories.ListByUser,
+		ListCodeFrequency:                     client.Repositories.ListCodeFrequency,
+		ListCollaborators:                     client.Repositories.ListCollaborators,
+		ListComments:                          client.Repositories.ListComments,
+		ListCommitActivity:                    client.Repositories.ListCommitActivity,
+		ListCommitComments:                    client.Repositories.ListCommitComments,
+		ListCommits:                           client.Repositories.ListCommits,
+		ListContributors:                      client.Repositories.ListContributors,
+		ListContributorsStats:                 client.Repositories.ListContributorsStats,
+		ListCustomDeploymentRuleIntegrations:  client.Repositories.ListCustomDeploymentRuleIntegrations,
+		ListDeploymentBranchPolicies:          client.Repositories.ListDeploymentBranchPolicies,
+		ListDeploymentStatuses:                client.Repositories.ListDeploymentStatuses,
+		ListDeployments:                       client.Repositories.ListDeployments,
+		ListEnvironments:                      client.Repositories.ListEnvironments,
+		ListForks:                             client.Repositories.ListForks,
+		ListHookDeliveries:                    client.Repositories.ListHookDeliveries,
+		ListHooks:                             client.Repositories.ListHooks,
+		ListInvitations:                       client.Repositories.ListInvitations,
+		ListKeys:                              client.Repositories.ListKeys,
+		ListLanguages:                         client.Repositories.ListLanguages,
+		ListPagesBuilds:                       client.Repositories.ListPagesBuilds,
+		ListParticipation:                     client.Repositories.ListParticipation,
+		ListPreReceiveHooks:                   client.Repositories.ListPreReceiveHooks,
+		ListProjects:                          client.Repositories.ListProjects,
+		ListPunchCard:                         client.Repositories.ListPunchCard,
+		ListReleaseAssets:                     client.Repositories.ListReleaseAssets,
+		ListReleases:                          client.Repositories.ListReleases,
+		ListRequiredStatusChecksContexts:      client.Repositories.ListRequiredStatusChecksContexts,
+		ListStatuses:                          client.Repositories.ListStatuses,
+		ListTagProtection:                     client.Repositories.ListTagProtection,
+		ListTags:                              client.Repositories.ListTags,
+		ListTeamRestrictions:                  client.Repositories.ListTeamRestrictions,
+		ListTeams:                             client.Repositories.ListTeams,
+		ListTrafficClones:                     client.Repositories.ListTrafficClones,
+		ListTrafficPaths:                      client.Repositories.ListTrafficPaths,
+		ListTrafficReferrers:                  client.Repositories.ListTrafficReferrers,
+		ListTrafficViews:                      client.Repositories.ListTrafficViews,
+		ListUserRestrictions:                  client.Repositories.ListUserRestrictions,
+		Merge:                                 client.Repositories.Merge,
+		MergeUpstream:                         client.Repositories.MergeUpstream,
+		OptionalSignaturesOnProtectedBranch:   client.Repositories.OptionalSignaturesOnProtectedBranch,
+		PingHook:                              client.Repositories.PingHook,
+		RedeliverHookDelivery:                 client.Repositories.RedeliverHookDelivery,
+		RemoveAdminEnforcement:                client.Repositories.RemoveAdminEnforcement,
+		RemoveAppRestrictions:                 client.Repositories.RemoveAppRestrictions,
+		RemoveBranchProtection:                client.Repositories.RemoveBranchProtection,
+		RemoveCollaborator:                    client.Repositories.RemoveCollaborator,
+		RemovePullRequestReviewEnforcement:    client.Repositories.RemovePullRequestReviewEnforcement,
+		RemoveRequiredStatusChecks:            client.Repositories.RemoveRequiredStatusChecks,
+		RemoveTeamRestrictions:                client.Repositories.RemoveTeamRestrictions,
+		RemoveUserRestrictions:                client.Repositories.RemoveUserRestrictions,
+		RenameBranch:                          client.Repositories.RenameBranch,
+		ReplaceAllTopics:                      client.Repositories.ReplaceAllTopics,
+		ReplaceAppRestrictions:                client.Repositories.ReplaceAppRestrictions,
+		ReplaceTeamRestrictions:               client.Repositories.ReplaceTeamRestrictions,
+		ReplaceUserRestrictions:               client.Repositories.ReplaceUserRestrictions,
+		RequestPageBuild:                      client.Repositories.RequestPageBuild,
+		RequireSignaturesOnProtectedBranch:    client.Repositories.RequireSignaturesOnProtectedBranch,
+		Subscribe:                             client.Repositories.Subscribe,
+		TestHook:                              client.Repositories.TestHook,
+		Transfer:                              client.Repositories.Transfer,
+		Unsubscribe:                           client.Repositories.Unsubscribe,
+		UpdateBranchProtection:                client.Repositories.UpdateBranchProtection,
+		UpdateComment:                         client.Repositories.UpdateComment,
+		UpdateDeploymentBranchPolicy:          client.Repositories.UpdateDeploymentBranchPolicy,
+		UpdateFile:                            client.Repositories.UpdateFile,
+		UpdateInvitation:                      client.Repositories.UpdateInvitation,
+		UpdatePages:                           client.Repositories.UpdatePages,
+		UpdatePreReceiveHook:                  client.Repositories.UpdatePreReceiveHook,
+		UpdatePullRequestReviewEnforcement:    client.Repositories.UpdatePullRequestReviewEnforcement,
+		UpdateRequiredStatusChecks:            client.Repositories.UpdateRequiredStatusChecks,
+		UpdateRuleset:                         client.Repositories.UpdateRuleset,
+		UploadReleaseAsset:                    client.Repositories.UploadReleaseAsset,
+	})
+}
+
+type SCIMServiceF struct {
+	DeleteSCIMUserFromOrg          func(ctx context.Context, org string, scimUserID string) (*github.Response, error)
+	GetSCIMProvisioningInfoForUser func(ctx context.Context, org string, scimUserID string) (*github.SCIMUserAttributes, *github.Response, error)
+	ListSCIMProvisionedIdentities  func(ctx context.Context, org string, opts *github.ListSCIMProvisionedIdentitiesOptions) (*github.SCIMProvisionedIdentities, *github.Response, error)
+	ProvisionAndInviteSCIMUser     func(ctx context.Context, org string, opts *github.SCIMUserAttributes) (*github.Response, error)
+	UpdateAttributeForSCIMUser     func(ctx context.Context, org string, scimUserID string, opts *github.UpdateAttributeForSCIMUserOptions) (*github.Response, error)
+	UpdateProvisionedOrgMembership func(ctx context.Context, org string, scimUserID string, opts *github.SCIMUserAttributes) (*github.Response, error)
+}
+
+type SCIMService struct {
+	f *SCIMServiceF
+}
+
+func (s *SCIMService) DeleteSCIMUserFromOrg(ctx context.Context, org string, scimUserID string) (*github.Response, error) {
+	return s.f.DeleteSCIMUserFromOrg(ctx, org, scimUserID)
+}
+func (s *SCIMService) GetSCIMProvisioningInfoForUser(ctx context.Context, org string, scimUserID string) (*github.SCIMUserAttributes, *github.Response, error) {
+	return s.f.GetSCIMProvisioningInfoForUser(ctx, org, scimUserID)
+}
+func (s *SCIMService) ListSCIMProvisionedIdentities(ctx context.Context, org string, opts *github.ListSCIMProvisionedIdentitiesOptions) (*github.SCIMProvisionedIdentities, *github.Response, error) {
+	return s.f.ListSCIMProvisionedIdentities(ctx, org, opts)
+}
+func (s *SCIMService) ProvisionAndInviteSCIMUser(ctx context.Context, org string, opts *github.SCIMUserAttributes) (*github.Response, error) {
+	return s.f.ProvisionAndInviteSCIMUser(ctx, org, opts)
+}
+func (s *SCIMService) UpdateAttributeForSCIMUser(ctx context.Context, org string, scimUserID string, opts *github.UpdateAttributeForSCIMUserOptions) (*github.Response, error) {
+	return s.f.UpdateAttributeForSCIMUser(ctx, org, scimUserID, opts)
+}
+func (s *SCIMService) UpdateProvisionedOrgMembership(ctx context.Context, org string, scimUserID string, opts *github.SCIMUserAttributes) (*github.Response, error) {
+	return s.f.UpdateProvisionedOrgMembership(ctx, org, scimUserID, opts)
+}
+
+// NewSCIMService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewSCIMService(f *SCIMServiceF) *SCIMService {
+	var table SCIMServiceF
+	if f != nil {
+		table = *f
+	}
+	return &SCIMService{f: &table}
+}
+
+func newSCIMServicePassthrough(client *github.Client) *SCIMService {
+	return NewSCIMService(&SCIMServiceF{
+		DeleteSCIMUserFromOrg:          client.SCIM.DeleteSCIMUserFromOrg,
+		GetSCIMProvisioningInfoForUser: client.SCIM.GetSCIMProvisioningInfoForUser,
+		ListSCIMProvisionedIdentities:  client.SCIM.ListSCIMProvisionedIdentities,
+		ProvisionAndInviteSCIMUser:     client.SCIM.ProvisionAndInviteSCIMUser,
+		UpdateAttributeForSCIMUser:     client.SCIM.UpdateAttributeForSCIMUser,
+		UpdateProvisionedOrgMembership: client.SCIM.UpdateProvisionedOrgMembership,
+	})
+}
+
+type SearchServiceF struct {
+	Code         func(ctx context.Context, query string, opts *github.SearchOptions) (*github.CodeSearchResult, *github.Response, error)
+	Commits      func(ctx context.Context, query string, opts *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error)
+	Issues       func(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error)
+	Labels       func(ctx context.Context, repoID int64, query string, opts *github.SearchOptions) (*github.LabelsSearchResult, *github.Response, error)
+	Repositories func(ctx context.Context, query string, opts *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error)
+	Topics       func(ctx context.Context, query string, opts *github.SearchOptions) (*github.TopicsSearchResult, *github.Response, error)
+	Users        func(ctx context.Context, query string, opts *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error)
+
+	Issue            func(ctx context.Context, query string) (*github.Issue, error)                                                                               `ghx:"extension"`
+	MapAllIssues     func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) `ghx:"extension"`
+	MapIssues        func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) `ghx:"extension"`
+	UniqueCommit     func(ctx context.Context, query string) (*github.CommitResult, error)                                                                        `ghx:"extension"`
+	UniqueIssue      func(ctx context.Context, query string) (*github.Issue, error)                                                                               `ghx:"extension"`
+	UniqueRepository func(ctx context.Context, query string) (*github.Repository, error)                                                                          `ghx:"extension"`
+	UniqueUser       func(ctx context.Context, query string) (*github.User, error)                                                                                `ghx:"extension"`
+}
+
+type SearchService struct {
+	f *SearchServiceF
+}
+
+func (s *SearchService) Code(ctx context.Context, query string, opts *github.SearchOptions) (*github.CodeSearchResult, *github.Response, error) {
+	return s.f.Code(ctx, query, opts)
+}
+func (s *SearchService) Commits(ctx context.Context, query string, opts *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error) {
+	return s.f.Commits(ctx, query, opts)
+}
+func (s *SearchService) Issues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	return s.f.Issues(ctx, query, opts)
+}
+func (s *SearchService) Labels(ctx context.Context, repoID int64, query string, opts *github.SearchOptions) (*github.LabelsSearchResult, *github.Response, error) {
+	return s.f.Labels(ctx, repoID, query, opts)
+}
+func (s *SearchService) Repositories(ctx context.Context, query string, opts *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+	return s.f.Repositories(ctx, query, opts)
+}
+func (s *SearchService) Topics(ctx context.Context, query string, opts *github.SearchOptions) (*github.TopicsSearchResult, *github.Response, error) {
+	return s.f.Topics(ctx, query, opts)
+}
+func (s *SearchService) Users(ctx context.Context, query string, opts *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error) {
+	return s.f.Users(ctx, query, opts)
+}
+
+func (s *SearchService) Issue(ctx context.Context, query string) (*github.Issue, error) {
+	return s.f.Issue(ctx, query)
+}
+func (s *SearchService) MapAllIssues(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+	return s.f.MapAllIssues(ctx, query, opts, handle, pageOpts...)
+}
+func (s *SearchService) MapIssues(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+	return s.f.MapIssues(ctx, query, opts, handle, pageOpts...)
+}
+func (s *SearchService) UniqueCommit(ctx context.Context, query string) (*github.CommitResult, error) {
+	return s.f.UniqueCommit(ctx, query)
+}
+func (s *SearchService) UniqueIssue(ctx context.Context, query string) (*github.Issue, error) {
+	return s.f.UniqueIssue(ctx, query)
+}
+func (s *SearchService) UniqueRepository(ctx context.Context, query string) (*github.Repository, error) {
+	return s.f.UniqueRepository(ctx, query)
+}
+func (s *SearchService) UniqueUser(ctx context.Context, query string) (*github.User, error) {
+	return s.f.UniqueUser(ctx, query)
+}
+
+// NewSearchService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+func NewSearchService(f *SearchServiceF) *SearchService {
+	var table SearchServiceF
+	if f != nil {
+		table = *f
+	}
+	s := &SearchService{f: &table}
+	if table.Issue == nil {
+		table.Issue = newIssueF(s)
+	}
+	if table.MapAllIssues == nil {
+		table.MapAllIssues = newMapAllIssuesF(s)
+	}
+	if table.MapIssues == nil {
+		table.MapIssues = newMapIssuesF(s)
+	}
+	if table.UniqueCommit == nil {
+		table.UniqueCommit = newUniqueCommitF(s)
+	}
+	if table.UniqueIssue == nil {
+		table.UniqueIssue = newUniqueIssueF(s)
+	}
+	if table.UniqueRepository == nil {
+		table.UniqueRepository = newUniqueRepositoryF(s)
+	}
+	if table.UniqueUser == nil {
+		table.UniqueUser = newUniqueUserF(s)
+	}
+	return s
+}
+
+func newSearchServicePassthrough(client *github.Client) *SearchService {
+	return NewSearchService(&SearchServiceF{
+		Code:         client.Search.Code,
+		Commits:      client.Search.Commits,
+		Issues:       client.Search.Issues,
+		Labels:       client.Search.Labels,
+		Repositories: client.Search.Repositories,
+		Topics:       client.Search.Topics,
+		Users:        client.Search.Users,
+	})
+}
+
+type SecretScanningServiceF struct {
+	GetAlert                func(ctx context.Context, owner string, repo string, number int64) (*github.SecretScanningAlert, *github.Response, error)
+	ListAlertsForEnterprise func(ctx context.Context, enterprise string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error)
+	ListAlertsForOrg        func(ctx context.Context, org string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error)
+	ListAlertsForRepo       func(ctx context.Context, owner string, repo string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error)
+	ListLocationsForAlert   func(ctx context.Context, owner string, repo string, number int64, opts *github.ListOptions) ([]*github.SecretScanningAlertLocation, *github.Response, error)
+	UpdateAlert             func(ctx context.Context, owner string, repo string, number int64, opts *github.SecretScanningAlertUpdateOptions) (*github.SecretScanningAlert, *github.Response, error)
+}
+
+type SecretScanningService struct {
+	f *SecretScanningServiceF
+}
+
+func (s *SecretScanningService) GetAlert(ctx context.Context, owner string, repo string, number int64) (*github.SecretScanningAlert, *github.Response, error) {
+	return s.f.GetAlert(ctx, owner, repo, number)
+}
+func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error) {
+	return s.f.ListAlertsForEnterprise(ctx, enterprise, opts)
+}
+func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error) {
+	return s.f.ListAlertsForOrg(ctx, org, opts)
+}
+func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner string, repo string, opts *github.SecretScanningAlertListOptions) ([]*github.SecretScanningAlert, *github.Response, error) {
+	return s.f.ListAlertsForRepo(ctx, owner, repo, opts)
+}
+func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner string, repo string, number int64, opts *github.ListOptions) ([]*github.SecretScanningAlertLocation, *github.Response, error) {
+	return s.f.ListLocationsForAlert(ctx, owner, repo, number, opts)
+}
+func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner string, repo string, number int64, opts *github.SecretScanningAlertUpdateOptions) (*github.SecretScanningAlert, *github.Response, error) {
+	return s.f.UpdateAlert(ctx, owner, repo, number, opts)
+}
+
+// NewSecretScanningService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewSecretScanningService(f *SecretScanningServiceF) *SecretScanningService {
+	var table SecretScanningServiceF
+	if f != nil {
+		table = *f
+	}
+	return &SecretScanningService{f: &table}
+}
+
+func newSecretScanningServicePassthrough(client *github.Client) *SecretScanningService {
+	return NewSecretScanningService(&SecretScanningServiceF{
+		GetAlert:                client.SecretScanning.GetAlert,
+		ListAlertsForEnterprise: client.SecretScanning.ListAlertsForEnterprise,
+		ListAlertsForOrg:        client.SecretScanning.ListAlertsForOrg,
+		ListAlertsForRepo:       client.SecretScanning.ListAlertsForRepo,
+		ListLocationsForAlert:   client.SecretScanning.ListLocationsForAlert,
+		UpdateAlert:             client.SecretScanning.UpdateAlert,
+	})
+}
+
+type SecurityAdvisoriesServiceF struct {
+	CreateTemporaryPrivateFork             func(ctx context.Context, owner string, repo string, ghsaID string) (*github.Repository, *github.Response, error)
+	GetGlobalSecurityAdvisories            func(ctx context.Context, ghsaID string) (*github.GlobalSecurityAdvisory, *github.Response, error)
+	ListGlobalSecurityAdvisories           func(ctx context.Context, opts *github.ListGlobalSecurityAdvisoriesOptions) ([]*github.GlobalSecurityAdvisory, *github.Response, error)
+	ListRepositorySecurityAdvisories       func(ctx context.Context, owner string, repo string, opt *github.ListRepositorySecurityAdvisoriesOptions) ([]*github.SecurityAdvisory, *github.Response, error)
+	ListRepositorySecurityAdvisoriesForOrg func(ctx context.Context, org string, opt *github.ListRepositorySecurityAdvisoriesOptions) ([]*github.SecurityAdvisory, *github.Response, error)
+	RequestCVE                             func(ctx context.Context, owner string, repo string, ghsaID string) (*github.Response, error)
+}
+
+type SecurityAdvisoriesService struct {
+	f *SecurityAdvisoriesServiceF
+}
+
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner string, repo string, ghsaID string) (*github.Repository, *github.Response, error) {
+	return s.f.CreateTemporaryPrivateFork(ctx, owner, repo, ghsaID)
+}
+func (s *SecurityAdvisoriesService) GetGlobalSecurityAdvisories(ctx context.Context, ghsaID string) (*github.GlobalSecurityAdvisory, *github.Response, error) {
+	return s.f.GetGlobalSecurityAdvisories(ctx, ghsaID)
+}
+func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Context, opts *github.ListGlobalSecurityAdvisoriesOptions) ([]*github.GlobalSecurityAdvisory, *github.Response, error) {
+	return s.f.ListGlobalSecurityAdvisories(ctx, opts)
+}
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner string, repo string, opt *github.ListRepositorySecurityAdvisoriesOptions) ([]*github.SecurityAdvisory, *github.Response, error) {
+	return s.f.ListRepositorySecurityAdvisories(ctx, owner, repo, opt)
+}
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opt *github.ListRepositorySecurityAdvisoriesOptions) ([]*github.SecurityAdvisory, *github.Response, error) {
+	return s.f.ListRepositorySecurityAdvisoriesForOrg(ctx, org, opt)
+}
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner string, repo string, ghsaID string) (*github.Response, error) {
+	return s.f.RequestCVE(ctx, owner, repo, ghsaID)
+}
+
+// NewSecurityAdvisoriesService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewSecurityAdvisoriesService(f *SecurityAdvisoriesServiceF) *SecurityAdvisoriesService {
+	var table SecurityAdvisoriesServiceF
+	if f != nil {
+		table = *f
+	}
+	return &SecurityAdvisoriesService{f: &table}
+}
+
+func newSecurityAdvisoriesServicePassthrough(client *github.Client) *SecurityAdvisoriesService {
+	return NewSecurityAdvisoriesService(&SecurityAdvisoriesServiceF{
+		CreateTemporaryPrivateFork:             client.SecurityAdvisories.CreateTemporaryPrivateFork,
+		GetGlobalSecurityAdvisories:            client.SecurityAdvisories.GetGlobalSecurityAdvisories,
+		ListGlobalSecurityAdvisories:           client.SecurityAdvisories.ListGlobalSecurityAdvisories,
+		ListRepositorySecurityAdvisories:       client.SecurityAdvisories.ListRepositorySecurityAdvisories,
+		ListRepositorySecurityAdvisoriesForOrg: client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg,
+		RequestCVE:                             client.SecurityAdvisories.RequestCVE,
+	})
+}
+
+type TeamsServiceF struct {
+	AddTeamMembershipByID                   func(ctx context.Context, orgID int64, teamID int64, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error)
+	AddTeamMembershipBySlug                 func(ctx context.Context, org string, slug string, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error)
+	AddTeamProjectByID                      func(ctx context.Context, orgID int64, teamID int64, projectID int64, opts *github.TeamProjectOptions) (*github.Response, error)
+	AddTeamProjectBySlug                    func(ctx context.Context, org string, slug string, projectID int64, opts *github.TeamProjectOptions) (*github.Response, error)
+	AddTeamRepoByID                         func(ctx context.Context, orgID int64, teamID int64, owner string, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error)
+	AddTeamRepoBySlug                       func(ctx context.Context, org string, slug string, owner string, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error)
+	CreateCommentByID                       func(ctx context.Context, orgID int64, teamID int64, discsusionNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error)
+	CreateCommentBySlug                     func(ctx context.Context, org string, slug string, discsusionNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error)
+	CreateDiscussionByID                    func(ctx context.Context, orgID int64, teamID int64, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error)
+	CreateDiscussionBySlug                  func(ctx context.Context, org string, slug string, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error)
+	CreateOrUpdateIDPGroupConnectionsByID   func(ctx context.Context, orgID int64, teamID int64, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error)
+	CreateOrUpdateIDPGroupConnectionsBySlug func(ctx context.Context, org string, slug string, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error)
+	CreateTeam                              func(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error)
+	DeleteCommentByID                       func(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int) (*github.Response, error)
+	DeleteCommentBySlug                     func(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int) (*github.Response, error)
+	DeleteDiscussionByID                    func(ctx context.Context, orgID int64, teamID int64, discussionNumber int) (*github.Response, error)
+	DeleteDiscussionBySlug                  func(ctx context.Context, org string, slug string, discussionNumber int) (*github.Response, error)
+	DeleteTeamByID                          func(ctx context.Context, orgID int64, teamID int64) (*github.Response, error)
+	DeleteTeamBySlug                        func(ctx context.Context, org string, slug string) (*github.Response, error)
+	EditCommentByID                         func(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error)
+	EditCommentBySlug                       func(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error)
+	EditDiscussionByID                      func(ctx context.Context, orgID int64, teamID int64, discussionNumber int, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error)
+	EditDiscussionBySlug                    func(ctx context.Context, org string, slug string, discussionNumber int, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error)
+	EditTeamByID                            func(ctx context.Context, orgID int64, teamID int64, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error)
+	EditTeamBySlug                          func(ctx context.Context, org string, slug string, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error)
+	GetCommentByID                          func(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int) (*github.DiscussionComment, *github.Response, error)
+	GetCommentBySlug                        func(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int) (*github.DiscussionComment, *github.Response, error)
+	GetDiscussionByID                       func(ctx context.Context, orgID int64, teamID int64, discussionNumber int) (*github.TeamDiscussion, *github.Response, error)
+	GetDiscussionBySlug                     func(ctx context.Context, org string, slug string, discussionNumber int) (*github.TeamDiscussion, *github.Response, error)
+	GetExternalGroup                        func(ctx context.Context, org string, groupID int64) (*github.ExternalGroup, *github.Response, error)
+	GetTeamByID                             func(ctx context.Context, orgID int64, teamID int64) (*github.Team, *github.Response, error)
+	GetTeamBySlug                           func(ctx context.Context, org string, slug string) (*github.Team, *github.Response, error)
+	GetTeamMembershipByID                   func(ctx context.Context, orgID int64, teamID int64, user string) (*github.Membership, *github.Response, error)
+	GetTeamMembershipBySlug                 func(ctx context.Context, org string, slug string, user string) (*github.Membership, *github.Response, error)
+	IsTeamRepoByID                          func(ctx context.Context, orgID int64, teamID int64, owner string, repo string) (*github.Repository, *github.Response, error)
+	IsTeamRepoBySlug                        func(ctx context.Context, org string, slug string, owner string, repo string) (*github.Repository, *github.Response, error)
+	ListChildTeamsByParentID                func(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListChildTeamsByParentSlug              func(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListCommentsByID                        func(ctx context.Context, orgID int64, teamID int64, discussionNumber int, options *github.DiscussionCommentListOptions) ([]*github.DiscussionComment, *github.Response, error)
+	ListCommentsBySlug                      func(ctx context.Context, org string, slug string, discussionNumber int, options *github.DiscussionCommentListOptions) ([]*github.DiscussionComment, *github.Response, error)
+	ListDiscussionsByID                     func(ctx context.Context, orgID int64, teamID int64, opts *github.DiscussionListOptions) ([]*github.TeamDiscussion, *github.Response, error)
+	ListDiscussionsBySlug                   func(ctx context.Context, org string, slug string, opts *github.DiscussionListOptions) ([]*github.TeamDiscussion, *github.Response, error)
+	ListExternalGroups                      func(ctx context.Context, org string, opts *github.ListExternalGroupsOptions) (*github.ExternalGroupList, *github.Response, error)
+	ListExternalGroupsForTeamBySlug         func(ctx context.Context, org string, slug string) (*github.ExternalGroupList, *github.Response, error)
+	ListIDPGroupsForTeamByID                func(ctx context.Context, orgID int64, teamID int64) (*github.IDPGroupList, *github.Response, error)
+	ListIDPGroupsForTeamBySlug              func(ctx context.Context, org string, slug string) (*github.IDPGroupList, *github.Response, error)
+	ListIDPGroupsInOrganization             func(ctx context.Context, org string, opts *github.ListCursorOptions) (*github.IDPGroupList, *github.Response, error)
+	ListPendingTeamInvitationsByID          func(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error)
+	ListPendingTeamInvitationsBySlug        func(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error)
+	ListTeamMembersByID                     func(ctx context.Context, orgID int64, teamID int64, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error)
+	ListTeamMembersBySlug                   func(ctx context.Context, org string, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error)
+	ListTeamProjectsByID                    func(ctx context.Context, orgID int64, teamID int64) ([]*github.Project, *github.Response, error)
+	ListTeamProjectsBySlug                  func(ctx context.Context, org string, slug string) ([]*github.Project, *github.Response, error)
+	ListTeamReposByID                       func(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
+	ListTeamReposBySlug                     func(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
+	ListTeams                               func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	ListUserTeams                           func(ctx context.Context, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	RemoveConnectedExternalGroup            func(ctx context.Context, org string, slug string) (*github.Response, error)
+	RemoveTeamMembershipByID                func(ctx context.Context, orgID int64, teamID int64, user string) (*github.Response, error)
+	RemoveTeamMembershipBySlug              func(ctx context.Context, org string, slug string, user string) (*github.Response, error)
+	RemoveTeamProjectByID                   func(ctx context.Context, orgID int64, teamID int64, projectID int64) (*github.Response, error)
+	RemoveTeamProjectBySlug                 func(ctx context.Context, org string, slug string, projectID int64) (*github.Response, error)
+	RemoveTeamRepoByID                      func(ctx context.Context, orgID int64, teamID int64, owner string, repo string) (*github.Response, error)
+	RemoveTeamRepoBySlug                    func(ctx context.Context, org string, slug string, owner string, repo string) (*github.Response, error)
+	ReviewTeamProjectsByID                  func(ctx context.Context, orgID int64, teamID int64, projectID int64) (*github.Project, *github.Response, error)
+	ReviewTeamProjectsBySlug                func(ctx context.Context, org string, slug string, projectID int64) (*github.Project, *github.Response, error)
+	UpdateConnectedExternalGroup            func(ctx context.Context, org string, slug string, eg *github.ExternalGroup) (*github.ExternalGroup, *github.Response, error)
+}
+
+type TeamsService struct {
+	f *TeamsServiceF
+}
+
+func (t *TeamsService) AddTeamMembershipByID(ctx context.Context, orgID int64, teamID int64, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+	return t.f.AddTeamMembershipByID(ctx, orgID, teamID, user, opts)
+}
+func (t *TeamsService) AddTeamMembershipBySlug(ctx context.Context, org string, slug string, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+	return t.f.AddTeamMembershipBySlug(ctx, org, slug, user, opts)
+}
+func (t *TeamsService) AddTeamProjectByID(ctx context.Context, orgID int64, teamID int64, projectID int64, opts *github.TeamProjectOptions) (*github.Response, error) {
+	return t.f.AddTeamProjectByID(ctx, orgID, teamID, projectID, opts)
+}
+func (t *TeamsService) AddTeamProjectBySlug(ctx context.Context, org string, slug string, projectID int64, opts *github.TeamProjectOptions) (*github.Response, error) {
+	return t.f.AddTeamProjectBySlug(ctx, org, slug, projectID, opts)
+}
+func (t *TeamsService) AddTeamRepoByID(ctx context.Context, orgID int64, teamID int64, owner string, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error) {
+	return t.f.AddTeamRepoByID(ctx, orgID, teamID, owner, repo, opts)
+}
+func (t *TeamsService) AddTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error) {
+	return t.f.AddTeamRepoBySlug(ctx, org, slug, owner, repo, opts)
+}
+func (t *TeamsService) CreateCommentByID(ctx context.Context, orgID int64, teamID int64, discsusionNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.CreateCommentByID(ctx, orgID, teamID, discsusionNumber, comment)
+}
+func (t *TeamsService) CreateCommentBySlug(ctx context.Context, org string, slug string, discsusionNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.CreateCommentBySlug(ctx, org, slug, discsusionNumber, comment)
+}
+func (t *TeamsService) CreateDiscussionByID(ctx context.Context, orgID int64, teamID int64, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.CreateDiscussionByID(ctx, orgID, teamID, discussion)
+}
+func (t *TeamsService) CreateDiscussionBySlug(ctx context.Context, org string, slug string, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.CreateDiscussionBySlug(ctx, org, slug, discussion)
+}
+func (t *TeamsService) CreateOrUpdateIDPGroupConnectionsByID(ctx context.Context, orgID int64, teamID int64, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error) {
+	return t.f.CreateOrUpdateIDPGroupConnectionsByID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) CreateOrUpdateIDPGroupConnectionsBySlug(ctx context.Context, org string, slug string, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error) {
+	return t.f.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) CreateTeam(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error) {
+	return t.f.CreateTeam(ctx, org, team)
+}
+func (t *TeamsService) DeleteCommentByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int) (*github.Response, error) {
+	return t.f.DeleteCommentByID(ctx, orgID, teamID, discussionNumber, commentNumber)
+}
+func (t *TeamsService) DeleteCommentBySlug(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int) (*github.Response, error) {
+	return t.f.DeleteCommentBySlug(ctx, org, slug, discussionNumber, commentNumber)
+}
+func (t *TeamsService) DeleteDiscussionByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int) (*github.Response, error) {
+	return t.f.DeleteDiscussionByID(ctx, orgID, teamID, discussionNumber)
+}
+func (t *TeamsService) DeleteDiscussionBySlug(ctx context.Context, org string, slug string, discussionNumber int) (*github.Response, error) {
+	return t.f.DeleteDiscussionBySlug(ctx, org, slug, discussionNumber)
+}
+func (t *TeamsService) DeleteTeamByID(ctx context.Context, orgID int64, teamID int64) (*github.Response, error) {
+	return t.f.DeleteTeamByID(ctx, orgID, teamID)
+}
+func (t *TeamsService) DeleteTeamBySlug(ctx context.Context, org string, slug string) (*github.Response, error) {
+	return t.f.DeleteTeamBySlug(ctx, org, slug)
+}
+func (t *TeamsService) EditCommentByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.EditCommentByID(ctx, orgID, teamID, discussionNumber, commentNumber, comment)
+}
+func (t *TeamsService) EditCommentBySlug(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int, comment github.DiscussionComment) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.EditCommentBySlug(ctx, org, slug, discussionNumber, commentNumber, comment)
+}
+func (t *TeamsService) EditDiscussionByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.EditDiscussionByID(ctx, orgID, teamID, discussionNumber, discussion)
+}
+func (t *TeamsService) EditDiscussionBySlug(ctx context.Context, org string, slug string, discussionNumber int, discussion github.TeamDiscussion) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.EditDiscussionBySlug(ctx, org, slug, discussionNumber, discussion)
+}
+func (t *TeamsService) EditTeamByID(ctx context.Context, orgID int64, teamID int64, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error) {
+	return t.f.EditTeamByID(ctx, orgID, teamID, team, removeParent)
+}
+func (t *TeamsService) EditTeamBySlug(ctx context.Context, org string, slug string, team github.NewTeam, removeParent bool) (*github.Team, *github.Response, error) {
+	return t.f.EditTeamBySlug(ctx, org, slug, team, removeParent)
+}
+func (t *TeamsService) GetCommentByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int, commentNumber int) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.GetCommentByID(ctx, orgID, teamID, discussionNumber, commentNumber)
+}
+func (t *TeamsService) GetCommentBySlug(ctx context.Context, org string, slug string, discussionNumber int, commentNumber int) (*github.DiscussionComment, *github.Response, error) {
+	return t.f.GetCommentBySlug(ctx, org, slug, discussionNumber, commentNumber)
+}
+func (t *TeamsService) GetDiscussionByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.GetDiscussionByID(ctx, orgID, teamID, discussionNumber)
+}
+func (t *TeamsService) GetDiscussionBySlug(ctx context.Context, org string, slug string, discussionNumber int) (*github.TeamDiscussion, *github.Response, error) {
+	return t.f.GetDiscussionBySlug(ctx, org, slug, discussionNumber)
+}
+func (t *TeamsService) GetExternalGroup(ctx context.Context, org string, groupID int64) (*github.ExternalGroup, *github.Response, error) {
+	return t.f.GetExternalGroup(ctx, org, groupID)
+}
+func (t *TeamsService) GetTeamByID(ctx context.Context, orgID int64, teamID int64) (*github.Team, *github.Response, error) {
+	return t.f.GetTeamByID(ctx, orgID, teamID)
+}
+func (t *TeamsService) GetTeamBySlug(ctx context.Context, org string, slug string) (*github.Team, *github.Response, error) {
+	return t.f.GetTeamBySlug(ctx, org, slug)
+}
+func (t *TeamsService) GetTeamMembershipByID(ctx context.Context, orgID int64, teamID int64, user string) (*github.Membership, *github.Response, error) {
+	return t.f.GetTeamMembershipByID(ctx, orgID, teamID, user)
+}
+func (t *TeamsService) GetTeamMembershipBySlug(ctx context.Context, org string, slug string, user string) (*github.Membership, *github.Response, error) {
+	return t.f.GetTeamMembershipBySlug(ctx, org, slug, user)
+}
+func (t *TeamsService) IsTeamRepoByID(ctx context.Context, orgID int64, teamID int64, owner string, repo string) (*github.Repository, *github.Response, error) {
+	return t.f.IsTeamRepoByID(ctx, orgID, teamID, owner, repo)
+}
+func (t *TeamsService) IsTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string) (*github.Repository, *github.Response, error) {
+	return t.f.IsTeamRepoBySlug(ctx, org, slug, owner, repo)
+}
+func (t *TeamsService) ListChildTeamsByParentID(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return t.f.ListChildTeamsByParentID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) ListChildTeamsByParentSlug(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return t.f.ListChildTeamsByParentSlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) ListCommentsByID(ctx context.Context, orgID int64, teamID int64, discussionNumber int, options *github.DiscussionCommentListOptions) ([]*github.DiscussionComment, *github.Response, error) {
+	return t.f.ListCommentsByID(ctx, orgID, teamID, discussionNumber, options)
+}
+func (t *TeamsService) ListCommentsBySlug(ctx context.Context, org string, slug string, discussionNumber int, options *github.DiscussionCommentListOptions) ([]*github.DiscussionComment, *github.Response, error) {
+	return t.f.ListCommentsBySlug(ctx, org, slug, discussionNumber, options)
+}
+func (t *TeamsService) ListDiscussionsByID(ctx context.Context, orgID int64, teamID int64, opts *github.DiscussionListOptions) ([]*github.TeamDiscussion, *github.Response, error) {
+	return t.f.ListDiscussionsByID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) ListDiscussionsBySlug(ctx context.Context, org string, slug string, opts *github.DiscussionListOptions) ([]*github.TeamDiscussion, *github.Response, error) {
+	return t.f.ListDiscussionsBySlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) ListExternalGroups(ctx context.Context, org string, opts *github.ListExternalGroupsOptions) (*github.ExternalGroupList, *github.Response, error) {
+	return t.f.ListExternalGroups(ctx, org, opts)
+}
+func (t *TeamsService) ListExternalGroupsForTeamBySlug(ctx context.Context, org string, slug string) (*github.ExternalGroupList, *github.Response, error) {
+	return t.f.ListExternalGroupsForTeamBySlug(ctx, org, slug)
+}
+func (t *TeamsService) ListIDPGroupsForTeamByID(ctx context.Context, orgID int64, teamID int64) (*github.IDPGroupList, *github.Response, error) {
+	return t.f.ListIDPGroupsForTeamByID(ctx, orgID, teamID)
+}
+func (t *TeamsService) ListIDPGroupsForTeamBySlug(ctx context.Context, org string, slug string) (*github.IDPGroupList, *github.Response, error) {
+	return t.f.ListIDPGroupsForTeamBySlug(ctx, org, slug)
+}
+func (t *TeamsService) ListIDPGroupsInOrganization(ctx context.Context, org string, opts *github.ListCursorOptions) (*github.IDPGroupList, *github.Response, error) {
+	return t.f.ListIDPGroupsInOrganization(ctx, org, opts)
+}
+func (t *TeamsService) ListPendingTeamInvitationsByID(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	return t.f.ListPendingTeamInvitationsByID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) ListPendingTeamInvitationsBySlug(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+	return t.f.ListPendingTeamInvitationsBySlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) ListTeamMembersByID(ctx context.Context, orgID int64, teamID int64, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error) {
+	return t.f.ListTeamMembersByID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) ListTeamMembersBySlug(ctx context.Context, org string, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error) {
+	return t.f.ListTeamMembersBySlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) ListTeamProjectsByID(ctx context.Context, orgID int64, teamID int64) ([]*github.Project, *github.Response, error) {
+	return t.f.ListTeamProjectsByID(ctx, orgID, teamID)
+}
+func (t *TeamsService) ListTeamProjectsBySlug(ctx context.Context, org string, slug string) ([]*github.Project, *github.Response, error) {
+	return t.f.ListTeamProjectsBySlug(ctx, org, slug)
+}
+func (t *TeamsService) ListTeamReposByID(ctx context.Context, orgID int64, teamID int64, opts *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	return t.f.ListTeamReposByID(ctx, orgID, teamID, opts)
+}
+func (t *TeamsService) ListTeamReposBySlug(ctx context.Context, org string, slug string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	return t.f.ListTeamReposBySlug(ctx, org, slug, opts)
+}
+func (t *TeamsService) ListTeams(ctx context.Context, org string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return t.f.ListTeams(ctx, org, opts)
+}
+func (t *TeamsService) ListUserTeams(ctx context.Context, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return t.f.ListUserTeams(ctx, opts)
+}
+func (t *TeamsService) RemoveConnectedExternalGroup(ctx context.Context, org string, slug string) (*github.Response, error) {
+	return t.f.RemoveConnectedExternalGroup(ctx, org, slug)
+}
+func (t *TeamsService) RemoveTeamMembershipByID(ctx context.Context, orgID int64, teamID int64, user string) (*github.Response, error) {
+	return t.f.RemoveTeamMembershipByID(ctx, orgID, teamID, user)
+}
+func (t *TeamsService) RemoveTeamMembershipBySlug(ctx context.Context, org string, slug string, user string) (*github.Response, error) {
+	return t.f.RemoveTeamMembershipBySlug(ctx, org, slug, user)
+}
+func (t *TeamsService) RemoveTeamProjectByID(ctx context.Context, orgID int64, teamID int64, projectID int64) (*github.Response, error) {
+	return t.f.RemoveTeamProjectByID(ctx, orgID, teamID, projectID)
+}
+func (t *TeamsService) RemoveTeamProjectBySlug(ctx context.Context, org string, slug string, projectID int64) (*github.Response, error) {
+	return t.f.RemoveTeamProjectBySlug(ctx, org, slug, projectID)
+}
+func (t *TeamsService) RemoveTeamRepoByID(ctx context.Context, orgID int64, teamID int64, owner string, repo string) (*github.Response, error) {
+	return t.f.RemoveTeamRepoByID(ctx, orgID, teamID, owner, repo)
+}
+func (t *TeamsService) RemoveTeamRepoBySlug(ctx context.Context, org string, slug string, owner string, repo string) (*github.Response, error) {
+	return t.f.RemoveTeamRepoBySlug(ctx, org, slug, owner, repo)
+}
+func (t *TeamsService) ReviewTeamProjectsByID(ctx context.Context, orgID int64, teamID int64, projectID int64) (*github.Project, *github.Response, error) {
+	return t.f.ReviewTeamProjectsByID(ctx, orgID, teamID, projectID)
+}
+func (t *TeamsService) ReviewTeamProjectsBySlug(ctx context.Context, org string, slug string, projectID int64) (*github.Project, *github.Response, error) {
+	return t.f.ReviewTeamProjectsBySlug(ctx, org, slug, projectID)
+}
+func (t *TeamsService) UpdateConnectedExternalGroup(ctx context.Context, org string, slug string, eg *github.ExternalGroup) (*github.ExternalGroup, *github.Response, error) {
+	return t.f.UpdateConnectedExternalGroup(ctx, org, slug, eg)
+}
+
+// NewTeamsService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewTeamsService(f *TeamsServiceF) *TeamsService {
+	var table TeamsServiceF
+	if f != nil {
+		table = *f
+	}
+	return &TeamsService{f: &table}
+}
+
+func newTeamsServicePassthrough(client *github.Client) *TeamsService {
+	return NewTeamsService(&TeamsServiceF{
+		AddTeamMembershipByID:                   client.Teams.AddTeamMembershipByID,
+		AddTeamMembershipBySlug:                 client.Teams.AddTeamMembershipBySlug,
+		AddTeamProjectByID:                      client.Teams.AddTeamProjectByID,
+		AddTeamProjectBySlug:                    client.Teams.AddTeamProjectBySlug,
+		AddTeamRepoByID:                         client.Teams.AddTeamRepoByID,
+		AddTeamRepoBySlug:                       client.Teams.AddTeamRepoBySlug,
+		CreateCommentByID:                       client.Teams.CreateCommentByID,
+		CreateCommentBySlug:                     client.Teams.CreateCommentBySlug,
+		CreateDiscussionByID:                    client.Teams.CreateDiscussionByID,
+		CreateDiscussionBySlug:                  client.Teams.CreateDiscussionBySlug,
+		CreateOrUpdateIDPGroupConnectionsByID:   client.Teams.CreateOrUpdateIDPGroupConnectionsByID,
+		CreateOrUpdateIDPGroupConnectionsBySlug: client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug,
+		CreateTeam:                              client.Teams.CreateTeam,
+		DeleteCommentByID:                       client.Teams.DeleteCommentByID,
+		DeleteCommentBySlug:                     client.Teams.DeleteCommentBySlug,
+		DeleteDiscussionByID:                    client.Teams.DeleteDiscussionByID,
+		DeleteDiscussionBySlug:                  client.Teams.DeleteDiscussionBySlug,
+		DeleteTeamByID:                          client.Teams.DeleteTeamByID,
+		DeleteTeamBySlug:                        client.Teams.DeleteTeamBySlug,
+		EditCommentByID:                         client.Teams.EditCommentByID,
+		EditCommentBySlug:                       client.Teams.EditCommentBySlug,
+		EditDiscussionByID:                      client.Teams.EditDiscussionByID,
+		EditDiscussionBySlug:                    client.Teams.EditDiscussionBySlug,
+		EditTeamByID:                            client.Teams.EditTeamByID,
+		EditTeamBySlug:                          client.Teams.EditTeamBySlug,
+		GetCommentByID:                          client.Teams.GetCommentByID,
+		GetCommentBySlug:                        client.Teams.GetCommentBySlug,
+		GetDiscussionByID:                       client.Teams.GetDiscussionByID,
+		GetDiscussionBySlug:                     client.Teams.GetDiscussionBySlug,
+		GetExternalGroup:                        client.Teams.GetExternalGroup,
+		GetTeamByID:                             client.Teams.GetTeamByID,
+		GetTeamBySlug:                           client.Teams.GetTeamBySlug,
+		GetTeamMembershipByID:                   client.Teams.GetTeamMembershipByID,
+		GetTeamMembershipBySlug:                 client.Teams.GetTeamMembershipBySlug,
+		IsTeamRepoByID:                          client.Teams.IsTeamRepoByID,
+		IsTeamRepoBySlug:                        client.Teams.IsTeamRepoBySlug,
+		ListChildTeamsByParentID:                client.Teams.ListChildTeamsByParentID,
+		ListChildTeamsByParentSlug:              client.Teams.ListChildTeamsByParentSlug,
+		ListCommentsByID:                        client.Teams.ListCommentsByID,
+		ListCommentsBySlug:                      client.Teams.ListCommentsBySlug,
+		ListDiscussionsByID:                     client.Teams.ListDiscussionsByID,
+		ListDiscussionsBySlug:                   client.Teams.ListDiscussionsBySlug,
+		ListExternalGroups:                      client.Teams.ListExternalGroups,
+		ListExternalGroupsForTeamBySlug:         client.Teams.ListExternalGroupsForTeamBySlug,
+		ListIDPGroupsForTeamByID:                client.Teams.ListIDPGroupsForTeamByID,
+		ListIDPGroupsForTeamBySlug:              client.Teams.ListIDPGroupsForTeamBySlug,
+		ListIDPGroupsInOrganization:             client.Teams.ListIDPGroupsInOrganization,
+		ListPendingTeamInvitationsByID:          client.Teams.ListPendingTeamInvitationsByID,
+		ListPendingTeamInvitationsBySlug:        client.Teams.ListPendingTeamInvitationsBySlug,
+		ListTeamMembersByID:                     client.Teams.ListTeamMembersByID,
+		ListTeamMembersBySlug:                   client.Teams.ListTeamMembersBySlug,
+		ListTeamProjectsByID:                    client.Teams.ListTeamProjectsByID,
+		ListTeamProjectsBySlug:                  client.Teams.ListTeamProjectsBySlug,
+		ListTeamReposByID:                       client.Teams.ListTeamReposByID,
+		ListTeamReposBySlug:                     client.Teams.ListTeamReposBySlug,
+		ListTeams:                               client.Teams.ListTeams,
+		ListUserTeams:                           client.Teams.ListUserTeams,
+		RemoveConnectedExternalGroup:            client.Teams.RemoveConnectedExternalGroup,
+		RemoveTeamMembershipByID:                client.Teams.RemoveTeamMembershipByID,
+		RemoveTeamMembershipBySlug:              client.Teams.RemoveTeamMembershipBySlug,
+		RemoveTeamProjectByID:                   client.Teams.RemoveTeamProjectByID,
+		RemoveTeamProjectBySlug:                 client.Teams.RemoveTeamProjectBySlug,
+		RemoveTeamRepoByID:                      client.Teams.RemoveTeamRepoByID,
+		RemoveTeamRepoBySlug:                    client.Teams.RemoveTeamRepoBySlug,
+		ReviewTeamProjectsByID:                  client.Teams.ReviewTeamProjectsByID,
+		ReviewTeamProjectsBySlug:                client.Teams.ReviewTeamProjectsBySlug,
+		UpdateConnectedExternalGroup:            client.Teams.UpdateConnectedExternalGroup,
+	})
+}
+
+type UsersServiceF struct {
+	AcceptInvitation      func(ctx context.Context, invitationID int64) (*github.Response, error)
+	AddEmails             func(ctx context.Context, emails []string) ([]*github.UserEmail, *github.Response, error)
+	BlockUser             func(ctx context.Context, user string) (*github.Response, error)
+	CreateGPGKey          func(ctx context.Context, armoredPublicKey string) (*github.GPGKey, *github.Response, error)
+	CreateKey             func(ctx context.Context, key *github.Key) (*github.Key, *github.Response, error)
+	CreateProject         func(ctx context.Context, opts *github.CreateUserProjectOptions) (*github.Project, *github.Response, error)
+	CreateSSHSigningKey   func(ctx context.Context, key *github.Key) (*github.SSHSigningKey, *github.Response, error)
+	DeclineInvitation     func(ctx context.Context, invitationID int64) (*github.Response, error)
+	DeleteEmails          func(ctx context.Context, emails []string) (*github.Response, error)
+	DeleteGPGKey          func(ctx context.Context, id int64) (*github.Response, error)
+	DeleteKey             func(ctx context.Context, id int64) (*github.Response, error)
+	DeletePackage         func(ctx context.Context, user string, packageType string, packageName string) (*github.Response, error)
+	DeleteSSHSigningKey   func(ctx context.Context, id int64) (*github.Response, error)
+	DemoteSiteAdmin       func(ctx context.Context, user string) (*github.Response, error)
+	Edit                  func(ctx context.Context, user *github.User) (*github.User, *github.Response, error)
+	Follow                func(ctx context.Context, user string) (*github.Response, error)
+	Get                   func(ctx context.Context, user string) (*github.User, *github.Response, error)
+	GetByID               func(ctx context.Context, id int64) (*github.User, *github.Response, error)
+	GetGPGKey             func(ctx context.Context, id int64) (*github.GPGKey, *github.Response, error)
+	GetHovercard          func(ctx context.Context, user string, opts *github.HovercardOptions) (*github.Hovercard, *github.Response, error)
+	GetKey                func(ctx context.Context, id int64) (*github.Key, *github.Response, error)
+	GetPackage            func(ctx context.Context, user string, packageType string, packageName string) (*github.Package, *github.Response, error)
+	GetSSHSigningKey      func(ctx context.Context, id int64) (*github.SSHSigningKey, *github.Response, error)
+	IsBlocked             func(ctx context.Context, user string) (bool, *github.Response, error)
+	IsFollowing           func(ctx context.Context, user string, target string) (bool, *github.Response, error)
+	ListAll               func(ctx context.Context, opts *github.UserListOptions) ([]*github.User, *github.Response, error)
+	ListBlockedUsers      func(ctx context.Context, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	ListEmails            func(ctx context.Context, opts *github.ListOptions) ([]*github.UserEmail, *github.Response, error)
+	ListFollowers         func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	ListFollowing         func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	ListGPGKeys           func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.GPGKey, *github.Response, error)
+	ListInvitations       func(ctx context.Context, opts *github.ListOptions) ([]*github.RepositoryInvitation, *github.Response, error)
+	ListKeys              func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Key, *github.Response, error)
+	ListPackages          func(ctx context.Context, user string, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error)
+	ListProjects          func(ctx context.Context, user string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error)
+	ListSSHSigningKeys    func(ctx context.Context, user string, opts *github.ListOptions) ([]*github.SSHSigningKey, *github.Response, error)
+	PackageDeleteVersion  func(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.Response, error)
+	PackageGetAllVersions func(ctx context.Context, user string, packageType string, packageName string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error)
+	PackageGetVersion     func(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.PackageVersion, *github.Response, error)
+	PackageRestoreVersion func(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.Response, error)
+	PromoteSiteAdmin      func(ctx context.Context, user string) (*github.Response, error)
+	RestorePackage        func(ctx context.Context, user string, packageType string, packageName string) (*github.Response, error)
+	SetEmailVisibility    func(ctx context.Context, visibility string) ([]*github.UserEmail, *github.Response, error)
+	Suspend               func(ctx context.Context, user string, opts *github.UserSuspendOptions) (*github.Response, error)
+	UnblockUser           func(ctx context.Context, user string) (*github.Response, error)
+	Unfollow              func(ctx context.Context, user string) (*github.Response, error)
+	Unsuspend             func(ctx context.Context, user string) (*github.Response, error)
+}
+
+type UsersService struct {
+	f *UsersServiceF
+}
+
+func (u *UsersService) AcceptInvitation(ctx context.Context, invitationID int64) (*github.Response, error) {
+	return u.f.AcceptInvitation(ctx, invitationID)
+}
+func (u *UsersService) AddEmails(ctx context.Context, emails []string) ([]*github.UserEmail, *github.Response, error) {
+	return u.f.AddEmails(ctx, emails)
+}
+func (u *UsersService) BlockUser(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.BlockUser(ctx, user)
+}
+func (u *UsersService) CreateGPGKey(ctx context.Context, armoredPublicKey string) (*github.GPGKey, *github.Response, error) {
+	return u.f.CreateGPGKey(ctx, armoredPublicKey)
+}
+func (u *UsersService) CreateKey(ctx context.Context, key *github.Key) (*github.Key, *github.Response, error) {
+	return u.f.CreateKey(ctx, key)
+}
+func (u *UsersService) CreateProject(ctx context.Context, opts *github.CreateUserProjectOptions) (*github.Project, *github.Response, error) {
+	return u.f.CreateProject(ctx, opts)
+}
+func (u *UsersService) CreateSSHSigningKey(ctx context.Context, key *github.Key) (*github.SSHSigningKey, *github.Response, error) {
+	return u.f.CreateSSHSigningKey(ctx, key)
+}
+func (u *UsersService) DeclineInvitation(ctx context.Context, invitationID int64) (*github.Response, error) {
+	return u.f.DeclineInvitation(ctx, invitationID)
+}
+func (u *UsersService) DeleteEmails(ctx context.Context, emails []string) (*github.Response, error) {
+	return u.f.DeleteEmails(ctx, emails)
+}
+func (u *UsersService) DeleteGPGKey(ctx context.Context, id int64) (*github.Response, error) {
+	return u.f.DeleteGPGKey(ctx, id)
+}
+func (u *UsersService) DeleteKey(ctx context.Context, id int64) (*github.Response, error) {
+	return u.f.DeleteKey(ctx, id)
+}
+func (u *UsersService) DeletePackage(ctx context.Context, user string, packageType string, packageName string) (*github.Response, error) {
+	return u.f.DeletePackage(ctx, user, packageType, packageName)
+}
+func (u *UsersService) DeleteSSHSigningKey(ctx context.Context, id int64) (*github.Response, error) {
+	return u.f.DeleteSSHSigningKey(ctx, id)
+}
+func (u *UsersService) DemoteSiteAdmin(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.DemoteSiteAdmin(ctx, user)
+}
+func (u *UsersService) Edit(ctx context.Context, user *github.User) (*github.User, *github.Response, error) {
+	return u.f.Edit(ctx, user)
+}
+func (u *UsersService) Follow(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.Follow(ctx, user)
+}
+func (u *UsersService) Get(ctx context.Context, user string) (*github.User, *github.Response, error) {
+	return u.f.Get(ctx, user)
+}
+func (u *UsersService) GetByID(ctx context.Context, id int64) (*github.User, *github.Response, error) {
+	return u.f.GetByID(ctx, id)
+}
+func (u *UsersService) GetGPGKey(ctx context.Context, id int64) (*github.GPGKey, *github.Response, error) {
+	return u.f.GetGPGKey(ctx, id)
+}
+func (u *UsersService) GetHovercard(ctx context.Context, user string, opts *github.HovercardOptions) (*github.Hovercard, *github.Response, error) {
+	return u.f.GetHovercard(ctx, user, opts)
+}
+func (u *UsersService) GetKey(ctx context.Context, id int64) (*github.Key, *github.Response, error) {
+	return u.f.GetKey(ctx, id)
+}
+func (u *UsersService) GetPackage(ctx context.Context, user string, packageType string, packageName string) (*github.Package, *github.Response, error) {
+	return u.f.GetPackage(ctx, user, packageType, packageName)
+}
+func (u *UsersService) GetSSHSigningKey(ctx context.Context, id int64) (*github.SSHSigningKey, *github.Response, error) {
+	return u.f.GetSSHSigningKey(ctx, id)
+}
+func (u *UsersService) IsBlocked(ctx context.Context, user string) (bool, *github.Response, error) {
+	return u.f.IsBlocked(ctx, user)
+}
+func (u *UsersService) IsFollowing(ctx context.Context, user string, target string) (bool, *github.Response, error) {
+	return u.f.IsFollowing(ctx, user, target)
+}
+func (u *UsersService) ListAll(ctx context.Context, opts *github.UserListOptions) ([]*github.User, *github.Response, error) {
+	return u.f.ListAll(ctx, opts)
+}
+func (u *UsersService) ListBlockedUsers(ctx context.Context, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return u.f.ListBlockedUsers(ctx, opts)
+}
+func (u *UsersService) ListEmails(ctx context.Context, opts *github.ListOptions) ([]*github.UserEmail, *github.Response, error) {
+	return u.f.ListEmails(ctx, opts)
+}
+func (u *UsersService) ListFollowers(ctx context.Context, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return u.f.ListFollowers(ctx, user, opts)
+}
+func (u *UsersService) ListFollowing(ctx context.Context, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return u.f.ListFollowing(ctx, user, opts)
+}
+func (u *UsersService) ListGPGKeys(ctx context.Context, user string, opts *github.ListOptions) ([]*github.GPGKey, *github.Response, error) {
+	return u.f.ListGPGKeys(ctx, user, opts)
+}
+func (u *UsersService) ListInvitations(ctx context.Context, opts *github.ListOptions) ([]*github.RepositoryInvitation, *github.Response, error) {
+	return u.f.ListInvitations(ctx, opts)
+}
+func (u *UsersService) ListKeys(ctx context.Context, user string, opts *github.ListOptions) ([]*github.Key, *github.Response, error) {
+	return u.f.ListKeys(ctx, user, opts)
+}
+func (u *UsersService) ListPackages(ctx context.Context, user string, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error) {
+	return u.f.ListPackages(ctx, user, opts)
+}
+func (u *UsersService) ListProjects(ctx context.Context, user string, opts *github.ProjectListOptions) ([]*github.Project, *github.Response, error) {
+	return u.f.ListProjects(ctx, user, opts)
+}
+func (u *UsersService) ListSSHSigningKeys(ctx context.Context, user string, opts *github.ListOptions) ([]*github.SSHSigningKey, *github.Response, error) {
+	return u.f.ListSSHSigningKeys(ctx, user, opts)
+}
+func (u *UsersService) PackageDeleteVersion(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.Response, error) {
+	return u.f.PackageDeleteVersion(ctx, user, packageType, packageName, packageVersionID)
+}
+func (u *UsersService) PackageGetAllVersions(ctx context.Context, user string, packageType string, packageName string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error) {
+	return u.f.PackageGetAllVersions(ctx, user, packageType, packageName, opts)
+}
+func (u *UsersService) PackageGetVersion(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.PackageVersion, *github.Response, error) {
+	return u.f.PackageGetVersion(ctx, user, packageType, packageName, packageVersionID)
+}
+func (u *UsersService) PackageRestoreVersion(ctx context.Context, user string, packageType string, packageName string, packageVersionID int64) (*github.Response, error) {
+	return u.f.PackageRestoreVersion(ctx, user, packageType, packageName, packageVersionID)
+}
+func (u *UsersService) PromoteSiteAdmin(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.PromoteSiteAdmin(ctx, user)
+}
+func (u *UsersService) RestorePackage(ctx context.Context, user string, packageType string, packageName string) (*github.Response, error) {
+	return u.f.RestorePackage(ctx, user, packageType, packageName)
+}
+func (u *UsersService) SetEmailVisibility(ctx context.Context, visibility string) ([]*github.UserEmail, *github.Response, error) {
+	return u.f.SetEmailVisibility(ctx, visibility)
+}
+func (u *UsersService) Suspend(ctx context.Context, user string, opts *github.UserSuspendOptions) (*github.Response, error) {
+	return u.f.Suspend(ctx, user, opts)
+}
+func (u *UsersService) UnblockUser(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.UnblockUser(ctx, user)
+}
+func (u *UsersService) Unfollow(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.Unfollow(ctx, user)
+}
+func (u *UsersService) Unsuspend(ctx context.Context, user string) (*github.Response, error) {
+	return u.f.Unsuspend(ctx, user)
+}
+
+// NewUsersService routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+func NewUsersService(f *UsersServiceF) *UsersService {
+	var table UsersServiceF
+	if f != nil {
+		table = *f
+	}
+	return &UsersService{f: &table}
+}
+
+func newUsersServicePassthrough(client *github.Client) *UsersService {
+	return NewUsersService(&UsersServiceF{
+		AcceptInvitation:      client.Users.AcceptInvitation,
+		AddEmails:             client.Users.AddEmails,
+		BlockUser:             client.Users.BlockUser,
+		CreateGPGKey:          client.Users.CreateGPGKey,
+		CreateKey:             client.Users.CreateKey,
+		CreateProject:         client.Users.CreateProject,
+		CreateSSHSigningKey:   client.Users.CreateSSHSigningKey,
+		DeclineInvitation:     client.Users.DeclineInvitation,
+		DeleteEmails:          client.Users.DeleteEmails,
+		DeleteGPGKey:          client.Users.DeleteGPGKey,
+		DeleteKey:             client.Users.DeleteKey,
+		DeletePackage:         client.Users.DeletePackage,
+		DeleteSSHSigningKey:   client.Users.DeleteSSHSigningKey,
+		DemoteSiteAdmin:       client.Users.DemoteSiteAdmin,
+		Edit:                  client.Users.Edit,
+		Follow:                client.Users.Follow,
+		Get:                   client.Users.Get,
+		GetByID:               client.Users.GetByID,
+		GetGPGKey:             client.Users.GetGPGKey,
+		GetHovercard:          client.Users.GetHovercard,
+		GetKey:                client.Users.GetKey,
+		GetPackage:            client.Users.GetPackage,
+		GetSSHSigningKey:      client.Users.GetSSHSigningKey,
+		IsBlocked:             client.Users.IsBlocked,
+		IsFollowing:           client.Users.IsFollowing,
+		ListAll:               client.Users.ListAll,
+		ListBlockedUsers:      client.Users.ListBlockedUsers,
+		ListEmails:            client.Users.ListEmails,
+		ListFollowers:         client.Users.ListFollowers,
+		ListFollowing:         client.Users.ListFollowing,
+		ListGPGKeys:           client.Users.ListGPGKeys,
+		ListInvitations:       client.Users.ListInvitations,
+		ListKeys:              client.Users.ListKeys,
+		ListPackages:          client.Users.ListPackages,
+		ListProjects:          client.Users.ListProjects,
+		ListSSHSigningKeys:    client.Users.ListSSHSigningKeys,
+		PackageDeleteVersion:  client.Users.PackageDeleteVersion,
+		PackageGetAllVersions: client.Users.PackageGetAllVersions,
+		PackageGetVersion:     client.Users.PackageGetVersion,
+		PackageRestoreVersion: client.Users.PackageRestoreVersion,
+		PromoteSiteAdmin:      client.Users.PromoteSiteAdmin,
+		RestorePackage:        client.Users.RestorePackage,
+		SetEmailVisibility:    client.Users.SetEmailVisibility,
+		Suspend:               client.Users.Suspend,
+		UnblockUser:           client.Users.UnblockUser,
+		Unfollow:              client.Users.Unfollow,
+		Unsuspend:             client.Users.Unsuspend,
+	})
+}
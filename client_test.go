@@ -0,0 +1,183 @@
+package ghx
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(github.NewClient(nil))
+	v := reflect.ValueOf(c).Elem()
+	for idx := range v.NumField() {
+		field := v.Type().Field(idx)
+		if field.Anonymous {
+			continue
+		}
+		require.Equal(t, reflect.Pointer, field.Type.Kind(), "%s is not a service pointer", field.Name)
+		require.False(t, v.Field(idx).IsNil(), "%s service is nil", field.Name)
+		assertNoNilFuncs(t, field.Name, v.Field(idx).Elem())
+	}
+}
+
+// assertNoNilFuncs walks a service struct, descending into nested struct pointers
+// such as the function table of IssuesService, and fails on every nil func field
+func assertNoNilFuncs(t *testing.T, path string, v reflect.Value) {
+	t.Helper()
+
+	for idx := range v.NumField() {
+		field := v.Field(idx)
+		name := path + "." + v.Type().Field(idx).Name
+		switch field.Kind() {
+		case reflect.Func:
+			assert.False(t, field.IsNil(), "%s is nil", name)
+		case reflect.Pointer:
+			if field.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			if assert.False(t, field.IsNil(), "%s is nil", name) {
+				assertNoNilFuncs(t, name, field.Elem())
+			}
+		default:
+		}
+	}
+}
+
+// serviceConstructors holds the constructor of every service, e.g. NewIssuesService
+var serviceConstructors = []any{
+	NewActionsService,
+	NewActivityService,
+	NewAdminService,
+	NewAppsService,
+	NewAuthorizationsService,
+	NewBillingService,
+	NewChecksService,
+	NewCodeScanningService,
+	NewCodesOfConductService,
+	NewCodespacesService,
+	NewCopilotService,
+	NewDependabotService,
+	NewDependencyGraphService,
+	NewEmojisService,
+	NewEnterpriseService,
+	NewGistsService,
+	NewGitService,
+	NewGitignoresService,
+	NewInteractionsService,
+	NewIssueImportService,
+	NewIssuesService,
+	NewLicensesService,
+	NewMarkdownService,
+	NewMarketplaceService,
+	NewMetaService,
+	NewMigrationService,
+	NewOrganizationsService,
+	NewProjectsService,
+	NewPullRequestsService,
+	NewRateLimitService,
+	NewReactionsService,
+	NewRepositoriesService,
+	NewSCIMService,
+	NewSearchService,
+	NewSecretScanningService,
+	NewSecurityAdvisoriesService,
+	NewTeamsService,
+	NewUsersService,
+}
+
+func TestServicesForwardToFunctionTables(t *testing.T) {
+	t.Parallel()
+
+	constructors := make(map[reflect.Type]reflect.Value, len(serviceConstructors))
+	for _, constructor := range serviceConstructors {
+		v := reflect.ValueOf(constructor)
+		constructors[v.Type().Out(0)] = v
+	}
+
+	v := reflect.ValueOf(NewClient(github.NewClient(nil))).Elem()
+	for idx := range v.NumField() {
+		field := v.Type().Field(idx)
+		if field.Anonymous {
+			continue
+		}
+		t.Run(field.Name, func(t *testing.T) {
+			t.Parallel()
+
+			constructor, ok := constructors[field.Type]
+			require.True(t, ok, "%s has no constructor in serviceConstructors", field.Name)
+			table := reflect.New(constructor.Type().In(0).Elem())
+
+			var called string
+			for fieldIdx := range table.Elem().NumField() {
+				name := table.Elem().Type().Field(fieldIdx).Name
+				fn := table.Elem().Field(fieldIdx)
+				fn.Set(reflect.MakeFunc(fn.Type(), func(_ []reflect.Value) []reflect.Value {
+					called = name
+					results := make([]reflect.Value, fn.Type().NumOut())
+					for i := range results {
+						results[i] = reflect.Zero(fn.Type().Out(i))
+					}
+					return results
+				}))
+			}
+			service := constructor.Call([]reflect.Value{table})[0]
+
+			assert.Equal(t, table.Elem().NumField(), service.NumMethod(), "%s methods and function table differ", field.Name)
+			for methodIdx := range service.NumMethod() {
+				m := service.Method(methodIdx)
+				name := service.Type().Method(methodIdx).Name
+				args := make([]reflect.Value, m.Type().NumIn())
+				for i := range args {
+					args[i] = reflect.Zero(m.Type().In(i))
+				}
+				called = ""
+				if m.Type().IsVariadic() {
+					m.CallSlice(args)
+				} else {
+					m.Call(args)
+				}
+				assert.Equal(t, name, called, "%s.%s does not forward to its function table", field.Name, name)
+			}
+		})
+	}
+}
+
+func TestNewServiceCopiesTable(t *testing.T) {
+	t.Parallel()
+
+	require.NotPanics(t, func() {
+		assert.NotNil(t, NewIssuesService(nil).f.MapByRepo, "nil tables default their extensions")
+	})
+
+	f := &IssuesServiceF{}
+	s := NewIssuesService(f)
+	assert.Nil(t, f.MapByRepo, "the caller's table must not be filled in")
+	assert.NotNil(t, s.f.MapByRepo)
+	assert.NotSame(t, f, s.f)
+
+	var stubbed bool
+	f.MapByRepo = func(context.Context, string, string, *github.IssueListByRepoOptions, IssueHandler, ...PaginatorOption) error {
+		stubbed = true
+		return nil
+	}
+	require.NoError(t, NewIssuesService(f).MapByRepo(context.Background(), "owner", "repo", nil, nil))
+	assert.True(t, stubbed, "set extensions are kept")
+
+	assert.NotNil(t, NewGitService(nil).f, "services without extensions accept nil tables too")
+	var got string
+	git := &GitServiceF{GetRef: func(_ context.Context, _ string, _ string, ref string) (*github.Reference, *github.Response, error) {
+		got = ref
+		return nil, nil, nil
+	}}
+	gs := NewGitService(git)
+	git.GetRef = nil
+	_, _, err := gs.GetRef(context.Background(), "owner", "repo", "heads/main")
+	require.NoError(t, err, "later changes to the table must not reach services without extensions")
+	assert.Equal(t, "heads/main", got)
+}
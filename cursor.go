@@ -0,0 +1,95 @@
+package ghx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursor marks how far a pagination got so that it can be resumed with WithCursor, even by another process.
+// It is JSON serializable, Token and ParseCursor wrap it into an opaque string.
+type Cursor struct {
+	// Page is the next page to fetch
+	Page int `json:"page,omitempty"`
+	// LastID is the ID of the last handled item of Page, items up to and including it are skipped on resume
+	LastID int64 `json:"last_id,omitempty"`
+	// Offset is the number of handled items of Page, skipped on resume if LastID is not set,
+	// i.e. for items without a GetID() int64 method such as *github.RepositoryCommit or *github.Notification
+	Offset int `json:"offset,omitempty"`
+	// Done is set once every page was handled
+	Done bool `json:"done,omitempty"`
+	// Query is the search query of search helpers
+	Query string `json:"query,omitempty"`
+	// Options are the list options the pagination was started with
+	Options json.RawMessage `json:"options,omitempty"`
+}
+
+// DecodeOptions unmarshals the options the pagination was started with into v, e.g. a *github.IssueListByRepoOptions
+func (c Cursor) DecodeOptions(v any) error {
+	if len(c.Options) == 0 {
+		return nil
+	}
+	return json.Unmarshal(c.Options, v)
+}
+
+// Token encodes the cursor into an opaque URL safe string
+func (c Cursor) Token() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encoding cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ParseCursor decodes a token created by Cursor.Token
+func ParseCursor(token string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("decoding cursor: %w", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("decoding cursor: %w", err)
+	}
+	return c, nil
+}
+
+// WithCursor resumes a pagination from a cursor reported by WithCheckpoint
+func WithCursor(cursor Cursor) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.resume = &cursor
+	}
+}
+
+// WithCheckpoint calls checkpoint with a cursor after every handled page and where pagination stopped on error.
+// A checkpoint error stops the pagination.
+func WithCheckpoint(checkpoint func(Cursor) error) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.checkpoint = checkpoint
+	}
+}
+
+// withCursorScope stamps the query and list options of a Map helper onto its cursors
+func withCursorScope(query string, opts any) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.query = query
+		c.opts = opts
+	}
+}
+
+// cursorScope prepends the scope of a Map helper to the options its caller passed
+func cursorScope(query string, opts any, pageOpts []PaginatorOption) []PaginatorOption {
+	return append([]PaginatorOption{withCursorScope(query, opts)}, pageOpts...)
+}
+
+type idGetter interface {
+	GetID() int64
+}
+
+// itemID returns the ID of go-github types such as *github.Issue, 0 if they have none
+func itemID(item any) int64 {
+	if i, ok := item.(idGetter); ok {
+		return i.GetID()
+	}
+	return 0
+}
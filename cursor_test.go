@@ -0,0 +1,221 @@
+package ghx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorToken(t *testing.T) {
+	t.Parallel()
+
+	expect := Cursor{Page: 3, LastID: 42, Offset: 7, Query: "is:open", Options: []byte(`{"state":"open"}`)}
+	token, err := expect.Token()
+	require.NoError(t, err)
+	actual, err := ParseCursor(token)
+	require.NoError(t, err)
+	assert.Equal(t, expect, actual)
+
+	var opts github.IssueListByRepoOptions
+	require.NoError(t, actual.DecodeOptions(&opts))
+	assert.Equal(t, "open", opts.State)
+
+	_, err = ParseCursor("not a token!")
+	require.Error(t, err)
+}
+
+func TestPaginatorCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	issues := []*github.Issue{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}, {ID: PTR[int64](3)}, {ID: PTR[int64](4)}, {ID: PTR[int64](5)}}
+	testErr := errors.New("testErr")
+
+	// newMap mocks MapByRepo over 3 pages, failing the listed pages once
+	newMap := func(t *testing.T, failPages ...int) func(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions, handle IssueHandler, pageOpts ...PaginatorOption) error {
+		t.Helper()
+
+		pages := servePages(t, issues[:2], issues[2:4], issues[4:])
+		failed := map[int]bool{}
+		return newMapByRepoF(NewIssuesService(&IssuesServiceF{
+			ListByRepo: func(_ context.Context, _ string, _ string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+				for _, page := range failPages {
+					if page == opts.Page && !failed[page] {
+						failed[page] = true
+						return nil, nil, testErr
+					}
+				}
+				return pages(opts.Page)
+			},
+		}))
+	}
+
+	t.Run("checkpoints every page", func(t *testing.T) {
+		t.Parallel()
+
+		var cursors []Cursor
+		opts := &github.IssueListByRepoOptions{State: "open"}
+		require.NoError(t, newMap(t)(testCtx, "owner", "repo", opts, func(*github.Issue) error { return nil }, WithCheckpoint(func(c Cursor) error {
+			cursors = append(cursors, c)
+			return nil
+		})))
+		require.Len(t, cursors, 3)
+		assert.Equal(t, 2, cursors[0].Page)
+		assert.Equal(t, 3, cursors[1].Page)
+		assert.True(t, cursors[2].Done)
+		var decoded github.IssueListByRepoOptions
+		require.NoError(t, cursors[0].DecodeOptions(&decoded))
+		assert.Equal(t, *opts, decoded)
+	})
+
+	t.Run("resumes after a failed page", func(t *testing.T) {
+		t.Parallel()
+
+		mapByRepo := newMap(t, 2)
+		var (
+			cursor Cursor
+			actual []*github.Issue
+		)
+		checkpoint := WithCheckpoint(func(c Cursor) error {
+			cursor = c
+			return nil
+		})
+		err := mapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, collect(&actual), checkpoint)
+		require.ErrorIs(t, err, testErr)
+		assert.Equal(t, Cursor{Page: 2, Options: cursor.Options}, cursor)
+
+		require.NoError(t, mapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, collect(&actual), checkpoint, WithCursor(cursor)))
+		assert.Equal(t, issues, actual)
+	})
+
+	t.Run("resumes mid page after a failed handler", func(t *testing.T) {
+		t.Parallel()
+
+		mapByRepo := newMap(t)
+		var (
+			cursor Cursor
+			actual []*github.Issue
+		)
+		checkpoint := WithCheckpoint(func(c Cursor) error {
+			cursor = c
+			return nil
+		})
+		err := mapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, func(issue *github.Issue) error {
+			if issue.GetID() == 4 {
+				return testErr
+			}
+			actual = append(actual, issue)
+			return nil
+		}, checkpoint)
+		require.ErrorIs(t, err, testErr)
+		assert.Equal(t, 2, cursor.Page)
+		assert.Equal(t, int64(3), cursor.LastID)
+
+		token, err := cursor.Token()
+		require.NoError(t, err)
+		resumed, err := ParseCursor(token)
+		require.NoError(t, err)
+		require.NoError(t, mapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, collect(&actual), WithCursor(resumed)))
+		assert.Equal(t, issues, actual)
+	})
+
+	t.Run("resumes mid page of items without IDs", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []*github.RepositoryCommit{{SHA: PTR("a")}, {SHA: PTR("b")}, {SHA: PTR("c")}, {SHA: PTR("d")}}
+		pages := servePages(t, commits[:2], commits[2:])
+		mapCommits := newMapRepositoryCommitsF(NewRepositoriesService(&RepositoriesServiceF{
+			ListCommits: func(_ context.Context, _ string, _ string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+				return pages(opts.Page)
+			},
+		}))
+		var (
+			cursor Cursor
+			actual []*github.RepositoryCommit
+		)
+		err := mapCommits(testCtx, "owner", "repo", &github.CommitsListOptions{}, func(commit *github.RepositoryCommit) error {
+			if commit.GetSHA() == "d" {
+				return testErr
+			}
+			actual = append(actual, commit)
+			return nil
+		}, WithCheckpoint(func(c Cursor) error {
+			cursor = c
+			return nil
+		}))
+		require.ErrorIs(t, err, testErr)
+		assert.Equal(t, Cursor{Page: 2, Offset: 1, Options: cursor.Options}, cursor)
+
+		require.NoError(t, mapCommits(testCtx, "owner", "repo", &github.CommitsListOptions{}, collect(&actual), WithCursor(cursor)))
+		assert.Equal(t, commits, actual)
+	})
+
+	t.Run("done cursor yields nothing", func(t *testing.T) {
+		t.Parallel()
+
+		var actual []*github.Issue
+		require.NoError(t, newMap(t)(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, collect(&actual), WithCursor(Cursor{Done: true})))
+		assert.Empty(t, actual)
+	})
+
+	t.Run("checkpoint error stops pagination", func(t *testing.T) {
+		t.Parallel()
+
+		var actual []*github.Issue
+		err := newMap(t)(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, collect(&actual), WithCheckpoint(func(Cursor) error {
+			return testErr
+		}))
+		require.ErrorIs(t, err, testErr)
+		assert.Equal(t, issues[:2], actual)
+	})
+
+	t.Run("checkpoint error after a failed handler is returned", func(t *testing.T) {
+		t.Parallel()
+
+		checkpointErr := errors.New("checkpointErr")
+		err := newMap(t)(testCtx, "owner", "repo", &github.IssueListByRepoOptions{}, func(*github.Issue) error {
+			return testErr
+		}, WithCheckpoint(func(Cursor) error {
+			return checkpointErr
+		}))
+		require.ErrorIs(t, err, testErr)
+		require.ErrorIs(t, err, checkpointErr)
+	})
+
+	t.Run("breaking out of Iter with a failing checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		list := func(_ context.Context, page int) ([]*github.Issue, *github.Response, error) {
+			return servePages(t, issues[:2], issues[2:])(page)
+		}
+		var checkpoints int
+		p := Paginate(0, list, WithCheckpoint(func(Cursor) error {
+			checkpoints++
+			return testErr
+		}))
+		assert.NotPanics(t, func() {
+			for _, err := range p.Iter(testCtx) {
+				require.NoError(t, err)
+				break
+			}
+		}, "yield must not be called once it returned false")
+		assert.Equal(t, 1, checkpoints)
+	})
+
+	t.Run("prefetched pages are checkpointed in order", func(t *testing.T) {
+		t.Parallel()
+
+		list, _ := newPrefetchListFunc(t, 4, 2, nil)
+		var pages []int
+		_, err := Paginate(0, list, WithPrefetch(2), WithCheckpoint(func(c Cursor) error {
+			pages = append(pages, c.Page)
+			return nil
+		})).All(testCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 3, 4, 0}, pages)
+	})
+}
@@ -0,0 +1,27 @@
+package ghx
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotFound  = errors.New("not found")
+	ErrAmbiguous = errors.New("ambiguous")
+)
+
+// SearchResultError carries the query and total count of a lookup that failed with ErrNotFound or ErrAmbiguous,
+// match it with errors.Is(err, ghx.ErrNotFound) or inspect it with errors.As
+type SearchResultError struct {
+	Query string
+	Total int
+	Err   error
+}
+
+func (e *SearchResultError) Error() string {
+	return fmt.Sprintf("%s: %d results for query %q", e.Err, e.Total, e.Query)
+}
+
+func (e *SearchResultError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,293 @@
+package ghxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// RecordEnv switches recorders created without WithMode to ModeRecord when set to a non empty value,
+// e.g. GHXTEST_RECORD=1 go test ./...
+const RecordEnv = "GHXTEST_RECORD"
+
+const redacted = "REDACTED"
+
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+type RecorderMode int
+
+const (
+	// ModeReplay serves recorded responses without network access, failing on requests that were not recorded
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests through the base transport and overwrites the cassette on test cleanup
+	ModeRecord
+)
+
+// tokenPattern matches GitHub personal access, OAuth, user to server, server to server and refresh tokens
+var tokenPattern = regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)
+
+// Cassette is the file format of recorded interactions
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording interactions with GitHub into a cassette file and replaying them.
+// Requests are matched on their method, path, query parameters regardless of their order and body,
+// identical requests, e.g. the same page fetched twice, are replayed in recorded order.
+//
+//	rec := ghxtest.NewRecorder(t, "testdata/list_issues.json")
+//	client := ghx.NewClient(github.NewClient(&http.Client{Transport: rec}).WithAuthToken(os.Getenv("GITHUB_TOKEN")))
+type Recorder struct {
+	fail      func(args ...any)
+	path      string
+	mode      RecorderMode
+	base      http.RoundTripper
+	headers   []string
+	redactors []func(string) string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+type RecorderOption func(*Recorder)
+
+// WithMode overrides the mode selected through RecordEnv
+func WithMode(mode RecorderMode) RecorderOption {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithBaseTransport sets the transport requests are sent through while recording, defaults to http.DefaultTransport
+func WithBaseTransport(base http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.base = base
+	}
+}
+
+// WithRedactedHeaders redacts the values of headers in addition to Authorization, Cookie and Set-Cookie
+func WithRedactedHeaders(headers ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.headers = append(r.headers, headers...)
+	}
+}
+
+// WithRedactor rewrites URLs, header values and bodies before they are recorded, in addition to redacting GitHub tokens.
+// Requests are matched after redaction, so replaying needs the same redactors as recording.
+func WithRedactor(redact func(string) string) RecorderOption {
+	return func(r *Recorder) {
+		r.redactors = append(r.redactors, redact)
+	}
+}
+
+// NewRecorder returns a recorder for the cassette at path, replaying it unless RecordEnv is set.
+// Recording writes the cassette on test cleanup, replaying fails the test if the cassette cannot be read.
+func NewRecorder(t *testing.T, path string, options ...RecorderOption) *Recorder {
+	t.Helper()
+
+	r := &Recorder{
+		fail:    t.Error,
+		path:    path,
+		base:    http.DefaultTransport,
+		headers: []string{"Authorization", "Cookie", "Set-Cookie"},
+		redactors: []func(string) string{
+			func(s string) string { return tokenPattern.ReplaceAllString(s, redacted) },
+		},
+	}
+	if os.Getenv(RecordEnv) != "" {
+		r.mode = ModeRecord
+	}
+	for _, option := range options {
+		option(r)
+	}
+
+	switch r.mode {
+	case ModeRecord:
+		t.Cleanup(func() {
+			if err := r.save(); err != nil {
+				t.Errorf("ghxtest: saving cassette: %v", err)
+			}
+		})
+	default:
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ghxtest: reading cassette, record it by setting %s: %v", RecordEnv, err)
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			t.Fatalf("ghxtest: decoding cassette %s: %v", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactHeader(resp.Header),
+			Body:       r.redact(string(body)),
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for idx, interaction := range r.cassette.Interactions {
+		if r.used[idx] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		r.used[idx] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	err := fmt.Errorf("ghxtest: %w for %s %s in %s", ErrNoInteraction, recorded.Method, recorded.URL, r.path)
+	r.fail(err)
+	return nil, err
+}
+
+// recordRequest captures req redacted, leaving req readable
+func (r *Recorder) recordRequest(req *http.Request) (CassetteRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return CassetteRequest{}, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return CassetteRequest{
+		Method: req.Method,
+		URL:    r.redact(req.URL.String()),
+		Header: r.redactHeader(req.Header),
+		Body:   r.redact(string(body)),
+	}, nil
+}
+
+// matches reports whether c was recorded for req, ignoring headers and the order of query parameters
+func (c CassetteRequest) matches(req CassetteRequest) bool {
+	if c.Method != req.Method || c.Body != req.Body {
+		return false
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return false
+	}
+	other, err := url.Parse(req.URL)
+	if err != nil || u.Path != other.Path {
+		return false
+	}
+	return canonicalQuery(u.Query()) == canonicalQuery(other.Query())
+}
+
+// canonicalQuery encodes query sorted by key and value
+func canonicalQuery(query url.Values) string {
+	sorted := make(url.Values, len(query))
+	for key, values := range query {
+		sorted[key] = slices.Sorted(slices.Values(values))
+	}
+	return sorted.Encode()
+}
+
+func (r *Recorder) redact(s string) string {
+	for _, redact := range r.redactors {
+		s = redact(s)
+	}
+	return s
+}
+
+func (r *Recorder) redactHeader(header http.Header) http.Header {
+	redactedHeader := make(http.Header, len(header))
+	for key, values := range header {
+		redactedValues := make([]string, len(values))
+		for idx, value := range values {
+			if slices.ContainsFunc(r.headers, func(h string) bool { return strings.EqualFold(h, key) }) {
+				value = redacted
+			}
+			redactedValues[idx] = r.redact(value)
+		}
+		redactedHeader[key] = redactedValues
+	}
+	return redactedHeader
+}
+
+func (r *Recorder) save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(b, '\n'), 0o644)
+}
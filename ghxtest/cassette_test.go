@@ -0,0 +1,140 @@
+package ghxtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bevicted/ghx"
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordedClient(t *testing.T, rec *Recorder, baseURL string) *ghx.Client {
+	t.Helper()
+
+	c := github.NewClient(&http.Client{Transport: rec}).WithAuthToken("ghp_" + strings.Repeat("a", 36))
+	u, err := url.Parse(baseURL + "/")
+	require.NoError(t, err)
+	c.BaseURL = u
+	return ghx.NewClient(c)
+}
+
+// newIssuesServer serves two pages of issues and the creation of issue 3, counting the requests it received
+func newIssuesServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var body any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/issues":
+			link := func(page string, rel string) {
+				u := *r.URL
+				query := u.Query()
+				query.Set("page", page)
+				u.RawQuery = query.Encode()
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel=%q`, r.Host, u.RequestURI(), rel))
+			}
+			if r.URL.Query().Get("page") == "2" {
+				link("1", "prev")
+				body = NewEmptyIssues(t, 1)
+				break
+			}
+			link("2", "next")
+			body = NewEmptyIssues(t, 2)
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/owner/repo/issues":
+			w.WriteHeader(http.StatusCreated)
+			body = &github.Issue{Number: ghx.PTR(3)}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "testdata", "issues.json")
+	srv, requests := newIssuesServer(t)
+
+	redactSecret := WithRedactor(func(s string) string {
+		return strings.ReplaceAll(s, "secret", "REDACTED")
+	})
+	collectIssues := func(client *ghx.Client) []*github.Issue {
+		var issues []*github.Issue
+		require.NoError(t, client.Issues.MapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{Labels: []string{"bug"}, State: "all"}, func(issue *github.Issue) error {
+			issues = append(issues, issue)
+			return nil
+		}))
+		return issues
+	}
+
+	t.Run("record", func(t *testing.T) {
+		rec := NewRecorder(t, cassette, WithMode(ModeRecord), redactSecret)
+		client := newRecordedClient(t, rec, srv.URL)
+		assert.Len(t, collectIssues(client), 3)
+		_, _, err := client.Issues.Create(testCtx, "owner", "repo", &github.IssueRequest{Title: ghx.PTR("secret")})
+		require.NoError(t, err)
+	})
+
+	b, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "ghp_", "tokens are redacted")
+	assert.NotContains(t, string(b), "secret")
+	assert.Contains(t, string(b), `"Authorization": [
+            "REDACTED"
+          ]`, "the authorization header is redacted")
+	requestsBefore := requests.Load()
+
+	t.Run("replay", func(t *testing.T) {
+		rec := NewRecorder(t, cassette, redactSecret)
+		client := newRecordedClient(t, rec, "https://api.github.com")
+		assert.Len(t, collectIssues(client), 3)
+		issue, _, err := client.Issues.Create(testCtx, "owner", "repo", &github.IssueRequest{Title: ghx.PTR("secret")})
+		require.NoError(t, err)
+		assert.Equal(t, 3, issue.GetNumber())
+	})
+	assert.Equal(t, requestsBefore, requests.Load(), "replaying must not send requests")
+
+	t.Run("query parameters match in any order", func(t *testing.T) {
+		rec := NewRecorder(t, cassette)
+		req, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/owner/repo/issues?state=all&page=2&labels=bug", nil)
+		require.NoError(t, err)
+		resp, err := rec.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("Link"))
+	})
+
+	t.Run("unrecorded requests fail", func(t *testing.T) {
+		rec := NewRecorder(t, cassette)
+		var failures []any
+		rec.fail = func(args ...any) { failures = append(failures, args...) }
+		client := newRecordedClient(t, rec, "https://api.github.com")
+		_, _, err := client.Issues.Get(testCtx, "owner", "repo", 1)
+		require.ErrorIs(t, err, ErrNoInteraction)
+		assert.Len(t, failures, 1)
+
+		// each interaction is replayed once
+		collectIssues(client)
+		_, _, err = client.Issues.ListByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{Labels: []string{"bug"}, State: "all"})
+		require.ErrorIs(t, err, ErrNoInteraction)
+	})
+}
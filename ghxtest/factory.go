@@ -0,0 +1,314 @@
+package ghxtest
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/bevicted/ghx/state"
+	"github.com/google/go-github/v62/github"
+)
+
+// Sequence customizes the nth value built by a Factory, counting from 1
+type Sequence[T any] func(v *T, n int)
+
+// Factory builds deep copies of Base with its sequences applied, e.g. auto-incrementing IDs and numbers.
+// Copies are made through reflection rather than JSON, Base and built values never share memory.
+type Factory[T any] struct {
+	Base T
+
+	mu        sync.Mutex
+	n         int
+	sequences []Sequence[T]
+}
+
+func NewFactory[T any](base T, sequences ...Sequence[T]) *Factory[T] {
+	return &Factory[T]{Base: base, sequences: sequences}
+}
+
+// With adds sequences applied after the existing ones
+func (f *Factory[T]) With(sequences ...Sequence[T]) *Factory[T] {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sequences = append(f.sequences, sequences...)
+	return f
+}
+
+func (f *Factory[T]) New() *T {
+	return f.NewWith(nil)
+}
+
+// NewWith builds a value and applies overwrite to it after the sequences
+func (f *Factory[T]) NewWith(overwrite func(*T)) *T {
+	f.mu.Lock()
+	f.n++
+	n := f.n
+	sequences := f.sequences
+	v := DeepCopy(f.Base)
+	f.mu.Unlock()
+
+	for _, sequence := range sequences {
+		sequence(&v, n)
+	}
+	if overwrite != nil {
+		overwrite(&v)
+	}
+	return &v
+}
+
+func (f *Factory[T]) NewN(n int) []*T {
+	values := make([]*T, n)
+	for i := range values {
+		values[i] = f.New()
+	}
+	return values
+}
+
+// Link sets a field of every built value to a new value of other, e.g. the repo of a pull request.
+// A nil other leaves the field untouched.
+func Link[T any, L any](other *Factory[L], set func(v *T, linked *L)) Sequence[T] {
+	return func(v *T, _ int) {
+		if other != nil {
+			set(v, other.New())
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// idSequence sets IDs from 1
+func idSequence[T any](id func(v *T) **int64) Sequence[T] {
+	return func(v *T, n int) {
+		*id(v) = ptr(int64(n))
+	}
+}
+
+func numberSequence[T any](number func(v *T) **int) Sequence[T] {
+	return func(v *T, n int) {
+		*number(v) = ptr(n)
+	}
+}
+
+// formatSequence sets a string from format and the sequence number, e.g. user-%d
+func formatSequence[T any](field func(v *T) **string, format string) Sequence[T] {
+	return func(v *T, n int) {
+		*field(v) = ptr(fmt.Sprintf(format, n))
+	}
+}
+
+func NewUserFactory() *Factory[github.User] {
+	return NewFactory(github.User{Type: ptr("User")},
+		idSequence(func(u *github.User) **int64 { return &u.ID }),
+		formatSequence(func(u *github.User) **string { return &u.Login }, "user-%d"),
+	)
+}
+
+func NewLabelFactory() *Factory[github.Label] {
+	return NewFactory(github.Label{Color: ptr("ededed")},
+		idSequence(func(l *github.Label) **int64 { return &l.ID }),
+		formatSequence(func(l *github.Label) **string { return &l.Name }, "label-%d"),
+	)
+}
+
+func NewMilestoneFactory() *Factory[github.Milestone] {
+	return NewFactory(github.Milestone{State: state.Open.StringP()},
+		idSequence(func(m *github.Milestone) **int64 { return &m.ID }),
+		numberSequence(func(m *github.Milestone) **int { return &m.Number }),
+		formatSequence(func(m *github.Milestone) **string { return &m.Title }, "milestone-%d"),
+	)
+}
+
+// NewRepositoryFactory builds repos owned by new users of owners, if not nil
+func NewRepositoryFactory(owners *Factory[github.User]) *Factory[github.Repository] {
+	return NewFactory(github.Repository{DefaultBranch: ptr("main")},
+		idSequence(func(r *github.Repository) **int64 { return &r.ID }),
+		formatSequence(func(r *github.Repository) **string { return &r.Name }, "repo-%d"),
+		Link(owners, func(r *github.Repository, owner *github.User) { r.Owner = owner }),
+		func(r *github.Repository, _ int) {
+			if r.Owner != nil {
+				r.FullName = ptr(r.Owner.GetLogin() + "/" + r.GetName())
+			}
+		},
+	)
+}
+
+// NewPullRequestFactory builds open pull requests into new repos of repos authored by new users of users, if not nil
+func NewPullRequestFactory(repos *Factory[github.Repository], users *Factory[github.User]) *Factory[github.PullRequest] {
+	return NewFactory(github.PullRequest{State: state.Open.StringP()},
+		idSequence(func(pr *github.PullRequest) **int64 { return &pr.ID }),
+		numberSequence(func(pr *github.PullRequest) **int { return &pr.Number }),
+		formatSequence(func(pr *github.PullRequest) **string { return &pr.Title }, "pull request %d"),
+		Link(users, func(pr *github.PullRequest, user *github.User) { pr.User = user }),
+		Link(repos, func(pr *github.PullRequest, repo *github.Repository) {
+			pr.Base = &github.PullRequestBranch{Ref: repo.DefaultBranch, Repo: repo}
+		}),
+		func(pr *github.PullRequest, n int) {
+			if pr.Base == nil || pr.Head != nil {
+				return
+			}
+			pr.Head = &github.PullRequestBranch{Ref: ptr(fmt.Sprintf("branch-%d", n)), Repo: DeepCopy(pr.Base.Repo)}
+		},
+	)
+}
+
+// NewIssueCommentFactory builds comments authored by new users of users, if not nil
+func NewIssueCommentFactory(users *Factory[github.User]) *Factory[github.IssueComment] {
+	return NewFactory(github.IssueComment{},
+		idSequence(func(c *github.IssueComment) **int64 { return &c.ID }),
+		formatSequence(func(c *github.IssueComment) **string { return &c.Body }, "comment %d"),
+		Link(users, func(c *github.IssueComment, user *github.User) { c.User = user }),
+	)
+}
+
+// NewPullRequestReviewFactory builds approving reviews by new users of users, if not nil
+func NewPullRequestReviewFactory(users *Factory[github.User]) *Factory[github.PullRequestReview] {
+	return NewFactory(github.PullRequestReview{State: ptr("APPROVED")},
+		idSequence(func(r *github.PullRequestReview) **int64 { return &r.ID }),
+		Link(users, func(r *github.PullRequestReview, user *github.User) { r.User = user }),
+	)
+}
+
+// NewWorkflowRunFactory builds successfully completed runs in new repos of repos, if not nil
+func NewWorkflowRunFactory(repos *Factory[github.Repository]) *Factory[github.WorkflowRun] {
+	return NewFactory(github.WorkflowRun{Status: ptr("completed"), Conclusion: ptr("success")},
+		idSequence(func(r *github.WorkflowRun) **int64 { return &r.ID }),
+		numberSequence(func(r *github.WorkflowRun) **int { return &r.RunNumber }),
+		Link(repos, func(r *github.WorkflowRun, repo *github.Repository) { r.Repository = repo }),
+	)
+}
+
+func NewCheckRunFactory() *Factory[github.CheckRun] {
+	return NewFactory(github.CheckRun{Status: ptr("completed"), Conclusion: ptr("success")},
+		idSequence(func(r *github.CheckRun) **int64 { return &r.ID }),
+		formatSequence(func(r *github.CheckRun) **string { return &r.Name }, "check-%d"),
+	)
+}
+
+// DeepCopy copies v through reflection, following pointers, slices, arrays, maps and interfaces.
+// Unexported fields are copied shallowly, which leaves values such as time.Time intact.
+// Pointers, slices and maps shared within v, including cycles, are shared the same way within the copy.
+func DeepCopy[T any](v T) T {
+	c := reflect.New(reflect.TypeOf(&v).Elem())
+	c.Elem().Set(deepCopyValue(reflect.ValueOf(&v).Elem(), map[copied]reflect.Value{}))
+	return *c.Interface().(*T)
+}
+
+// copied identifies a pointer, slice or map already copied by deepCopyValue
+type copied struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+func deepCopyValue(v reflect.Value, visited map[copied]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		key := copied{typ: v.Type(), ptr: v.Pointer()}
+		if c, ok := visited[key]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		visited[key] = c
+		c.Elem().Set(deepCopyValue(v.Elem(), visited))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		key := copied{typ: v.Type(), ptr: v.Pointer(), len: v.Len()}
+		if c, ok := visited[key]; ok {
+			return c
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		visited[key] = c
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopyValue(v.Index(i), visited))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopyValue(v.Index(i), visited))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key := copied{typ: v.Type(), ptr: v.Pointer()}
+		if c, ok := visited[key]; ok {
+			return c
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		visited[key] = c
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value(), visited))
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem(), visited))
+		return c
+	case reflect.Struct:
+		fields := deepFields(v.Type())
+		if len(fields) == 0 {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for _, i := range fields {
+			if field := v.Field(i); sharesMemory(field) {
+				c.Field(i).Set(deepCopyValue(field, visited))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// deepFieldsCache maps struct types to the result of deepFields
+var deepFieldsCache sync.Map
+
+// deepFields returns the indexes of the exported fields of struct type typ that may share memory,
+// i.e. the fields worth deep copying
+func deepFields(typ reflect.Type) []int {
+	if fields, ok := deepFieldsCache.Load(typ); ok {
+		return fields.([]int)
+	}
+	var fields []int
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Array:
+			fields = append(fields, i)
+		case reflect.Struct:
+			if len(deepFields(field.Type)) > 0 {
+				fields = append(fields, i)
+			}
+		}
+	}
+	deepFieldsCache.Store(typ, fields)
+	return fields
+}
+
+// sharesMemory reports whether a shallow copy of v may share memory with v
+func sharesMemory(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return !v.IsNil()
+	default:
+		return true
+	}
+}
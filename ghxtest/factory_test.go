@@ -0,0 +1,130 @@
+package ghxtest
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx"
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactory(t *testing.T) {
+	t.Parallel()
+
+	base := github.Issue{
+		Title:     ghx.PTR("title"),
+		Labels:    []*github.Label{{Name: ghx.PTR("bug")}},
+		CreatedAt: &github.Timestamp{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	f := NewFactory(base, numberSequence(func(i *github.Issue) **int { return &i.Number }))
+
+	first := f.New()
+	second := f.NewWith(func(i *github.Issue) { i.Title = ghx.PTR("overwritten") })
+	assert.Equal(t, 1, first.GetNumber())
+	assert.Equal(t, 2, second.GetNumber())
+	assert.Equal(t, "title", first.GetTitle())
+	assert.Equal(t, "overwritten", second.GetTitle())
+	assert.Equal(t, base.CreatedAt, first.CreatedAt)
+
+	first.Labels[0].Name = ghx.PTR("changed")
+	assert.Equal(t, "bug", second.Labels[0].GetName(), "built values must not share memory")
+	assert.Equal(t, "bug", f.Base.Labels[0].GetName(), "the base must not share memory with built values")
+
+	f.With(func(i *github.Issue, n int) { i.Comments = ghx.PTR(n * 10) })
+	third := f.NewN(2)
+	require.Len(t, third, 2)
+	assert.Equal(t, 3, third[0].GetNumber())
+	assert.Equal(t, 40, third[1].GetComments())
+}
+
+func TestDeepCopyArray(t *testing.T) {
+	t.Parallel()
+
+	type labeled struct {
+		Labels [2]*github.Label
+	}
+	v := labeled{Labels: [2]*github.Label{{Name: ghx.PTR("bug")}, nil}}
+
+	c := DeepCopy(v)
+	assert.Equal(t, v, c)
+	c.Labels[0].Name = ghx.PTR("changed")
+	assert.Equal(t, "bug", v.Labels[0].GetName(), "array elements must not share memory")
+	assert.Nil(t, c.Labels[1])
+}
+
+func TestDeepCopyShared(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		Next  *node
+		Label *github.Label
+	}
+	label := &github.Label{Name: ghx.PTR("bug")}
+	cycle := &node{Label: label}
+	cycle.Next = &node{Next: cycle, Label: label}
+
+	c := DeepCopy(cycle)
+	assert.NotSame(t, cycle, c)
+	assert.Same(t, c, c.Next.Next, "cycles are kept")
+	assert.Same(t, c.Label, c.Next.Label, "shared pointers stay shared")
+	assert.NotSame(t, label, c.Label)
+
+	self := map[string]any{}
+	self["self"] = self
+	copied := DeepCopy(self)
+	assert.Equal(t, reflect.ValueOf(copied).Pointer(), reflect.ValueOf(copied["self"]).Pointer())
+	assert.NotEqual(t, reflect.ValueOf(self).Pointer(), reflect.ValueOf(copied).Pointer())
+}
+
+func TestLinkedFactories(t *testing.T) {
+	t.Parallel()
+
+	users := NewUserFactory()
+	repos := NewRepositoryFactory(users)
+	prs := NewPullRequestFactory(repos, users)
+
+	pr := prs.New()
+	assert.Equal(t, int64(1), pr.GetID())
+	assert.Equal(t, 1, pr.GetNumber())
+	assert.Equal(t, "user-1", pr.GetUser().GetLogin())
+	assert.Equal(t, "user-2/repo-1", pr.GetBase().GetRepo().GetFullName(), "the repo owner is built after the author")
+	assert.Equal(t, "main", pr.GetBase().GetRef())
+	assert.Equal(t, pr.GetBase().GetRepo(), pr.GetHead().GetRepo())
+	assert.NotSame(t, pr.GetBase().GetRepo(), pr.GetHead().GetRepo())
+
+	unlinked := NewPullRequestFactory(nil, nil).New()
+	assert.Nil(t, unlinked.User)
+	assert.Nil(t, unlinked.Base)
+
+	run := NewWorkflowRunFactory(repos).New()
+	assert.Equal(t, "repo-2", run.GetRepository().GetName())
+	assert.Equal(t, "user-4", NewIssueCommentFactory(users).New().GetUser().GetLogin())
+	assert.Equal(t, "APPROVED", NewPullRequestReviewFactory(users).New().GetState())
+	assert.Equal(t, "label-1", NewLabelFactory().New().GetName())
+	assert.Equal(t, "milestone-1", NewMilestoneFactory().New().GetTitle())
+	assert.Equal(t, "check-1", NewCheckRunFactory().New().GetName())
+}
+
+func BenchmarkFactory(b *testing.B) {
+	repos := NewRepositoryFactory(NewUserFactory())
+	base := *NewPullRequestFactory(repos, NewUserFactory()).New()
+
+	b.Run("DeepCopy", func(b *testing.B) {
+		for range b.N {
+			_ = DeepCopy(base)
+		}
+	})
+
+	b.Run("json", func(b *testing.B) {
+		for range b.N {
+			var pr github.PullRequest
+			data, err := json.Marshal(base)
+			require.NoError(b, err)
+			require.NoError(b, json.Unmarshal(data, &pr))
+		}
+	})
+}
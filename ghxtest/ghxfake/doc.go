@@ -0,0 +1,3 @@
+// Package ghxfake fakes GitHub for tests of code built on ghx: an in-memory backend of function tables and a stub REST API server.
+// It lives apart from ghxtest because it depends on ghx, whose own tests use ghxtest.
+package ghxfake
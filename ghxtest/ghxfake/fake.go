@@ -0,0 +1,885 @@
+package ghxfake
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bevicted/ghx"
+	"github.com/bevicted/ghx/ghxtest"
+	"github.com/bevicted/ghx/sq"
+	"github.com/google/go-github/v62/github"
+)
+
+const (
+	// DefaultLogin is the login of the user the fake acts as unless set through WithLogin
+	DefaultLogin = "ghxtest"
+
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// GitHub is a stateful in-memory GitHub backend of repos, issues, labels, milestones and comments.
+// Its function tables plug into ghx.NewIssuesService and ghx.NewSearchService so that code built on top of ghx
+// can be tested end to end, all tables of a GitHub share its store and are safe for concurrent use.
+type GitHub struct {
+	mu     sync.Mutex
+	login  string
+	now    func() time.Time
+	repos  map[string]*fakeRepo
+	nextID int64
+}
+
+type fakeRepo struct {
+	owner      string
+	name       string
+	issues     []*github.Issue
+	labels     []*github.Label
+	milestones []*github.Milestone
+	comments   []*github.IssueComment
+}
+
+type Option func(*GitHub)
+
+// WithLogin sets the user the fake acts as, e.g. the author of created issues and comments
+func WithLogin(login string) Option {
+	return func(f *GitHub) {
+		f.login = login
+	}
+}
+
+// WithClock sets the time source of created, updated and closed timestamps
+func WithClock(now func() time.Time) Option {
+	return func(f *GitHub) {
+		f.now = now
+	}
+}
+
+func NewGitHub(options ...Option) *GitHub {
+	f := &GitHub{
+		login: DefaultLogin,
+		now:   time.Now,
+		repos: map[string]*fakeRepo{},
+	}
+	for _, option := range options {
+		option(f)
+	}
+	return f
+}
+
+// AddRepo seeds an empty repo, API calls on repos that were never seeded fail with 404 Not Found
+func (f *GitHub) AddRepo(owner string, repo string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seedRepo(owner, repo)
+}
+
+// AddLabel seeds a label, seeding its repo if needed
+func (f *GitHub) AddLabel(owner string, repo string, label *github.Label) *github.Label {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r := f.seedRepo(owner, repo)
+	l := clone(label)
+	l.ID = f.newID()
+	r.labels = append(r.labels, l)
+	return clone(l)
+}
+
+// AddMilestone seeds an open milestone, seeding its repo if needed
+func (f *GitHub) AddMilestone(owner string, repo string, title string) *github.Milestone {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r := f.seedRepo(owner, repo)
+	m := &github.Milestone{
+		ID:     f.newID(),
+		Number: ghx.PTR(len(r.milestones) + 1),
+		Title:  &title,
+		State:  ghx.StateOpen.StringP(),
+	}
+	r.milestones = append(r.milestones, m)
+	return clone(m)
+}
+
+// AddIssue seeds a copy of issue as is, seeding its repo if needed.
+// The number, ID and repository URL are always assigned by the fake, the state defaults to open,
+// the author to the fake's login and the created and updated timestamps to now.
+func (f *GitHub) AddIssue(owner string, repo string, issue *github.Issue) *github.Issue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r := f.seedRepo(owner, repo)
+	i := clone(issue)
+	i.ID = f.newID()
+	i.Number = ghx.PTR(len(r.issues) + 1)
+	i.RepositoryURL = ghx.PTR(repoURL(owner, repo))
+	if i.State == nil {
+		i.State = ghx.StateOpen.StringP()
+	}
+	if i.User == nil {
+		i.User = f.user()
+	}
+	if i.CreatedAt == nil {
+		i.CreatedAt = f.timestamp()
+	}
+	if i.UpdatedAt == nil {
+		i.UpdatedAt = i.CreatedAt
+	}
+	r.issues = append(r.issues, i)
+	return clone(i)
+}
+
+// Issue returns a copy of the stored issue, nil if there is none
+func (f *GitHub) Issue(owner string, repo string, number int) *github.Issue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil
+	}
+	return clone(i)
+}
+
+// Issues returns copies of all stored issues of a repo ordered by number
+func (f *GitHub) Issues(owner string, repo string) []*github.Issue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil
+	}
+	return cloneAll(r.issues)
+}
+
+// Comments returns copies of all stored comments of an issue ordered by creation
+func (f *GitHub) Comments(owner string, repo string, number int) []*github.IssueComment {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil
+	}
+	var comments []*github.IssueComment
+	for _, c := range r.comments {
+		if c.GetIssueURL() == issueURL(owner, repo, number) {
+			comments = append(comments, clone(c))
+		}
+	}
+	return comments
+}
+
+// IssuesServiceF returns a function table backed by the store.
+// Methods without a fake implementation are left nil for the caller to stub,
+// extensions such as MapByRepo are filled in by ghx.NewIssuesService on top of the fake.
+func (f *GitHub) IssuesServiceF() *ghx.IssuesServiceF {
+	return &ghx.IssuesServiceF{
+		AddLabelsToIssue:      f.addLabelsToIssue,
+		Create:                f.create,
+		CreateComment:         f.createComment,
+		CreateLabel:           f.createLabel,
+		CreateMilestone:       f.createMilestone,
+		Edit:                  f.edit,
+		Get:                   f.get,
+		GetLabel:              f.getLabel,
+		GetMilestone:          f.getMilestone,
+		ListByRepo:            f.listByRepo,
+		ListComments:          f.listComments,
+		ListLabels:            f.listLabels,
+		ListLabelsByIssue:     f.listLabelsByIssue,
+		ListMilestones:        f.listMilestones,
+		RemoveLabelForIssue:   f.removeLabelForIssue,
+		ReplaceLabelsForIssue: f.replaceLabelsForIssue,
+	}
+}
+
+// SearchServiceF returns a function table whose Issues searches the store through sq.Match.
+// Queries with qualifiers sq.Match cannot evaluate fail rather than silently matching,
+// and like GitHub only the first ghx.MaxSearchResults results of a query can be paged through.
+func (f *GitHub) SearchServiceF() *ghx.SearchServiceF {
+	return &ghx.SearchServiceF{
+		Issues: f.searchIssues,
+	}
+}
+
+func (f *GitHub) create(_ context.Context, owner string, repo string, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.GetTitle() == "" {
+		return nil, nil, unprocessable("title is missing")
+	}
+	now := f.timestamp()
+	i := &github.Issue{
+		ID:            f.newID(),
+		Number:        ghx.PTR(len(r.issues) + 1),
+		State:         ghx.StateOpen.StringP(),
+		Title:         req.Title,
+		Body:          req.Body,
+		User:          f.user(),
+		Comments:      ghx.PTR(0),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		RepositoryURL: ghx.PTR(repoURL(owner, repo)),
+	}
+	if err := f.applyRequest(r, i, req); err != nil {
+		return nil, nil, err
+	}
+	r.issues = append(r.issues, i)
+	return clone(i), newResponse(http.StatusCreated), nil
+}
+
+func (f *GitHub) get(_ context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	return clone(i), newResponse(http.StatusOK), nil
+}
+
+func (f *GitHub) edit(_ context.Context, owner string, repo string, number int, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edited := clone(i)
+	if req.Title != nil {
+		if req.GetTitle() == "" {
+			return nil, nil, unprocessable("title cannot be blank")
+		}
+		edited.Title = req.Title
+	}
+	if req.Body != nil {
+		edited.Body = req.Body
+	}
+	if err := f.applyRequest(r, edited, req); err != nil {
+		return nil, nil, err
+	}
+	if err := f.applyState(edited, req); err != nil {
+		return nil, nil, err
+	}
+	edited.UpdatedAt = f.timestamp()
+	*i = *edited
+	return clone(i), newResponse(http.StatusOK), nil
+}
+
+// applyRequest applies the labels, assignees and milestone of req to i
+func (f *GitHub) applyRequest(r *fakeRepo, i *github.Issue, req *github.IssueRequest) error {
+	if req.Labels != nil {
+		i.Labels = f.labels(r, *req.Labels)
+	}
+	if req.Assignee != nil || req.Assignees != nil {
+		logins := req.GetAssignees()
+		if req.GetAssignee() != "" {
+			logins = append([]string{req.GetAssignee()}, logins...)
+		}
+		i.Assignee, i.Assignees = nil, nil
+		for _, login := range slices.Compact(logins) {
+			i.Assignees = append(i.Assignees, &github.User{Login: ghx.PTR(login)})
+		}
+		if len(i.Assignees) > 0 {
+			i.Assignee = i.Assignees[0]
+		}
+	}
+	if req.Milestone != nil {
+		if req.GetMilestone() == 0 {
+			i.Milestone = nil
+			return nil
+		}
+		idx := slices.IndexFunc(r.milestones, func(m *github.Milestone) bool { return m.GetNumber() == req.GetMilestone() })
+		if idx < 0 {
+			return unprocessable(fmt.Sprintf("milestone %d does not exist", req.GetMilestone()))
+		}
+		i.Milestone = clone(r.milestones[idx])
+	}
+	return nil
+}
+
+// applyState closes or reopens i, defaulting the state reason the way GitHub does
+func (f *GitHub) applyState(i *github.Issue, req *github.IssueRequest) error {
+	state := i.GetState()
+	if req.State != nil {
+		s, err := ghx.ParseState(req.GetState())
+		if err != nil || s == ghx.StateAll {
+			return unprocessable(fmt.Sprintf("invalid state %q", req.GetState()))
+		}
+		state = s.String()
+	}
+	reason := req.StateReason
+	if reason != nil {
+		if _, err := ghx.ParseStateReason(*reason); err != nil {
+			return unprocessable(fmt.Sprintf("invalid state reason %q", *reason))
+		}
+	}
+
+	switch {
+	case state == i.GetState() && reason == nil:
+		return nil
+	case state == ghx.StateClosed.String():
+		if reason == nil {
+			reason = ghx.PTR(ghx.StateReasonCompleted.String())
+		}
+		if i.GetState() != state {
+			i.ClosedAt = f.timestamp()
+			i.ClosedBy = f.user()
+		}
+	default:
+		if i.GetState() != state {
+			reason = ghx.PTR(ghx.StateReasonReopened.String())
+		}
+		i.ClosedAt, i.ClosedBy = nil, nil
+	}
+	i.State, i.StateReason = &state, reason
+	return nil
+}
+
+func (f *GitHub) addLabelsToIssue(_ context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+	return f.updateLabels(owner, repo, number, func(r *fakeRepo, current []*github.Label) ([]*github.Label, error) {
+		names := make([]string, 0, len(current)+len(labels))
+		for _, l := range current {
+			names = append(names, l.GetName())
+		}
+		return f.labels(r, append(names, labels...)), nil
+	})
+}
+
+func (f *GitHub) replaceLabelsForIssue(_ context.Context, owner string, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+	return f.updateLabels(owner, repo, number, func(r *fakeRepo, _ []*github.Label) ([]*github.Label, error) {
+		return f.labels(r, labels), nil
+	})
+}
+
+func (f *GitHub) removeLabelForIssue(_ context.Context, owner string, repo string, number int, label string) (*github.Response, error) {
+	_, resp, err := f.updateLabels(owner, repo, number, func(_ *fakeRepo, current []*github.Label) ([]*github.Label, error) {
+		idx := slices.IndexFunc(current, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), label) })
+		if idx < 0 {
+			return nil, notFound()
+		}
+		return slices.Delete(current, idx, idx+1), nil
+	})
+	return resp, err
+}
+
+// updateLabels replaces the labels of an issue with the result of update
+func (f *GitHub) updateLabels(owner string, repo string, number int, update func(r *fakeRepo, current []*github.Label) ([]*github.Label, error)) ([]*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	labels, err := update(r, slices.Clone(i.Labels))
+	if err != nil {
+		return nil, nil, err
+	}
+	i.Labels = labels
+	i.UpdatedAt = f.timestamp()
+	return cloneAll(i.Labels), newResponse(http.StatusOK), nil
+}
+
+func (f *GitHub) listByRepo(_ context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	if opts == nil {
+		opts = &github.IssueListByRepoOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issues []*github.Issue
+	for _, i := range r.issues {
+		if matchesListOptions(i, opts) {
+			issues = append(issues, i)
+		}
+	}
+	sortIssues(issues, opts.Sort, opts.Direction)
+	page, resp := paginate(issues, opts.ListOptions)
+	return cloneAll(page), resp, nil
+}
+
+// matchesListOptions filters issues the way the list repository issues endpoint does
+func matchesListOptions(i *github.Issue, opts *github.IssueListByRepoOptions) bool {
+	switch opts.State {
+	case "", ghx.StateOpen.String():
+		if i.GetState() != ghx.StateOpen.String() {
+			return false
+		}
+	case ghx.StateClosed.String():
+		if i.GetState() != ghx.StateClosed.String() {
+			return false
+		}
+	}
+
+	switch opts.Milestone {
+	case "":
+	case "*":
+		if i.Milestone == nil {
+			return false
+		}
+	case "none":
+		if i.Milestone != nil {
+			return false
+		}
+	default:
+		if i.Milestone == nil || strconv.Itoa(i.Milestone.GetNumber()) != opts.Milestone {
+			return false
+		}
+	}
+
+	switch opts.Assignee {
+	case "":
+	case "*":
+		if len(i.Assignees) == 0 && i.Assignee == nil {
+			return false
+		}
+	case "none":
+		if len(i.Assignees) > 0 || i.Assignee != nil {
+			return false
+		}
+	default:
+		if !slices.ContainsFunc(append([]*github.User{i.Assignee}, i.Assignees...), func(u *github.User) bool {
+			return u != nil && strings.EqualFold(u.GetLogin(), opts.Assignee)
+		}) {
+			return false
+		}
+	}
+
+	if opts.Creator != "" && !strings.EqualFold(i.GetUser().GetLogin(), opts.Creator) {
+		return false
+	}
+	if opts.Mentioned != "" && !strings.Contains(strings.ToLower(i.GetBody()), "@"+strings.ToLower(opts.Mentioned)) {
+		return false
+	}
+	for _, label := range opts.Labels {
+		if !slices.ContainsFunc(i.Labels, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), label) }) {
+			return false
+		}
+	}
+	return opts.Since.IsZero() || !i.GetUpdatedAt().Before(opts.Since)
+}
+
+// sortIssues sorts issues by created, updated or comments, descending by default
+func sortIssues(issues []*github.Issue, by string, direction string) {
+	slices.SortStableFunc(issues, func(a *github.Issue, b *github.Issue) int {
+		var c int
+		switch by {
+		case "updated":
+			c = a.GetUpdatedAt().Compare(b.GetUpdatedAt().Time)
+		case "comments":
+			c = cmp.Compare(a.GetComments(), b.GetComments())
+		default:
+			c = a.GetCreatedAt().Compare(b.GetCreatedAt().Time)
+		}
+		if c == 0 {
+			c = cmp.Compare(a.GetID(), b.GetID())
+		}
+		if direction != "asc" {
+			c = -c
+		}
+		return c
+	})
+}
+
+func (f *GitHub) searchIssues(_ context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+	if opts == nil {
+		opts = &github.SearchOptions{}
+	}
+	qualifiers, err := sq.Parse(query)
+	if err != nil {
+		return nil, nil, unprocessable(err.Error())
+	}
+	var repos, owners, excludedRepos, excludedOwners []string
+	for _, q := range qualifiers {
+		value := strings.ToLower(q.Value())
+		switch {
+		case q.Key() == "repo" && q.Negated():
+			excludedRepos = append(excludedRepos, value)
+		case q.Key() == "repo":
+			repos = append(repos, value)
+		case (q.Key() == "org" || q.Key() == "user") && q.Negated():
+			excludedOwners = append(excludedOwners, value)
+		case q.Key() == "org" || q.Key() == "user":
+			owners = append(owners, value)
+		}
+	}
+	qualifiers = qualifiers.Without("repo", "org", "user")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var issues []*github.Issue
+	for key, r := range f.repos {
+		owner := strings.ToLower(r.owner)
+		switch {
+		case len(repos) > 0 && !slices.Contains(repos, key), len(owners) > 0 && !slices.Contains(owners, owner):
+			continue
+		case slices.Contains(excludedRepos, key), slices.Contains(excludedOwners, owner):
+			continue
+		}
+		for _, i := range r.issues {
+			match, err := sq.Match(qualifiers, i)
+			if err != nil {
+				return nil, nil, unprocessable(err.Error())
+			}
+			if match {
+				issues = append(issues, i)
+			}
+		}
+	}
+	direction := opts.Order
+	if direction == "" {
+		direction = "desc"
+	}
+	sortIssues(issues, opts.Sort, direction)
+
+	if opts.Page*cmp.Or(min(opts.PerPage, maxPerPage), defaultPerPage) > ghx.MaxSearchResults {
+		return nil, nil, unprocessable(fmt.Sprintf("only the first %d search results are available", ghx.MaxSearchResults))
+	}
+	page, resp := paginate(issues[:min(len(issues), ghx.MaxSearchResults)], opts.ListOptions)
+	return &github.IssuesSearchResult{
+		Total:             ghx.PTR(len(issues)),
+		IncompleteResults: ghx.PTR(false),
+		Issues:            cloneAll(page),
+	}, resp, nil
+}
+
+func (f *GitHub) createComment(_ context.Context, owner string, repo string, number int, comment *github.IssueComment) (*github.IssueComment, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment.GetBody() == "" {
+		return nil, nil, unprocessable("body is missing")
+	}
+	now := f.timestamp()
+	c := &github.IssueComment{
+		ID:        f.newID(),
+		Body:      comment.Body,
+		User:      f.user(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		IssueURL:  ghx.PTR(issueURL(owner, repo, number)),
+	}
+	r.comments = append(r.comments, c)
+	i.Comments = ghx.PTR(i.GetComments() + 1)
+	i.UpdatedAt = now
+	return clone(c), newResponse(http.StatusCreated), nil
+}
+
+// listComments lists the comments of an issue, or of all issues of the repo for number 0
+func (f *GitHub) listComments(_ context.Context, owner string, repo string, number int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+	if opts == nil {
+		opts = &github.IssueListCommentsOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if number != 0 {
+		if _, err := f.issue(owner, repo, number); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var comments []*github.IssueComment
+	for _, c := range r.comments {
+		if number != 0 && c.GetIssueURL() != issueURL(owner, repo, number) {
+			continue
+		}
+		if opts.Since != nil && c.GetUpdatedAt().Before(*opts.Since) {
+			continue
+		}
+		comments = append(comments, c)
+	}
+	slices.SortStableFunc(comments, func(a *github.IssueComment, b *github.IssueComment) int {
+		c := a.GetCreatedAt().Compare(b.GetCreatedAt().Time)
+		if opts.GetSort() == "updated" {
+			c = a.GetUpdatedAt().Compare(b.GetUpdatedAt().Time)
+		}
+		if opts.GetDirection() == "desc" {
+			c = -c
+		}
+		return c
+	})
+	page, resp := paginate(comments, opts.ListOptions)
+	return cloneAll(page), resp, nil
+}
+
+func (f *GitHub) createLabel(_ context.Context, owner string, repo string, label *github.Label) (*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if label.GetName() == "" {
+		return nil, nil, unprocessable("name is missing")
+	}
+	if findLabel(r, label.GetName()) != nil {
+		return nil, nil, unprocessable(fmt.Sprintf("label %q already exists", label.GetName()))
+	}
+	l := clone(label)
+	l.ID = f.newID()
+	r.labels = append(r.labels, l)
+	return clone(l), newResponse(http.StatusCreated), nil
+}
+
+func (f *GitHub) getLabel(_ context.Context, owner string, repo string, name string) (*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := findLabel(r, name)
+	if l == nil {
+		return nil, nil, notFound()
+	}
+	return clone(l), newResponse(http.StatusOK), nil
+}
+
+func (f *GitHub) listLabels(_ context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	page, resp := paginate(r.labels, derefListOptions(opts))
+	return cloneAll(page), resp, nil
+}
+
+func (f *GitHub) listLabelsByIssue(_ context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.issue(owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	page, resp := paginate(i.Labels, derefListOptions(opts))
+	return cloneAll(page), resp, nil
+}
+
+func (f *GitHub) createMilestone(_ context.Context, owner string, repo string, milestone *github.Milestone) (*github.Milestone, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if milestone.GetTitle() == "" {
+		return nil, nil, unprocessable("title is missing")
+	}
+	m := clone(milestone)
+	m.ID = f.newID()
+	m.Number = ghx.PTR(len(r.milestones) + 1)
+	if m.State == nil {
+		m.State = ghx.StateOpen.StringP()
+	}
+	r.milestones = append(r.milestones, m)
+	return clone(m), newResponse(http.StatusCreated), nil
+}
+
+func (f *GitHub) getMilestone(_ context.Context, owner string, repo string, number int) (*github.Milestone, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if number < 1 || number > len(r.milestones) {
+		return nil, nil, notFound()
+	}
+	return clone(r.milestones[number-1]), newResponse(http.StatusOK), nil
+}
+
+func (f *GitHub) listMilestones(_ context.Context, owner string, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error) {
+	if opts == nil {
+		opts = &github.MilestoneListOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	state := cmp.Or(opts.State, ghx.StateOpen.String())
+	var milestones []*github.Milestone
+	for _, m := range r.milestones {
+		if state == ghx.StateAll.String() || m.GetState() == state {
+			milestones = append(milestones, m)
+		}
+	}
+	page, resp := paginate(milestones, opts.ListOptions)
+	return cloneAll(page), resp, nil
+}
+
+// seedRepo returns the repo, creating it if needed, the lock must be held
+func (f *GitHub) seedRepo(owner string, repo string) *fakeRepo {
+	key := repoKey(owner, repo)
+	r, ok := f.repos[key]
+	if !ok {
+		r = &fakeRepo{owner: owner, name: repo}
+		f.repos[key] = r
+	}
+	return r
+}
+
+func (f *GitHub) repo(owner string, repo string) (*fakeRepo, error) {
+	r, ok := f.repos[repoKey(owner, repo)]
+	if !ok {
+		return nil, notFound()
+	}
+	return r, nil
+}
+
+func (f *GitHub) issue(owner string, repo string, number int) (*github.Issue, error) {
+	r, err := f.repo(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(r.issues) {
+		return nil, notFound()
+	}
+	return r.issues[number-1], nil
+}
+
+// labels resolves names to the labels of the repo, creating missing ones like GitHub does
+func (f *GitHub) labels(r *fakeRepo, names []string) []*github.Label {
+	var labels []*github.Label
+	for _, name := range names {
+		l := findLabel(r, name)
+		if l == nil {
+			l = &github.Label{ID: f.newID(), Name: ghx.PTR(name)}
+			r.labels = append(r.labels, l)
+		}
+		if !slices.Contains(labels, l) {
+			labels = append(labels, l)
+		}
+	}
+	return cloneAll(labels)
+}
+
+func findLabel(r *fakeRepo, name string) *github.Label {
+	idx := slices.IndexFunc(r.labels, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), name) })
+	if idx < 0 {
+		return nil
+	}
+	return r.labels[idx]
+}
+
+func (f *GitHub) newID() *int64 {
+	f.nextID++
+	id := f.nextID
+	return &id
+}
+
+func (f *GitHub) user() *github.User {
+	return &github.User{Login: ghx.PTR(f.login)}
+}
+
+func (f *GitHub) timestamp() *github.Timestamp {
+	return &github.Timestamp{Time: f.now().UTC().Truncate(time.Second)}
+}
+
+// paginate returns the requested page of items along with a response linking the other pages like GitHub does
+func paginate[T any](items []T, opts github.ListOptions) ([]T, *github.Response) {
+	perPage := cmp.Or(min(opts.PerPage, maxPerPage), defaultPerPage)
+	page := max(opts.Page, 1)
+	lastPage := max((len(items)+perPage-1)/perPage, 1)
+
+	resp := newResponse(http.StatusOK)
+	if page > 1 {
+		resp.FirstPage = 1
+		resp.PrevPage = min(page-1, lastPage)
+	}
+	if page < lastPage {
+		resp.NextPage = page + 1
+		resp.LastPage = lastPage
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return items[start:end], resp
+}
+
+func derefListOptions(opts *github.ListOptions) github.ListOptions {
+	if opts == nil {
+		return github.ListOptions{}
+	}
+	return *opts
+}
+
+func newResponse(status int) *github.Response {
+	return &github.Response{Response: &http.Response{StatusCode: status}}
+}
+
+func notFound() error {
+	return &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+		Message:  "Not Found",
+	}
+}
+
+func unprocessable(message string) error {
+	return &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "Validation Failed: " + message,
+	}
+}
+
+func repoKey(owner string, repo string) string {
+	return strings.ToLower(owner + "/" + repo)
+}
+
+func repoURL(owner string, repo string) string {
+	return "https://api.github.com/repos/" + owner + "/" + repo
+}
+
+func issueURL(owner string, repo string, number int) string {
+	return repoURL(owner, repo) + "/issues/" + strconv.Itoa(number)
+}
+
+// clone deepcopies v, so that the store cannot be mutated through returned values
+func clone[T any](v *T) *T {
+	return ghxtest.DeepCopy(v)
+}
+
+func cloneAll[T any](items []*T) []*T {
+	if items == nil {
+		return nil
+	}
+	clones := make([]*T, len(items))
+	for idx, item := range items {
+		clones[idx] = clone(item)
+	}
+	return clones
+}
@@ -0,0 +1,257 @@
+package ghxfake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx"
+	"github.com/bevicted/ghx/sq"
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClock returns a clock starting at start and ticking a minute per call
+func newTestClock(start time.Time) func() time.Time {
+	now := start
+	return func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+}
+
+func requireStatus(t *testing.T, expect int, err error) {
+	t.Helper()
+	var errResp *github.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, expect, errResp.Response.StatusCode)
+}
+
+func issueNumbers(issues []*github.Issue) []int {
+	numbers := make([]int, len(issues))
+	for idx, i := range issues {
+		numbers[idx] = i.GetNumber()
+	}
+	return numbers
+}
+
+func TestGitHubIssues(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	fake := NewGitHub(WithLogin("bot"), WithClock(newTestClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))))
+	fake.AddRepo("owner", "repo")
+	milestone := fake.AddMilestone("owner", "repo", "v1")
+	issues := ghx.NewIssuesService(fake.IssuesServiceF())
+
+	created, resp, err := issues.Create(testCtx, "owner", "repo", &github.IssueRequest{
+		Title:     ghx.PTR("crash on start"),
+		Labels:    &[]string{"bug"},
+		Milestone: milestone.Number,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 1, created.GetNumber())
+	assert.Equal(t, "open", created.GetState())
+	assert.Equal(t, "bot", created.GetUser().GetLogin())
+	assert.Equal(t, "v1", created.GetMilestone().GetTitle())
+	require.Len(t, created.Labels, 1)
+	assert.Equal(t, "bug", created.Labels[0].GetName())
+
+	t.Run("labels", func(t *testing.T) {
+		labels, _, err := issues.AddLabelsToIssue(testCtx, "owner", "repo", 1, []string{"BUG", "triage"})
+		require.NoError(t, err)
+		assert.Len(t, labels, 2, "labels match case insensitively")
+
+		label, _, err := issues.GetLabel(testCtx, "owner", "repo", "triage")
+		require.NoError(t, err, "missing labels are created")
+		assert.Equal(t, "triage", label.GetName())
+
+		_, err = issues.RemoveLabelForIssue(testCtx, "owner", "repo", 1, "triage")
+		require.NoError(t, err)
+		_, err = issues.RemoveLabelForIssue(testCtx, "owner", "repo", 1, "triage")
+		requireStatus(t, http.StatusNotFound, err)
+	})
+
+	t.Run("close and reopen", func(t *testing.T) {
+		closed, _, err := issues.Close(testCtx, "owner", "repo", 1, ghx.StateReasonNotPlanned)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", closed.GetState())
+		assert.Equal(t, "not_planned", closed.GetStateReason())
+		assert.NotNil(t, closed.ClosedAt)
+		assert.Equal(t, "bot", closed.GetClosedBy().GetLogin())
+
+		reopened, _, err := issues.Reopen(testCtx, "owner", "repo", 1)
+		require.NoError(t, err)
+		assert.Equal(t, "open", reopened.GetState())
+		assert.Equal(t, "reopened", reopened.GetStateReason())
+		assert.Nil(t, reopened.ClosedAt)
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		_, _, err := issues.CreateComment(testCtx, "owner", "repo", 1, &github.IssueComment{Body: ghx.PTR("on it")})
+		require.NoError(t, err)
+		comments, _, err := issues.ListComments(testCtx, "owner", "repo", 1, nil)
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.Equal(t, "on it", comments[0].GetBody())
+		assert.Equal(t, 1, fake.Issue("owner", "repo", 1).GetComments())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := issues.Get(testCtx, "owner", "repo", 2)
+		requireStatus(t, http.StatusNotFound, err)
+		_, _, err = issues.Create(testCtx, "owner", "unknown", &github.IssueRequest{Title: ghx.PTR("title")})
+		requireStatus(t, http.StatusNotFound, err)
+		_, _, err = issues.Create(testCtx, "owner", "repo", &github.IssueRequest{})
+		requireStatus(t, http.StatusUnprocessableEntity, err)
+		_, _, err = issues.Edit(testCtx, "owner", "repo", 1, &github.IssueRequest{Milestone: ghx.PTR(42)})
+		requireStatus(t, http.StatusUnprocessableEntity, err)
+	})
+
+	t.Run("returned issues do not alias the store", func(t *testing.T) {
+		issue, _, err := issues.Get(testCtx, "owner", "repo", 1)
+		require.NoError(t, err)
+		issue.Title = ghx.PTR("changed")
+		assert.Equal(t, "crash on start", fake.Issue("owner", "repo", 1).GetTitle())
+	})
+}
+
+func TestGitHubListByRepo(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	fake := NewGitHub(WithClock(newTestClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))))
+	for n := range 7 {
+		issue := &github.Issue{Title: ghx.PTR("issue")}
+		if n%2 == 0 {
+			issue.Labels = []*github.Label{{Name: ghx.PTR("bug")}}
+		}
+		if n == 6 {
+			issue.State = ghx.StateClosed.StringP()
+		}
+		fake.AddIssue("owner", "repo", issue)
+	}
+	issues := ghx.NewIssuesService(fake.IssuesServiceF())
+
+	for _, tc := range []struct {
+		name   string
+		opts   *github.IssueListByRepoOptions
+		expect []int
+	}{
+		{
+			name:   "open newest first by default",
+			expect: []int{6, 5, 4, 3, 2, 1},
+		},
+		{
+			name:   "all ascending",
+			opts:   &github.IssueListByRepoOptions{State: "all", Direction: "asc"},
+			expect: []int{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name:   "labels",
+			opts:   &github.IssueListByRepoOptions{Labels: []string{"bug"}, Direction: "asc"},
+			expect: []int{1, 3, 5},
+		},
+		{
+			name:   "since",
+			opts:   &github.IssueListByRepoOptions{State: "closed", Since: time.Date(2024, 1, 1, 0, 7, 0, 0, time.UTC)},
+			expect: []int{7},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual []*github.Issue
+			require.NoError(t, issues.MapByRepo(testCtx, "owner", "repo", tc.opts, func(issue *github.Issue) error {
+				actual = append(actual, issue)
+				return nil
+			}))
+			assert.Equal(t, tc.expect, issueNumbers(actual))
+		})
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		t.Parallel()
+
+		page, resp, err := issues.ListByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{ListOptions: github.ListOptions{Page: 2, PerPage: 4}})
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 1}, issueNumbers(page))
+		assert.Equal(t, 0, resp.NextPage)
+		assert.Equal(t, 1, resp.PrevPage)
+
+		page, resp, err = issues.ListByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{ListOptions: github.ListOptions{PerPage: 4}})
+		require.NoError(t, err)
+		assert.Len(t, page, 4)
+		assert.Equal(t, 2, resp.NextPage)
+		assert.Equal(t, 2, resp.LastPage)
+	})
+}
+
+func TestGitHubSearch(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	fake := NewGitHub(WithClock(newTestClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))))
+	fake.AddIssue("owner", "repo", &github.Issue{Title: ghx.PTR("crash on start"), Labels: []*github.Label{{Name: ghx.PTR("bug")}}})
+	fake.AddIssue("owner", "repo", &github.Issue{Title: ghx.PTR("add dark mode")})
+	fake.AddIssue("owner", "other", &github.Issue{Title: ghx.PTR("crash on exit"), Labels: []*github.Label{{Name: ghx.PTR("bug")}}})
+	search := ghx.NewSearchService(fake.SearchServiceF())
+
+	t.Run("qualifiers", func(t *testing.T) {
+		t.Parallel()
+
+		query, err := sq.Query(sq.HasText("crash")).Repo("owner", "repo").Label("bug").Build()
+		require.NoError(t, err)
+		issue, err := search.UniqueIssue(testCtx, query)
+		require.NoError(t, err)
+		assert.Equal(t, "crash on start", issue.GetTitle())
+
+		result, _, err := search.Issues(testCtx, "label:bug", &github.SearchOptions{Sort: "created", Order: "asc"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.GetTotal())
+		assert.Equal(t, []string{"crash on start", "crash on exit"}, []string{result.Issues[0].GetTitle(), result.Issues[1].GetTitle()})
+	})
+
+	t.Run("negated repo and owner qualifiers exclude", func(t *testing.T) {
+		t.Parallel()
+
+		for query, expect := range map[string]int{
+			"label:bug -repo:owner/other":          1,
+			"label:bug -repo:OWNER/other -org:foo": 1,
+			"label:bug -user:owner":                0,
+			"label:bug -org:someone":               2,
+			"repo:owner/repo -repo:owner/repo":     0,
+		} {
+			result, _, err := search.Issues(testCtx, query, nil)
+			require.NoError(t, err, query)
+			assert.Equal(t, expect, result.GetTotal(), query)
+		}
+	})
+
+	t.Run("unsupported qualifiers fail", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := search.Issues(testCtx, "involves:someone", nil)
+		requireStatus(t, http.StatusUnprocessableEntity, err)
+	})
+
+	t.Run("results past the search limit", func(t *testing.T) {
+		t.Parallel()
+
+		big := NewGitHub()
+		for range ghx.MaxSearchResults + 1 {
+			big.AddIssue("owner", "repo", &github.Issue{})
+		}
+		result, _, err := big.SearchServiceF().Issues(testCtx, "repo:owner/repo", &github.SearchOptions{ListOptions: github.ListOptions{Page: 10, PerPage: 100}})
+		require.NoError(t, err)
+		assert.Equal(t, ghx.MaxSearchResults+1, result.GetTotal())
+		assert.Len(t, result.Issues, 100)
+
+		_, _, err = big.SearchServiceF().Issues(testCtx, "repo:owner/repo", &github.SearchOptions{ListOptions: github.ListOptions{Page: 11, PerPage: 100}})
+		requireStatus(t, http.StatusUnprocessableEntity, err)
+	})
+}
@@ -0,0 +1,294 @@
+package ghxfake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx"
+	"github.com/google/go-github/v62/github"
+)
+
+// Server is a stub of the GitHub REST API for exercising the whole go-github stack,
+// i.e. URL building, JSON decoding, Link header pagination and error types.
+// Requests are answered by the most recently registered route matching them and fail the test if there is none.
+type Server struct {
+	*httptest.Server
+
+	t        *testing.T
+	mu       sync.Mutex
+	routes   []*Route
+	requests []RecordedRequest
+}
+
+// Route answers requests matching its method and path pattern
+type Route struct {
+	mu        *sync.Mutex
+	method    string
+	segments  []string
+	handler   http.HandlerFunc
+	remaining int
+}
+
+// Times limits the route to the next n matching requests, later ones fall through to earlier routes,
+// e.g. srv.RespondError(...).Times(1) fails a single request
+func (r *Route) Times(n int) *Route {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.remaining = n
+	return r
+}
+
+// RecordedRequest is a request received by a Server
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// String formats the request as its method and URL, e.g. GET /repos/owner/repo/issues?page=2
+func (r RecordedRequest) String() string {
+	if len(r.Query) == 0 {
+		return r.Method + " " + r.Path
+	}
+	return r.Method + " " + r.Path + "?" + r.Query.Encode()
+}
+
+type ServerOption func(*serverConfig)
+
+type serverConfig struct {
+	transport func(http.RoundTripper) http.RoundTripper
+}
+
+// WithClientTransport wraps the transport of the returned client, e.g. in a ghx.RateLimitTransport
+func WithClientTransport(wrap func(base http.RoundTripper) http.RoundTripper) ServerOption {
+	return func(c *serverConfig) {
+		c.transport = wrap
+	}
+}
+
+// NewServer starts a Server closed on test cleanup and returns a client pointed at it
+func NewServer(t *testing.T, options ...ServerOption) (*ghx.Client, *Server) {
+	t.Helper()
+
+	var cfg serverConfig
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	s := &Server{t: t}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+
+	transport := s.Server.Client().Transport
+	if cfg.transport != nil {
+		transport = cfg.transport(transport)
+	}
+	c := github.NewClient(&http.Client{Transport: transport})
+	baseURL, err := url.Parse(s.URL + "/")
+	if err != nil {
+		t.Fatalf("ghxfake: parsing server URL: %v", err)
+	}
+	c.BaseURL, c.UploadURL = baseURL, baseURL
+	return ghx.NewClient(c), s
+}
+
+// Handle registers handler for requests matching method and pattern, a path whose segments may be {wildcards},
+// e.g. GET /repos/{owner}/{repo}/issues, their values are available through http.Request.PathValue
+func (s *Server) Handle(method string, pattern string, handler http.HandlerFunc) *Route {
+	r := &Route{
+		mu:        &s.mu,
+		method:    method,
+		segments:  strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:   handler,
+		remaining: -1,
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, r)
+	return r
+}
+
+// Respond registers a route answering with status and body encoded as JSON, typically a go-github type
+func (s *Server) Respond(method string, pattern string, status int, body any) *Route {
+	return s.Handle(method, pattern, func(w http.ResponseWriter, _ *http.Request) {
+		s.writeJSON(w, status, body)
+	})
+}
+
+// RespondPages registers a route answering with the page of pages requested through the page query parameter,
+// linking the other pages through the Link header like GitHub does
+func (s *Server) RespondPages(method string, pattern string, pages ...any) *Route {
+	return s.Handle(method, pattern, func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if raw := r.URL.Query().Get("page"); raw != "" {
+			var err error
+			if page, err = strconv.Atoi(raw); err != nil || page < 1 {
+				s.writeError(w, http.StatusBadRequest, "invalid page "+raw)
+				return
+			}
+		}
+		if page > len(pages) {
+			s.t.Errorf("ghxfake: %s %s requested page %d of %d", r.Method, r.URL.Path, page, len(pages))
+			s.writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+
+		var links []string
+		link := func(p int, rel string) {
+			u := *r.URL
+			q := u.Query()
+			q.Set("page", strconv.Itoa(p))
+			u.RawQuery = q.Encode()
+			links = append(links, fmt.Sprintf(`<%s%s>; rel="%s"`, s.URL, u.RequestURI(), rel))
+		}
+		if page > 1 {
+			link(page-1, "prev")
+			link(1, "first")
+		}
+		if page < len(pages) {
+			link(page+1, "next")
+			link(len(pages), "last")
+		}
+		if len(links) > 0 {
+			w.Header().Set("Link", strings.Join(links, ", "))
+		}
+		s.writeJSON(w, http.StatusOK, pages[page-1])
+	})
+}
+
+// RespondError registers a route answering with status and a GitHub error body, decoded by go-github as *github.ErrorResponse
+func (s *Server) RespondError(method string, pattern string, status int, message string) *Route {
+	return s.Handle(method, pattern, func(w http.ResponseWriter, _ *http.Request) {
+		s.writeError(w, status, message)
+	})
+}
+
+// RespondRateLimited registers a route rejecting requests with an exhausted primary rate limit resetting at reset,
+// decoded by go-github as *github.RateLimitError
+func (s *Server) RespondRateLimited(method string, pattern string, reset time.Time) *Route {
+	return s.Handle(method, pattern, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		s.writeError(w, http.StatusForbidden, "API rate limit exceeded")
+	})
+}
+
+// RespondSecondaryRateLimited registers a route rejecting requests with a secondary rate limit asking to retry after retryAfter,
+// decoded by go-github as *github.AbuseRateLimitError
+func (s *Server) RespondSecondaryRateLimited(method string, pattern string, retryAfter time.Duration) *Route {
+	return s.Handle(method, pattern, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		s.writeJSON(w, http.StatusForbidden, map[string]string{
+			"message":           "You have exceeded a secondary rate limit. Please wait a few minutes before you try again.",
+			"documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits",
+		})
+	})
+}
+
+// Requests returns the requests received so far in order
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// Calls returns the received requests formatted by RecordedRequest.String, for asserting exactly which calls were made
+func (s *Server) Calls() []string {
+	requests := s.Requests()
+	calls := make([]string, len(requests))
+	for idx, r := range requests {
+		calls[idx] = r.String()
+	}
+	return calls
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.t.Errorf("ghxfake: reading request body: %v", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, RecordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	route := s.match(r)
+	s.mu.Unlock()
+
+	if route == nil {
+		s.t.Errorf("ghxfake: no route for %s %s", r.Method, r.URL.RequestURI())
+		s.writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	route.handler(w, r)
+}
+
+// match returns the latest route matching r, consuming one of its remaining requests, the lock must be held
+func (s *Server) match(r *http.Request) *Route {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for idx := len(s.routes) - 1; idx >= 0; idx-- {
+		route := s.routes[idx]
+		if route.method != r.Method || route.remaining == 0 || len(route.segments) != len(segments) {
+			continue
+		}
+		values := map[string]string{}
+		matched := true
+		for i, segment := range route.segments {
+			if name, ok := strings.CutPrefix(segment, "{"); ok && strings.HasSuffix(name, "}") {
+				values[strings.TrimSuffix(name, "}")] = segments[i]
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		for name, value := range values {
+			r.SetPathValue(name, value)
+		}
+		if route.remaining > 0 {
+			route.remaining--
+		}
+		return route
+	}
+	return nil
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, body any) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		s.t.Errorf("ghxfake: encoding response: %v", err)
+		status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
+	s.writeJSON(w, status, map[string]string{
+		"message":           message,
+		"documentation_url": "https://docs.github.com/rest",
+	})
+}
@@ -0,0 +1,102 @@
+package ghxfake
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx"
+	"github.com/bevicted/ghx/ghxtest"
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+
+	t.Run("pages are linked", func(t *testing.T) {
+		t.Parallel()
+
+		client, srv := NewServer(t)
+		srv.RespondPages(http.MethodGet, "/repos/{owner}/{repo}/issues",
+			ghxtest.NewEmptyIssues(t, 2),
+			ghxtest.NewEmptyIssues(t, 2),
+			ghxtest.NewEmptyIssues(t, 1),
+		)
+
+		var count int
+		require.NoError(t, client.Issues.MapByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{State: "all"}, func(*github.Issue) error {
+			count++
+			return nil
+		}))
+		assert.Equal(t, 5, count)
+		assert.Equal(t, []string{
+			"GET /repos/owner/repo/issues?state=all",
+			"GET /repos/owner/repo/issues?page=2&state=all",
+			"GET /repos/owner/repo/issues?page=3&state=all",
+		}, srv.Calls())
+
+		_, resp, err := client.Issues.ListByRepo(testCtx, "owner", "repo", &github.IssueListByRepoOptions{ListOptions: github.ListOptions{Page: 2}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.FirstPage)
+		assert.Equal(t, 1, resp.PrevPage)
+		assert.Equal(t, 3, resp.NextPage)
+		assert.Equal(t, 3, resp.LastPage)
+	})
+
+	t.Run("path values and request bodies", func(t *testing.T) {
+		t.Parallel()
+
+		client, srv := NewServer(t)
+		srv.Handle(http.MethodPatch, "/repos/{owner}/{repo}/issues/{number}", func(w http.ResponseWriter, r *http.Request) {
+			var req github.IssueRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "42", r.PathValue("number"))
+			_ = json.NewEncoder(w).Encode(&github.Issue{Number: ghx.PTR(42), State: req.State})
+		})
+
+		issue, _, err := client.Issues.Close(testCtx, "owner", "repo", 42, ghx.StateReasonCompleted)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", issue.GetState())
+		requests := srv.Requests()
+		require.Len(t, requests, 1)
+		assert.JSONEq(t, `{"state":"closed","state_reason":"completed"}`, string(requests[0].Body))
+	})
+
+	t.Run("injected errors", func(t *testing.T) {
+		t.Parallel()
+
+		client, srv := NewServer(t)
+		srv.Respond(http.MethodGet, "/repos/{owner}/{repo}/issues/{number}", http.StatusOK, &github.Issue{Number: ghx.PTR(1)})
+		srv.RespondError(http.MethodGet, "/repos/{owner}/{repo}/issues/{number}", http.StatusNotFound, "Not Found").Times(1)
+		srv.RespondRateLimited(http.MethodGet, "/rate", time.Now().Add(-time.Second))
+		srv.RespondSecondaryRateLimited(http.MethodGet, "/abuse", time.Minute)
+
+		_, _, err := client.Issues.Get(testCtx, "owner", "repo", 1)
+		var errResp *github.ErrorResponse
+		require.ErrorAs(t, err, &errResp)
+		assert.Equal(t, http.StatusNotFound, errResp.Response.StatusCode)
+
+		issue, _, err := client.Issues.Get(testCtx, "owner", "repo", 1)
+		require.NoError(t, err, "the error was injected once")
+		assert.Equal(t, 1, issue.GetNumber())
+
+		req, err := client.NewRequest(http.MethodGet, "rate", nil)
+		require.NoError(t, err)
+		_, err = client.Do(testCtx, req, nil)
+		var rateErr *github.RateLimitError
+		require.ErrorAs(t, err, &rateErr)
+
+		req, err = client.NewRequest(http.MethodGet, "abuse", nil)
+		require.NoError(t, err)
+		_, err = client.Do(testCtx, req, nil)
+		var abuseErr *github.AbuseRateLimitError
+		require.ErrorAs(t, err, &abuseErr)
+		assert.Equal(t, time.Minute, abuseErr.GetRetryAfter())
+	})
+}
@@ -1,33 +1,29 @@
 package ghxtest
 
 import (
-	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-github/v62/github"
-	"github.com/stretchr/testify/require"
 )
 
 type IssueFactory struct {
 	github.Issue
 }
 
-// NewIssue deepcopies IssueFactory.Issue by marshaling and unmarshaling it
-// NOTE: performance heavy, only use for detailed testing
+// NewIssue deepcopies IssueFactory.Issue
 func (f *IssueFactory) NewIssue(t *testing.T) *github.Issue {
 	t.Helper()
-	var i github.Issue
-	remarshal(t, f.Issue, &i)
+	i := DeepCopy(f.Issue)
 	return &i
 }
 
+// NewIssueWithOverwrite deepcopies IssueFactory.Issue and sets the non empty fields of overwrite on it,
+// structs set on both are merged the same way, e.g. the name of an overwritten user keeps the login of the base
 func (f *IssueFactory) NewIssueWithOverwrite(t *testing.T, overwrite github.Issue) *github.Issue {
 	t.Helper()
-	var i github.Issue
-
-	remarshal(t, f.Issue, &i)
-	remarshal(t, overwrite, &i)
-
+	i := DeepCopy(f.Issue)
+	merge(reflect.ValueOf(&i).Elem(), reflect.ValueOf(DeepCopy(overwrite)))
 	return &i
 }
 
@@ -61,10 +57,20 @@ func NewEmptyIssues(t *testing.T, n int) []*github.Issue {
 	return issues
 }
 
-func remarshal[T any](t *testing.T, marshalTarget T, unmarshalTarget *T) {
-	t.Helper()
-
-	b, err := json.Marshal(marshalTarget)
-	require.NoError(t, err, "error marshalling in remarshal")
-	require.NoError(t, json.Unmarshal(b, unmarshalTarget), "error unmarshalling in remarshal")
+// merge sets the non empty exported fields of struct src on struct dst, merging structs pointed to by both
+func merge(dst reflect.Value, src reflect.Value) {
+	for i := range src.NumField() {
+		if !src.Type().Field(i).IsExported() {
+			continue
+		}
+		from, to := src.Field(i), dst.Field(i)
+		switch {
+		case from.IsZero():
+		case (from.Kind() == reflect.Slice || from.Kind() == reflect.Map) && from.Len() == 0:
+		case from.Kind() == reflect.Pointer && from.Elem().Kind() == reflect.Struct && !to.IsNil():
+			merge(to.Elem(), from.Elem())
+		default:
+			to.Set(from)
+		}
+	}
 }
@@ -2,6 +2,7 @@ package ghxtest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bevicted/ghx"
 	"github.com/google/go-github/v62/github"
@@ -58,6 +59,27 @@ func TestIssueFactory(t *testing.T) {
 	)
 }
 
+func TestNewIssueWithOverwrite(t *testing.T) {
+	t.Parallel()
+
+	created := &github.Timestamp{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	f := &IssueFactory{Issue: github.Issue{
+		Title:  ghx.PTR("title"),
+		User:   &github.User{Login: ghx.PTR("octocat"), Name: ghx.PTR("name")},
+		Labels: []*github.Label{{Name: ghx.PTR("bug")}},
+	}}
+
+	overwrite := github.Issue{User: &github.User{Name: ghx.PTR("new name")}, Labels: []*github.Label{}, CreatedAt: created}
+	i := f.NewIssueWithOverwrite(t, overwrite)
+	assert.Equal(t, "title", i.GetTitle())
+	assert.Equal(t, "octocat", i.GetUser().GetLogin(), "structs set on both are merged")
+	assert.Equal(t, "new name", i.GetUser().GetName())
+	assert.Equal(t, "bug", i.Labels[0].GetName(), "empty slices do not overwrite")
+	assert.Equal(t, created, i.CreatedAt)
+	assert.NotSame(t, created, i.CreatedAt)
+	assert.Equal(t, "name", f.Issue.User.GetName(), "the base must not be changed")
+}
+
 func TestNewEmptyIssues(t *testing.T) {
 	t.Parallel()
 
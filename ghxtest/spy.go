@@ -0,0 +1,192 @@
+package ghxtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ErrNotStubbed = errors.New("function not stubbed")
+
+// Anything matches any argument in Spy assertions
+const Anything = "ghxtest.Anything"
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Call is a call recorded by a Spy, context arguments are not recorded
+type Call struct {
+	Method string
+	Args   []any
+}
+
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for idx, arg := range c.Args {
+		args[idx] = fmt.Sprintf("%#v", arg)
+	}
+	return c.Method + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Spy records the calls to a function table such as *ghx.IssuesServiceF
+type Spy struct {
+	errorf func(format string, args ...any)
+	mu     sync.Mutex
+	calls  []Call
+}
+
+// NewSpy wraps every function of table in place to record its calls, table must be a pointer to a struct of functions.
+// Calls to functions that are not set fail the test and return zero values, along with ErrNotStubbed if they return an error.
+//
+// Service constructors copy their table, spy it before passing it on. Unset extensions, tagged ghx:"extension",
+// are left unset so that they default to their implementation: the calls they make are recorded, not their own.
+//
+//	f := &ghx.IssuesServiceF{AddLabelsToIssue: ...}
+//	spy := ghxtest.NewSpy(t, f)
+//	issues := ghx.NewIssuesService(f)
+func NewSpy(t *testing.T, table any) *Spy {
+	t.Helper()
+
+	v := reflect.ValueOf(table)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		t.Fatalf("ghxtest: spying on %T, expected a pointer to a function table", table)
+	}
+	s := &Spy{errorf: t.Errorf}
+	v = v.Elem()
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if field.Kind() != reflect.Func || !field.CanSet() {
+			continue
+		}
+		if field.IsNil() && v.Type().Field(i).Tag.Get("ghx") == "extension" {
+			continue
+		}
+		// copy the function out of the field before replacing it, the field's value would refer to the wrapper
+		fn := reflect.ValueOf(field.Interface())
+		name := v.Type().Field(i).Name
+		field.Set(s.wrap(v.Type().Name()+"."+name, name, fn))
+	}
+	return s
+}
+
+// wrap returns fn recording its calls under method
+func (s *Spy) wrap(qualified string, method string, fn reflect.Value) reflect.Value {
+	typ := fn.Type()
+	return reflect.MakeFunc(typ, func(in []reflect.Value) []reflect.Value {
+		call := Call{Method: method}
+		for idx, arg := range in {
+			if typ.In(idx) == contextType {
+				continue
+			}
+			call.Args = append(call.Args, arg.Interface())
+		}
+		s.mu.Lock()
+		s.calls = append(s.calls, call)
+		s.mu.Unlock()
+
+		if !fn.IsNil() {
+			if typ.IsVariadic() {
+				return fn.CallSlice(in)
+			}
+			return fn.Call(in)
+		}
+
+		s.errorf("ghxtest: %s called but not set: %s", qualified, call)
+		out := make([]reflect.Value, typ.NumOut())
+		for idx := range out {
+			out[idx] = reflect.Zero(typ.Out(idx))
+		}
+		if last := typ.NumOut() - 1; last >= 0 && typ.Out(last) == errorType {
+			out[last] = reflect.ValueOf(fmt.Errorf("%w: %s", ErrNotStubbed, qualified))
+		}
+		return out
+	})
+}
+
+// Calls returns the recorded calls in order
+func (s *Spy) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo returns the recorded calls to method matching args, all calls to method if no args are given
+func (s *Spy) CallsTo(method string, args ...any) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Method == method && (len(args) == 0 || argsMatch(args, call.Args)) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCalled asserts that method was called at least once with args, or at all if no args are given
+func (s *Spy) AssertCalled(t assert.TestingT, method string, args ...any) bool {
+	if len(s.CallsTo(method, args...)) > 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf("expected %s to be called with %v", method, args), s.callLog())
+}
+
+// AssertCalledTimes asserts that method was called exactly times with args, or at all if no args are given
+func (s *Spy) AssertCalledTimes(t assert.TestingT, times int, method string, args ...any) bool {
+	if actual := len(s.CallsTo(method, args...)); actual != times {
+		return assert.Fail(t, fmt.Sprintf("expected %s to be called %d times with %v, was called %d times", method, times, args, actual), s.callLog())
+	}
+	return true
+}
+
+// AssertNotCalled asserts that method was never called with args, or never called at all if no args are given
+func (s *Spy) AssertNotCalled(t assert.TestingT, method string, args ...any) bool {
+	if calls := s.CallsTo(method, args...); len(calls) > 0 {
+		return assert.Fail(t, fmt.Sprintf("expected %s not to be called with %v, was called %d times", method, args, len(calls)), s.callLog())
+	}
+	return true
+}
+
+// AssertCallOrder asserts that methods were called in this order, other calls may happen in between
+func (s *Spy) AssertCallOrder(t assert.TestingT, methods ...string) bool {
+	next := 0
+	for _, call := range s.Calls() {
+		if next < len(methods) && call.Method == methods[next] {
+			next++
+		}
+	}
+	if next < len(methods) {
+		return assert.Fail(t, fmt.Sprintf("expected calls in order %v, %s was not called after %v", methods, methods[next], methods[:next]), s.callLog())
+	}
+	return true
+}
+
+func (s *Spy) callLog() string {
+	calls := s.Calls()
+	if len(calls) == 0 {
+		return "no calls were recorded"
+	}
+	lines := make([]string, len(calls))
+	for idx, call := range calls {
+		lines[idx] = call.String()
+	}
+	return "recorded calls:\n" + strings.Join(lines, "\n")
+}
+
+func argsMatch(expect []any, actual []any) bool {
+	if len(expect) != len(actual) {
+		return false
+	}
+	for idx := range expect {
+		if expect[idx] != Anything && !assert.ObjectsAreEqual(expect[idx], actual[idx]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package ghxtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bevicted/ghx"
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testingT records assertion failures instead of failing the test
+type testingT struct {
+	errors []string
+}
+
+func (t *testingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestSpy(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	f := &ghx.IssuesServiceF{
+		AddLabelsToIssue: func(_ context.Context, _ string, _ string, _ int, labels []string) ([]*github.Label, *github.Response, error) {
+			return []*github.Label{{Name: &labels[0]}}, nil, nil
+		},
+		ListByRepo: func(context.Context, string, string, *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+			return NewEmptyIssues(t, 2), &github.Response{}, nil
+		},
+	}
+	spy := NewSpy(t, f)
+	issues := ghx.NewIssuesService(f)
+
+	labels, _, err := issues.AddLabelsToIssue(testCtx, "o", "r", 42, []string{"bug"})
+	require.NoError(t, err)
+	assert.Equal(t, "bug", labels[0].GetName(), "calls reach the wrapped function")
+	require.NoError(t, issues.MapByRepo(testCtx, "o", "r", nil, func(*github.Issue) error { return nil }))
+
+	spy.AssertCalled(t, "AddLabelsToIssue", "o", "r", 42, []string{"bug"})
+	spy.AssertCalledTimes(t, 1, "AddLabelsToIssue", "o", "r", 42, []string{"bug"})
+	spy.AssertCalled(t, "ListByRepo", "o", "r", Anything)
+	spy.AssertNotCalled(t, "Edit")
+	spy.AssertNotCalled(t, "AddLabelsToIssue", "o", "r", 43, []string{"bug"})
+	spy.AssertCallOrder(t, "AddLabelsToIssue", "ListByRepo")
+	spy.AssertNotCalled(t, "MapByRepo")
+	assert.Len(t, spy.Calls(), 2, "the calls unset extensions make are recorded")
+
+	stubbed := &ghx.IssuesServiceF{
+		MapByRepo: func(context.Context, string, string, *github.IssueListByRepoOptions, ghx.IssueHandler, ...ghx.PaginatorOption) error {
+			return nil
+		},
+	}
+	stubbedSpy := NewSpy(t, stubbed)
+	require.NoError(t, ghx.NewIssuesService(stubbed).MapByRepo(testCtx, "o", "r", nil, nil))
+	stubbedSpy.AssertCalledTimes(t, 1, "MapByRepo", "o", "r", Anything, Anything, Anything)
+
+	mockT := &testingT{}
+	assert.False(t, spy.AssertCalled(mockT, "AddLabelsToIssue", "o", "r", 42, []string{"wontfix"}))
+	assert.False(t, spy.AssertCalledTimes(mockT, 2, "AddLabelsToIssue"))
+	assert.False(t, spy.AssertNotCalled(mockT, "ListByRepo"))
+	assert.False(t, spy.AssertCallOrder(mockT, "ListByRepo", "AddLabelsToIssue"))
+	require.Len(t, mockT.errors, 4)
+	assert.Contains(t, mockT.errors[0], `AddLabelsToIssue("o", "r", 42, []string{"bug"})`, "failures list the recorded calls")
+}
+
+func TestSpyUnsetFunction(t *testing.T) {
+	t.Parallel()
+
+	f := &ghx.IssuesServiceF{}
+	spy := NewSpy(t, f)
+	var failures []string
+	spy.errorf = func(format string, args ...any) {
+		failures = append(failures, fmt.Sprintf(format, args...))
+	}
+
+	issue, resp, err := ghx.NewIssuesService(f).Get(context.Background(), "o", "r", 1)
+	require.ErrorIs(t, err, ErrNotStubbed)
+	assert.Nil(t, issue)
+	assert.Nil(t, resp)
+	require.Len(t, failures, 1)
+	assert.Contains(t, failures[0], "IssuesServiceF.Get called but not set")
+	spy.AssertCalledTimes(t, 1, "Get", "o", "r", 1)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const extendDirective = "//ghxgen:extend "
+
+// loadExtensions parses the hand-written go files of dir for constructors marked with
+// //ghxgen:extend XxxService.Method and returns them by service name, along with the
+// import paths their signatures need
+func loadExtensions(dir string, output string) (map[string][]extension, []string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	extensions := map[string][]extension{}
+	imports := map[string]bool{}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == filepath.Base(output) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+			for _, c := range fn.Doc.List {
+				target, ok := strings.CutPrefix(c.Text, extendDirective)
+				if !ok {
+					continue
+				}
+				serviceName, methodName, ok := strings.Cut(strings.TrimSpace(target), ".")
+				if !ok {
+					return nil, nil, fmt.Errorf("%s: malformed directive %q", fset.Position(c.Pos()), c.Text)
+				}
+				ext, err := newExtension(fset, f, fn, methodName, imports)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: %w", fset.Position(fn.Pos()), err)
+				}
+				extensions[serviceName] = append(extensions[serviceName], ext)
+			}
+		}
+	}
+
+	for _, exts := range extensions {
+		sort.Slice(exts, func(i, j int) bool { return exts[i].Name < exts[j].Name })
+	}
+	importPaths := make([]string, 0, len(imports))
+	for path := range imports {
+		importPaths = append(importPaths, path)
+	}
+	return extensions, importPaths, nil
+}
+
+func newExtension(fset *token.FileSet, f *ast.File, fn *ast.FuncDecl, name string, imports map[string]bool) (extension, error) {
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return extension{}, fmt.Errorf("%s must return exactly one func", fn.Name.Name)
+	}
+	funcType, ok := fn.Type.Results.List[0].Type.(*ast.FuncType)
+	if !ok {
+		return extension{}, fmt.Errorf("%s must return a func literal type", fn.Name.Name)
+	}
+
+	var args []string
+	for _, field := range funcType.Params.List {
+		if len(field.Names) == 0 {
+			return extension{}, fmt.Errorf("%s must name the parameters of the func it returns", fn.Name.Name)
+		}
+		for _, n := range field.Names {
+			arg := n.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, funcType); err != nil {
+		return extension{}, err
+	}
+	sig := strings.TrimPrefix(buf.String(), "func")
+	params, results := splitSignature(sig)
+
+	fileImports := map[string]string{}
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		pkgName := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			pkgName = spec.Name.Name
+		}
+		fileImports[pkgName] = path
+	}
+	ast.Inspect(funcType, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				if path, ok := fileImports[x.Name]; ok {
+					imports[path] = true
+				}
+			}
+		}
+		return true
+	})
+
+	return extension{
+		method: method{
+			Name:    name,
+			Params:  params,
+			Results: results,
+			Args:    strings.Join(args, ", "),
+			Returns: funcType.Results != nil && len(funcType.Results.List) > 0,
+		},
+		Constructor: fn.Name.Name,
+	}, nil
+}
+
+// splitSignature splits "(a int) (b, error)" at the parenthesis closing the parameter list
+func splitSignature(sig string) (string, string) {
+	var depth int
+	for i, r := range sig {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return sig[:i+1], strings.TrimSpace(sig[i+1:])
+			}
+		default:
+		}
+	}
+	return sig, ""
+}
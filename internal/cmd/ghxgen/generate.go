@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type service struct {
+	Field    string // field on github.Client, e.g. Issues
+	Name     string // service type name, e.g. IssuesService
+	Receiver string
+	Methods  []method
+	Extras   []extension
+}
+
+type method struct {
+	Name    string
+	Params  string // (ctx context.Context, owner string)
+	Results string // (*github.Issue, *github.Response, error)
+	Args    string // ctx, owner
+	Returns bool
+}
+
+type extension struct {
+	method
+	Constructor string
+}
+
+type file struct {
+	StdImports []string
+	Imports    []string
+	Services   []*service
+}
+
+// generate renders the wrappers of every service in pkgPath, adding the
+// extensions declared in the non-generated go files of dir
+func generate(dir string, pkgPath string, output string) ([]byte, error) {
+	imports := map[string]bool{pkgPath: true}
+	qualifier := func(p *types.Package) string {
+		imports[p.Path()] = true
+		return p.Name()
+	}
+
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(pkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", pkgPath, err)
+	}
+
+	services, err := loadServices(pkg, qualifier)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, extImports, err := loadExtensions(dir, output)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range extImports {
+		imports[path] = true
+	}
+	byName := make(map[string]*service, len(services))
+	for _, s := range services {
+		byName[s.Name] = s
+	}
+	for name, exts := range extensions {
+		s, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("extension of unknown service %s", name)
+		}
+		s.Extras = exts
+	}
+	for _, s := range services {
+		s.Receiver = receiverName(s)
+	}
+
+	f := file{Services: services}
+	for path := range imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			f.Imports = append(f.Imports, path)
+		} else {
+			f.StdImports = append(f.StdImports, path)
+		}
+	}
+	sort.Strings(f.StdImports)
+	sort.Strings(f.Imports)
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, f); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// loadServices collects every *XxxService field of github.Client along with its exported methods
+func loadServices(pkg *types.Package, qualifier types.Qualifier) ([]*service, error) {
+	obj := pkg.Scope().Lookup("Client")
+	if obj == nil {
+		return nil, fmt.Errorf("%s has no Client type", pkg.Path())
+	}
+	client, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s.Client is not a struct", pkg.Path())
+	}
+
+	var services []*service
+	for i := range client.NumFields() {
+		field := client.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		ptr, ok := field.Type().(*types.Pointer)
+		if !ok {
+			continue
+		}
+		named, ok := ptr.Elem().(*types.Named)
+		if !ok || named.Obj().Pkg() != pkg || !strings.HasSuffix(named.Obj().Name(), "Service") {
+			continue
+		}
+
+		s := &service{Field: field.Name(), Name: named.Obj().Name()}
+		mset := types.NewMethodSet(ptr)
+		for j := range mset.Len() {
+			fn := mset.At(j).Obj()
+			if !fn.Exported() {
+				continue
+			}
+			s.Methods = append(s.Methods, newMethod(fn.Name(), fn.Type().(*types.Signature), qualifier))
+		}
+		services = append(services, s)
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("%s.Client has no service fields", pkg.Path())
+	}
+	return services, nil
+}
+
+func newMethod(name string, sig *types.Signature, qualifier types.Qualifier) method {
+	m := method{Name: name, Returns: sig.Results().Len() > 0}
+
+	params := make([]string, sig.Params().Len())
+	args := make([]string, sig.Params().Len())
+	for i := range sig.Params().Len() {
+		p := sig.Params().At(i)
+		paramName := p.Name()
+		if paramName == "" || paramName == "_" {
+			paramName = fmt.Sprintf("arg%d", i)
+		}
+		typ := types.TypeString(p.Type(), qualifier)
+		args[i] = paramName
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + types.TypeString(p.Type().(*types.Slice).Elem(), qualifier)
+			args[i] += "..."
+		}
+		params[i] = paramName + " " + typ
+	}
+	m.Params = "(" + strings.Join(params, ", ") + ")"
+	m.Args = strings.Join(args, ", ")
+
+	results := make([]string, sig.Results().Len())
+	for i := range sig.Results().Len() {
+		results[i] = types.TypeString(sig.Results().At(i).Type(), qualifier)
+	}
+	switch len(results) {
+	case 0:
+	case 1:
+		m.Results = results[0]
+	default:
+		m.Results = "(" + strings.Join(results, ", ") + ")"
+	}
+	return m
+}
+
+// receiverName picks the lowercased initial of the service unless a parameter already uses it
+func receiverName(s *service) string {
+	name := strings.ToLower(s.Name[:1])
+	for _, methods := range [][]method{s.Methods, extensionMethods(s.Extras)} {
+		for _, m := range methods {
+			for _, arg := range strings.Split(m.Args, ", ") {
+				if strings.TrimSuffix(arg, "...") == name {
+					return "svc"
+				}
+			}
+		}
+	}
+	return name
+}
+
+func extensionMethods(exts []extension) []method {
+	methods := make([]method, len(exts))
+	for i, e := range exts {
+		methods[i] = e.method
+	}
+	return methods
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by ghxgen. DO NOT EDIT.
+
+package ghx
+
+import (
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
+{{range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+type Client struct {
+	*github.Client
+{{range .Services}}
+	{{.Field}} *{{.Name}}
+{{- end}}
+}
+
+// NewClient returns a new *ghx.Client with all calls routed to *github.Client
+func NewClient(client *github.Client) *Client {
+	return &Client{
+		Client: client,
+{{- range .Services}}
+		{{.Field}}: new{{.Name}}Passthrough(client),
+{{- end}}
+	}
+}
+{{range $s := .Services}}
+type {{.Name}}F struct {
+{{- range .Methods}}
+	{{.Name}} func{{.Params}} {{.Results}}
+{{- end}}
+{{- if .Extras}}
+{{range .Extras}}
+	{{.Name}} func{{.Params}} {{.Results}} ` + "`ghx:\"extension\"`" + `
+{{- end}}
+{{- end}}
+}
+
+type {{.Name}} struct {
+	f *{{.Name}}F
+}
+{{range .Methods}}
+func ({{$s.Receiver}} *{{$s.Name}}) {{.Name}}{{.Params}} {{.Results}} {
+	{{if .Returns}}return {{end}}{{$s.Receiver}}.f.{{.Name}}({{.Args}})
+}
+{{- end}}
+{{range .Extras}}
+func ({{$s.Receiver}} *{{$s.Name}}) {{.Name}}{{.Params}} {{.Results}} {
+	{{if .Returns}}return {{end}}{{$s.Receiver}}.f.{{.Name}}({{.Args}})
+}
+{{- end}}
+
+// New{{.Name}} routes all calls to a copy of f, later changes to f do not reach the service. A nil f is an empty table.
+{{- if .Extras}}
+// Nil extension fields, tagged ghx:"extension", default to their implementation on top of the copy.
+{{- end}}
+func New{{.Name}}(f *{{.Name}}F) *{{.Name}} {
+	var table {{.Name}}F
+	if f != nil {
+		table = *f
+	}
+{{- if .Extras}}
+	{{.Receiver}} := &{{.Name}}{f: &table}
+{{- range .Extras}}
+	if table.{{.Name}} == nil {
+		table.{{.Name}} = {{.Constructor}}({{$s.Receiver}})
+	}
+{{- end}}
+	return {{.Receiver}}
+{{- else}}
+	return &{{.Name}}{f: &table}
+{{- end}}
+}
+
+func new{{.Name}}Passthrough(client *github.Client) *{{.Name}} {
+	return New{{.Name}}(&{{.Name}}F{
+{{- range .Methods}}
+		{{.Name}}: client.{{$s.Field}}.{{.Name}},
+{{- end}}
+	})
+}
+{{end}}`))
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	t.Parallel()
+
+	const root = "../../.."
+
+	expect, err := os.ReadFile(filepath.Join(root, "client_gen.go"))
+	require.NoError(t, err)
+
+	actual, err := generate(root, defaultPkgPath, "client_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(expect), string(actual), "client_gen.go is stale, run go generate ./...")
+}
+
+func TestSplitSignature(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		sig           string
+		expectParams  string
+		expectResults string
+	}{
+		{
+			sig:          "(ctx context.Context)",
+			expectParams: "(ctx context.Context)",
+		},
+		{
+			sig:           "(ctx context.Context, handle func(*github.Issue) error) error",
+			expectParams:  "(ctx context.Context, handle func(*github.Issue) error)",
+			expectResults: "error",
+		},
+		{
+			sig:           "(ctx context.Context) (*github.Issue, error)",
+			expectParams:  "(ctx context.Context)",
+			expectResults: "(*github.Issue, error)",
+		},
+	} {
+		tc := tc
+		t.Run(tc.sig, func(t *testing.T) {
+			t.Parallel()
+
+			params, results := splitSignature(tc.sig)
+			assert.Equal(t, tc.expectParams, params)
+			assert.Equal(t, tc.expectResults, results)
+		})
+	}
+}
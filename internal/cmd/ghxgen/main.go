@@ -0,0 +1,33 @@
+// ghxgen generates the ghx service wrappers from the go-github service types.
+//
+// Every exported *XxxService field of github.Client becomes an XxxServiceF function table,
+// an XxxService forwarding its methods to that table, NewXxxService and newXxxServicePassthrough.
+// Hand-written helpers are added to a service by marking their constructor with
+//
+//	//ghxgen:extend XxxService.Method
+//
+// the constructor must take the service and return the func that backs Method.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+const defaultPkgPath = "github.com/google/go-github/v62/github"
+
+func main() {
+	pkgPath := flag.String("pkg", defaultPkgPath, "import path of the go-github package to wrap")
+	output := flag.String("o", "client_gen.go", "file to write the generated code to")
+	flag.Parse()
+
+	src, err := generate(".", *pkgPath, *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(fmt.Errorf("writing %s: %w", *output, err))
+	}
+}
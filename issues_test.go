@@ -118,7 +118,9 @@ func TestMapIssuesOfRepo(t *testing.T) {
 					assert.Equal(t, testCtx, actualCtx)
 					assert.Equal(t, testOwner, actualOwner)
 					assert.Equal(t, testRepo, actualRepo)
-					assert.Same(t, opts, actualOpts)
+					assert.Equal(t, opts.Milestone, actualOpts.Milestone)
+					assert.Equal(t, opts.State, actualOpts.State)
+					assert.Equal(t, opts.Assignee, actualOpts.Assignee)
 					require.Less(t, listByRepoCallCount, len(tc.listByRepoReturnValues), "ListByRepo called more times than it has return values mocked")
 					rv := tc.listByRepoReturnValues[listByRepoCallCount]
 					listByRepoCallCount++
@@ -137,3 +139,161 @@ func TestMapIssuesOfRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestMapIssueSubresources(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testOwner  = "testOwner"
+		testRepo   = "testRepo"
+		testNumber = 42
+	)
+	testCtx := context.Background()
+
+	t.Run("MapComments", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.IssueComment{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewIssuesService(&IssuesServiceF{
+			ListComments: func(_ context.Context, actualOwner string, actualRepo string, actualNumber int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testNumber, actualNumber)
+				assert.Equal(t, "asc", *opts.Direction)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.IssueComment
+		require.NoError(t, newMapCommentsF(s)(testCtx, testOwner, testRepo, testNumber, &github.IssueListCommentsOptions{Direction: PTR("asc")}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapIssueTimeline", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.Timeline{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewIssuesService(&IssuesServiceF{
+			ListIssueTimeline: func(_ context.Context, actualOwner string, actualRepo string, actualNumber int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testNumber, actualNumber)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.Timeline
+		require.NoError(t, newMapIssueTimelineF(s)(testCtx, testOwner, testRepo, testNumber, &github.ListOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+}
+
+func TestMapByRepoOptions(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	var pagesAsked []int
+	var perPages []int
+	pages := servePages(t, ghxtest.NewEmptyIssues(t, 2), ghxtest.NewEmptyIssues(t, 1))
+	mapByRepo := newMapByRepoF(NewIssuesService(&IssuesServiceF{
+		ListByRepo: func(_ context.Context, _ string, _ string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+			pagesAsked = append(pagesAsked, opts.Page)
+			perPages = append(perPages, opts.PerPage)
+			return pages(opts.Page)
+		},
+	}))
+	noop := func(*github.Issue) error { return nil }
+
+	require.NotPanics(t, func() {
+		require.NoError(t, mapByRepo(testCtx, "owner", "repo", nil, noop))
+	})
+	assert.Equal(t, []int{MaxPerPage, MaxPerPage}, perPages, "nil opts default to MaxPerPage")
+
+	opts := &github.IssueListByRepoOptions{State: "open", ListOptions: github.ListOptions{PerPage: 2}}
+	expectOpts := *opts
+	pagesAsked = nil
+	require.NoError(t, mapByRepo(testCtx, "owner", "repo", opts, noop))
+	require.NoError(t, mapByRepo(testCtx, "owner", "repo", opts, noop))
+	assert.Equal(t, expectOpts, *opts, "caller's opts must not be mutated")
+	assert.Equal(t, []int{0, 2, 0, 2}, pagesAsked, "reused opts must start from the first page again")
+}
+
+func TestSetState(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	for _, tc := range []struct {
+		name         string
+		set          func(s *IssuesService) error
+		expectState  string
+		expectReason string
+		expectErr    error
+	}{
+		{
+			name: "ok - close as not planned",
+			set: func(s *IssuesService) error {
+				_, _, err := newCloseF(s)(testCtx, "owner", "repo", 1, StateReasonNotPlanned)
+				return err
+			},
+			expectState:  "closed",
+			expectReason: "not_planned",
+		},
+		{
+			name: "ok - reopen",
+			set: func(s *IssuesService) error {
+				_, _, err := newReopenF(s)(testCtx, "owner", "repo", 1)
+				return err
+			},
+			expectState:  "open",
+			expectReason: "reopened",
+		},
+		{
+			name: "err - close as reopened",
+			set: func(s *IssuesService) error {
+				_, _, err := newCloseF(s)(testCtx, "owner", "repo", 1, StateReasonReopened)
+				return err
+			},
+			expectErr: ErrUnknownStateReason,
+		},
+		{
+			name: "err - state all",
+			set: func(s *IssuesService) error {
+				_, _, err := newSetStateF(s)(testCtx, "owner", "repo", 1, StateAll, StateReasonCompleted)
+				return err
+			},
+			expectErr: ErrUnknownState,
+		},
+		{
+			name: "err - unknown state",
+			set: func(s *IssuesService) error {
+				_, _, err := newSetStateF(s)(testCtx, "owner", "repo", 1, State(-1), StateReasonReopened)
+				return err
+			},
+			expectErr: ErrUnknownState,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var edited *github.IssueRequest
+			s := NewIssuesService(&IssuesServiceF{
+				Edit: func(_ context.Context, _ string, _ string, _ int, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+					edited = issue
+					return &github.Issue{}, &github.Response{}, nil
+				},
+			})
+			err := tc.set(s)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				assert.Nil(t, edited, "invalid states must not be sent")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectState, edited.GetState())
+			assert.Equal(t, tc.expectReason, edited.GetStateReason())
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package ghx
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type memberLister interface {
+	ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error)
+}
+
+//ghxgen:extend OrganizationsService.MapMembers
+func newMapMembersF(organizationsService memberLister) func(ctx context.Context, org string, opts *github.ListMembersOptions, handle UserHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, org string, opts *github.ListMembersOptions, handle UserHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.ListMembersOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+			return organizationsService.ListMembers(ctx, org, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
@@ -0,0 +1,30 @@
+package ghx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapMembers(t *testing.T) {
+	t.Parallel()
+
+	const testOrg = "testOrg"
+
+	expect := []*github.User{{Login: PTR("a")}, {Login: PTR("b")}, {Login: PTR("c")}}
+	pages := servePages(t, expect[:2], expect[2:])
+	s := NewOrganizationsService(&OrganizationsServiceF{
+		ListMembers: func(_ context.Context, actualOrg string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+			assert.Equal(t, testOrg, actualOrg)
+			assert.Equal(t, "admin", opts.Role)
+			return pages(opts.Page)
+		},
+	})
+
+	var actual []*github.User
+	require.NoError(t, newMapMembersF(s)(context.Background(), testOrg, &github.ListMembersOptions{Role: "admin"}, collect(&actual)))
+	assert.Equal(t, expect, actual)
+}
@@ -0,0 +1,346 @@
+package ghx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// ListFunc fetches a single page, page 0 being the first page
+type ListFunc[T any] func(ctx context.Context, page int) ([]T, *github.Response, error)
+
+type paginatorConfig struct {
+	prefetch   int
+	retry      *RetryPolicy
+	resume     *Cursor
+	checkpoint func(Cursor) error
+	query      string
+	opts       any
+	incomplete IncompleteResultsPolicy
+}
+
+type PaginatorOption func(*paginatorConfig)
+
+func newPaginatorConfig(options []PaginatorOption) paginatorConfig {
+	var c paginatorConfig
+	for _, option := range options {
+		option(&c)
+	}
+	return c
+}
+
+// WithPrefetch fetches the pages after the first one with up to concurrency requests in flight,
+// once the first response reveals the last page through its Link header.
+// Items are still handled in page order.
+func WithPrefetch(concurrency int) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.prefetch = concurrency
+	}
+}
+
+// copyOptions copies opts so that paginating never writes to the caller's value, nil opts fall back to defaults
+func copyOptions[O any](opts *O, defaults *O) *O {
+	if opts == nil {
+		return defaults
+	}
+	c := *opts
+	return &c
+}
+
+// listPages prepares a page-numbered list call for pagination. opts is copied, nil opts defaulting to MaxPerPage items
+// per page, and the returned page function lists every page with its own copy so that paginating never writes to the
+// caller's value. listOptions returns the github.ListOptions of opts, either opts itself or its embedded ListOptions.
+func listPages[O any, R any](opts *O, listOptions func(*O) *github.ListOptions, list func(ctx context.Context, opts *O) (R, *github.Response, error)) (*O, func(ctx context.Context, page int) (R, *github.Response, error)) {
+	defaults := new(O)
+	listOptions(defaults).PerPage = MaxPerPage
+	opts = copyOptions(opts, defaults)
+	return opts, func(ctx context.Context, page int) (R, *github.Response, error) {
+		opts := *opts
+		listOptions(&opts).Page = page
+		return list(ctx, &opts)
+	}
+}
+
+// ownListOptions is the listOptions of listPages for plain github.ListOptions
+func ownListOptions(opts *github.ListOptions) *github.ListOptions {
+	return opts
+}
+
+// Paginator drives a ListFunc through every page by following github.Response.NextPage
+type Paginator[T any] struct {
+	paginatorConfig
+	page int
+	list ListFunc[T]
+}
+
+// Paginate returns a Paginator over list starting at page
+func Paginate[T any](page int, list ListFunc[T], options ...PaginatorOption) *Paginator[T] {
+	return &Paginator[T]{paginatorConfig: newPaginatorConfig(options), page: page, list: list}
+}
+
+// PaginateWrapped returns a Paginator over list functions whose items are wrapped in a result struct,
+// e.g. github.IssuesSearchResult.Issues or github.Runners.Runners
+func PaginateWrapped[R any, T any](page int, list func(ctx context.Context, page int) (R, *github.Response, error), unwrap func(R) []T, options ...PaginatorOption) *Paginator[T] {
+	return Paginate(page, func(ctx context.Context, page int) ([]T, *github.Response, error) {
+		result, resp, err := list(ctx, page)
+		if err != nil {
+			return nil, resp, err
+		}
+		return unwrap(result), resp, nil
+	}, options...)
+}
+
+// Iter yields every item of every page, stopping after yielding the first error.
+// A checkpoint failing once the loop stopped early cannot be yielded anymore, Map and All return it instead.
+func (p *Paginator[T]) Iter(ctx context.Context) iter.Seq2[T, error] {
+	return p.iterate(ctx, nil)
+}
+
+// iterate implements Iter, storing the checkpoint error of a loop stopped early into stopErr, if not nil
+func (p *Paginator[T]) iterate(ctx context.Context, stopErr *error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		it := &iteration[T]{Paginator: p, yield: yield, stopErr: stopErr}
+		page := p.page
+		if p.resume != nil {
+			if p.resume.Done {
+				return
+			}
+			page = p.resume.Page
+			it.skipID, it.skip = p.resume.LastID, p.resume.Offset
+		}
+
+		for {
+			items, resp, err := p.fetch(ctx, page)
+			if err != nil {
+				it.fail(page, err)
+				return
+			}
+			next := 0
+			if resp != nil {
+				next = resp.NextPage
+			}
+			if !it.page(page, items, next) || next == 0 {
+				return
+			}
+			page = next
+
+			if p.prefetch > 0 && resp.LastPage >= next {
+				next, ok := it.prefetchPages(ctx, next, resp.LastPage)
+				if !ok || next == 0 {
+					return
+				}
+				page = next
+			}
+		}
+	}
+}
+
+// fetch lists a single page, retrying it if the paginator has a RetryPolicy
+func (p *Paginator[T]) fetch(ctx context.Context, page int) ([]T, *github.Response, error) {
+	if p.retry == nil {
+		return p.list(ctx, page)
+	}
+	var (
+		items []T
+		resp  *github.Response
+	)
+	err := p.retry.Do(ctx, func() error {
+		var err error
+		items, resp, err = p.list(ctx, page)
+		return err
+	})
+	return items, resp, err
+}
+
+// iteration holds the state of a single Iter run
+type iteration[T any] struct {
+	*Paginator[T]
+	yield func(T, error) bool
+	// skipID is the ID of the last item handled before resuming, items up to and including it are skipped
+	skipID int64
+	// skip is the number of items handled before resuming, skipped if skipID is not set
+	skip int
+	// stopErr receives the checkpoint error of a loop stopped early, which must not be yielded
+	stopErr *error
+}
+
+// page yields the items of a fetched page and checkpoints the next one, returning whether iteration should go on
+func (it *iteration[T]) page(page int, items []T, next int) bool {
+	var (
+		lastID  int64
+		handled int
+	)
+	switch {
+	case it.skipID != 0:
+		for idx, item := range items {
+			if itemID(item) == it.skipID {
+				items = items[idx+1:]
+				lastID, handled = it.skipID, idx+1
+				break
+			}
+		}
+	case it.skip > 0:
+		handled = min(it.skip, len(items))
+		items = items[handled:]
+	}
+	it.skipID, it.skip = 0, 0
+
+	for _, item := range items {
+		if !it.yield(item, nil) {
+			if err := it.checkpointCursor(Cursor{Page: page, LastID: lastID, Offset: handled}); err != nil && it.stopErr != nil {
+				*it.stopErr = err
+			}
+			return false
+		}
+		lastID = itemID(item)
+		handled++
+	}
+	if err := it.checkpointCursor(Cursor{Page: next, Done: next == 0}); err != nil {
+		var zero T
+		it.yield(zero, err)
+		return false
+	}
+	return true
+}
+
+// fail checkpoints the page that failed and yields err
+func (it *iteration[T]) fail(page int, err error) {
+	if cpErr := it.checkpointCursor(Cursor{Page: page, LastID: it.skipID, Offset: it.skip}); cpErr != nil {
+		err = errors.Join(err, cpErr)
+	}
+	var zero T
+	it.yield(zero, err)
+}
+
+// checkpointCursor stamps the scope of the pagination onto c and reports it to the checkpoint, if any
+func (it *iteration[T]) checkpointCursor(c Cursor) error {
+	if it.checkpoint == nil {
+		return nil
+	}
+	c.Query = it.query
+	if it.opts != nil {
+		opts, err := json.Marshal(it.opts)
+		if err != nil {
+			return fmt.Errorf("encoding cursor options: %w", err)
+		}
+		c.Options = opts
+	}
+	if err := it.checkpoint(c); err != nil {
+		return fmt.Errorf("checkpoint: %w", err)
+	}
+	return nil
+}
+
+type pageResult[T any] struct {
+	items []T
+	resp  *github.Response
+	err   error
+}
+
+// prefetchPages concurrently fetches pages first through last and yields their items in order.
+// It returns the page following last, if any, and whether iteration should go on.
+func (it *iteration[T]) prefetchPages(ctx context.Context, first int, last int) (int, bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	results := make([]chan pageResult[T], last-first+1)
+	for i := range results {
+		results[i] = make(chan pageResult[T], 1)
+	}
+	slots := make(chan struct{}, it.prefetch)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range results {
+			if ctx.Err() == nil {
+				select {
+				case slots <- struct{}{}:
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						items, resp, err := it.fetch(ctx, first+i)
+						results[i] <- pageResult[T]{items: items, resp: resp, err: err}
+					}()
+					continue
+				case <-ctx.Done():
+				}
+			}
+			results[i] <- pageResult[T]{err: ctx.Err()}
+		}
+	}()
+
+	var next int
+	for i := range results {
+		r := <-results[i]
+		if r.err != nil {
+			cancel()
+			errs := []error{r.err}
+			for _, rest := range results[i+1:] {
+				if r := <-rest; r.err != nil && !isContextErr(r.err) {
+					errs = append(errs, r.err)
+				}
+			}
+			if len(errs) == 1 {
+				it.fail(first+i, errs[0])
+			} else {
+				it.fail(first+i, errors.Join(errs...))
+			}
+			return 0, false
+		}
+		<-slots
+		next = 0
+		if r.resp != nil {
+			next = r.resp.NextPage
+		}
+		if !it.page(first+i, r.items, next) {
+			return 0, false
+		}
+	}
+	return next, true
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Map calls handle with every item of every page, stopping at the first error.
+// If the checkpoint fails after handle did, both errors are returned.
+func (p *Paginator[T]) Map(ctx context.Context, handle func(T) error) (err error) {
+	var stopErr error
+	defer func() {
+		if stopErr != nil {
+			err = errors.Join(err, stopErr)
+		}
+	}()
+	for item, err := range p.iterate(ctx, &stopErr) {
+		if err != nil {
+			return err
+		}
+		if err := handle(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All collects every item of every page, returning the items collected so far alongside any error
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for item, err := range p.Iter(ctx) {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
@@ -0,0 +1,411 @@
+package ghx
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type listReturnValue struct {
+	Items []int
+	Res   *github.Response
+	Err   error
+}
+
+// newTestListFunc mocks a ListFunc serving rvs in order, asserting that each call asks for the expected page
+func newTestListFunc(t *testing.T, startPage int, rvs []listReturnValue) ListFunc[int] {
+	t.Helper()
+
+	var callCount int
+	expectPage := startPage
+	return func(_ context.Context, page int) ([]int, *github.Response, error) {
+		require.Less(t, callCount, len(rvs), "list called more times than it has return values mocked")
+		assert.Equal(t, expectPage, page)
+		rv := rvs[callCount]
+		callCount++
+		if rv.Res != nil {
+			expectPage = rv.Res.NextPage
+		}
+		return rv.Items, rv.Res, rv.Err
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	for _, tc := range []struct {
+		name             string
+		startPage        int
+		handlerErr       error
+		expectItems      []int
+		expectErr        error
+		listReturnValues []listReturnValue
+	}{
+		{
+			name: "ok - 0 items",
+			listReturnValues: []listReturnValue{
+				{
+					Res: &github.Response{},
+				},
+			},
+		},
+		{
+			name: "ok - nil response ends pagination",
+			listReturnValues: []listReturnValue{
+				{
+					Items: []int{1},
+				},
+			},
+			expectItems: []int{1},
+		},
+		{
+			name: "ok - 3 pages, 5 items",
+			listReturnValues: []listReturnValue{
+				{
+					Items: []int{1, 2},
+					Res:   &github.Response{NextPage: 2},
+				},
+				{
+					Items: []int{3, 4},
+					Res:   &github.Response{NextPage: 3},
+				},
+				{
+					Items: []int{5},
+					Res:   &github.Response{},
+				},
+			},
+			expectItems: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "ok - starts from the configured page",
+			startPage: 4,
+			listReturnValues: []listReturnValue{
+				{
+					Items: []int{7, 8},
+					Res:   &github.Response{NextPage: 5},
+				},
+				{
+					Items: []int{9},
+					Res:   &github.Response{},
+				},
+			},
+			expectItems: []int{7, 8, 9},
+		},
+		{
+			name: "err - listErr on page 2",
+			listReturnValues: []listReturnValue{
+				{
+					Items: []int{1, 2},
+					Res:   &github.Response{NextPage: 2},
+				},
+				{
+					Err: errors.New("listErr"),
+				},
+			},
+			expectItems: []int{1, 2},
+			expectErr:   errors.New("listErr"),
+		},
+		{
+			name: "err - handlerErr fails before listErr",
+			listReturnValues: []listReturnValue{
+				{
+					Items: []int{1, 2},
+					Res:   &github.Response{NextPage: 2},
+				},
+				{
+					Err: errors.New("listErr"),
+				},
+			},
+			handlerErr: errors.New("handlerErr"),
+			expectErr:  errors.New("handlerErr"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("Map", func(t *testing.T) {
+				t.Parallel()
+
+				var items []int
+				assert.Equal(t, tc.expectErr, Paginate(tc.startPage, newTestListFunc(t, tc.startPage, tc.listReturnValues)).Map(testCtx, func(i int) error {
+					if tc.handlerErr != nil {
+						return tc.handlerErr
+					}
+					items = append(items, i)
+					return nil
+				}))
+				assert.Equal(t, tc.expectItems, items)
+			})
+
+			if tc.handlerErr != nil {
+				return
+			}
+
+			t.Run("All", func(t *testing.T) {
+				t.Parallel()
+
+				items, err := Paginate(tc.startPage, newTestListFunc(t, tc.startPage, tc.listReturnValues)).All(testCtx)
+				assert.Equal(t, tc.expectErr, err)
+				assert.Equal(t, tc.expectItems, items)
+			})
+
+			t.Run("Iter", func(t *testing.T) {
+				t.Parallel()
+
+				var (
+					items []int
+					errs  []error
+				)
+				for item, err := range Paginate(tc.startPage, newTestListFunc(t, tc.startPage, tc.listReturnValues)).Iter(testCtx) {
+					if err != nil {
+						errs = append(errs, err)
+						continue
+					}
+					items = append(items, item)
+				}
+				assert.Equal(t, tc.expectItems, items)
+				if tc.expectErr != nil {
+					assert.Equal(t, []error{tc.expectErr}, errs)
+				} else {
+					assert.Empty(t, errs)
+				}
+			})
+		})
+	}
+}
+
+func TestPaginatorIterBreak(t *testing.T) {
+	t.Parallel()
+
+	p := Paginate(0, newTestListFunc(t, 0, []listReturnValue{
+		{
+			Items: []int{1, 2},
+			Res:   &github.Response{NextPage: 2},
+		},
+	}))
+
+	var items []int
+	for item, err := range p.Iter(context.Background()) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, items, "breaking must not fetch the next page")
+}
+
+// newPrefetchListFunc serves lastPage pages of pageSize items, answering later pages faster
+// so that out of order completion is exercised, and tracks the peak of concurrent calls
+func newPrefetchListFunc(t *testing.T, lastPage int, pageSize int, pageErrs map[int]error) (ListFunc[int], *int) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+	)
+	return func(ctx context.Context, page int) ([]int, *github.Response, error) {
+		if page == 0 {
+			page = 1
+		}
+		require.LessOrEqual(t, page, lastPage)
+
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		select {
+		case <-time.After(time.Duration(lastPage-page) * time.Millisecond):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		if err := pageErrs[page]; err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]int, pageSize)
+		for i := range items {
+			items[i] = (page-1)*pageSize + i
+		}
+		resp := &github.Response{LastPage: lastPage}
+		if page < lastPage {
+			resp.NextPage = page + 1
+		}
+		return items, resp, nil
+	}, &peak
+}
+
+func TestPaginatorPrefetch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		lastPage    = 10
+		pageSize    = 3
+		concurrency = 3
+	)
+	testCtx := context.Background()
+
+	t.Run("ok - handles items in page order", func(t *testing.T) {
+		t.Parallel()
+
+		list, peak := newPrefetchListFunc(t, lastPage, pageSize, nil)
+		items, err := Paginate(0, list, WithPrefetch(concurrency)).All(testCtx)
+		require.NoError(t, err)
+		require.Len(t, items, lastPage*pageSize)
+		for idx, item := range items {
+			assert.Equal(t, idx, item)
+		}
+		assert.LessOrEqual(t, *peak, concurrency, "more requests in flight than allowed")
+	})
+
+	t.Run("err - fetch errors are joined", func(t *testing.T) {
+		t.Parallel()
+
+		errPage4 := errors.New("page 4 failed")
+		errPage5 := errors.New("page 5 failed")
+		list, _ := newPrefetchListFunc(t, lastPage, pageSize, map[int]error{4: errPage4, 5: errPage5})
+		items, err := Paginate(0, list, WithPrefetch(lastPage)).All(testCtx)
+		require.ErrorIs(t, err, errPage4)
+		require.ErrorIs(t, err, errPage5)
+		assert.Len(t, items, 3*pageSize, "items of the pages before the failure are still handled")
+	})
+
+	t.Run("err - handler error cancels outstanding fetches", func(t *testing.T) {
+		t.Parallel()
+
+		handlerErr := errors.New("handlerErr")
+		list, _ := newPrefetchListFunc(t, lastPage, pageSize, nil)
+		var handled int
+		assert.Equal(t, handlerErr, Paginate(0, list, WithPrefetch(concurrency)).Map(testCtx, func(i int) error {
+			handled++
+			if i == pageSize*2 {
+				return handlerErr
+			}
+			return nil
+		}))
+		assert.Equal(t, pageSize*2+1, handled)
+	})
+
+	t.Run("ok - keeps following pages discovered past the last page", func(t *testing.T) {
+		t.Parallel()
+
+		calls := map[int]int{}
+		var mu sync.Mutex
+		items, err := Paginate(0, func(_ context.Context, page int) ([]int, *github.Response, error) {
+			mu.Lock()
+			calls[page]++
+			mu.Unlock()
+			switch page {
+			case 0:
+				return []int{1}, &github.Response{NextPage: 2, LastPage: 2}, nil
+			case 2:
+				return []int{2}, &github.Response{NextPage: 3}, nil
+			case 3:
+				return []int{3}, &github.Response{}, nil
+			default:
+				return nil, nil, errors.New("unexpected page")
+			}
+		}, WithPrefetch(concurrency)).All(testCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, items)
+		assert.Equal(t, map[int]int{0: 1, 2: 1, 3: 1}, calls)
+	})
+}
+
+func TestPaginateWrapped(t *testing.T) {
+	t.Parallel()
+
+	type wrapped struct {
+		Items []int
+	}
+
+	var callCount int
+	items, err := PaginateWrapped(0, func(_ context.Context, _ int) (*wrapped, *github.Response, error) {
+		callCount++
+		switch callCount {
+		case 1:
+			return &wrapped{Items: []int{1, 2}}, &github.Response{NextPage: 2}, nil
+		case 2:
+			return &wrapped{Items: []int{3}}, &github.Response{}, nil
+		default:
+			return nil, nil, errors.New("called too many times")
+		}
+	}, func(w *wrapped) []int {
+		return w.Items
+	}).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	_, err = PaginateWrapped(0, func(_ context.Context, _ int) (*wrapped, *github.Response, error) {
+		return nil, nil, errors.New("listErr")
+	}, func(w *wrapped) []int {
+		return w.Items
+	}).All(context.Background())
+	assert.Equal(t, errors.New("listErr"), err, "unwrap must not be called on error")
+}
+
+func TestListPages(t *testing.T) {
+	t.Parallel()
+
+	var listed []github.ListOptions
+	list := func(_ context.Context, opts *github.IssueListByRepoOptions) ([]int, *github.Response, error) {
+		listed = append(listed, opts.ListOptions)
+		return nil, &github.Response{}, nil
+	}
+	listOptions := func(o *github.IssueListByRepoOptions) *github.ListOptions { return &o.ListOptions }
+
+	opts, listPage := listPages(nil, listOptions, list)
+	assert.Equal(t, MaxPerPage, opts.PerPage, "nil opts default to MaxPerPage")
+	_, _, err := listPage(context.Background(), 2)
+	require.NoError(t, err)
+
+	callerOpts := &github.IssueListByRepoOptions{State: "all", ListOptions: github.ListOptions{Page: 3, PerPage: 10}}
+	opts, listPage = listPages(callerOpts, listOptions, list)
+	assert.NotSame(t, callerOpts, opts)
+	_, _, err = listPage(context.Background(), 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, []github.ListOptions{{Page: 2, PerPage: MaxPerPage}, {Page: 4, PerPage: 10}}, listed)
+	assert.Equal(t, 3, callerOpts.Page, "caller's opts must not be mutated")
+	assert.Equal(t, 3, opts.Page, "listing a page must not mutate the copy")
+}
+
+// servePages mocks the pages of a list endpoint, returning the page requested through ListOptions.Page
+func servePages[T any](t *testing.T, pages ...[]T) func(page int) ([]T, *github.Response, error) {
+	t.Helper()
+
+	return func(page int) ([]T, *github.Response, error) {
+		if page == 0 {
+			page = 1
+		}
+		require.LessOrEqual(t, page, len(pages), "requested page %d of %d", page, len(pages))
+		resp := &github.Response{}
+		if page < len(pages) {
+			resp.NextPage = page + 1
+		}
+		return pages[page-1], resp, nil
+	}
+}
+
+// collect returns a handler appending to items
+func collect[T any](items *[]T) func(T) error {
+	return func(item T) error {
+		*items = append(*items, item)
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package ghx
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type pullRequestLister interface {
+	List(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error)
+}
+
+//ghxgen:extend PullRequestsService.Map
+func newMapPullRequestsF(pullRequestsService pullRequestLister) func(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions, handle PullRequestHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, opts *github.PullRequestListOptions, handle PullRequestHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.PullRequestListOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+			return pullRequestsService.List(ctx, owner, repo, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+type pullRequestFileLister interface {
+	ListFiles(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error)
+}
+
+//ghxgen:extend PullRequestsService.MapFiles
+func newMapPullRequestFilesF(pullRequestsService pullRequestFileLister) func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle CommitFileHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle CommitFileHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, ownListOptions, func(ctx context.Context, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+			return pullRequestsService.ListFiles(ctx, owner, repo, number, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+type pullRequestCommitLister interface {
+	ListCommits(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error)
+}
+
+//ghxgen:extend PullRequestsService.MapCommits
+func newMapPullRequestCommitsF(pullRequestsService pullRequestCommitLister) func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, ownListOptions, func(ctx context.Context, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+			return pullRequestsService.ListCommits(ctx, owner, repo, number, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+type pullRequestReviewLister interface {
+	ListReviews(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error)
+}
+
+//ghxgen:extend PullRequestsService.MapReviews
+func newMapPullRequestReviewsF(pullRequestsService pullRequestReviewLister) func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle PullRequestReviewHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, number int, opts *github.ListOptions, handle PullRequestReviewHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, ownListOptions, func(ctx context.Context, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+			return pullRequestsService.ListReviews(ctx, owner, repo, number, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
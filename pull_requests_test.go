@@ -0,0 +1,98 @@
+package ghx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapPullRequests(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testOwner  = "testOwner"
+		testRepo   = "testRepo"
+		testNumber = 42
+	)
+	testCtx := context.Background()
+
+	t.Run("Map", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.PullRequest{{Number: PTR(1)}, {Number: PTR(2)}, {Number: PTR(3)}}
+		pages := servePages(t, expect[:2], expect[2:])
+		s := NewPullRequestsService(&PullRequestsServiceF{
+			List: func(actualCtx context.Context, actualOwner string, actualRepo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+				assert.Equal(t, testCtx, actualCtx)
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, "open", opts.State)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.PullRequest
+		require.NoError(t, newMapPullRequestsF(s)(testCtx, testOwner, testRepo, &github.PullRequestListOptions{State: "open"}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapFiles", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.CommitFile{{Filename: PTR("a")}, {Filename: PTR("b")}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewPullRequestsService(&PullRequestsServiceF{
+			ListFiles: func(_ context.Context, actualOwner string, actualRepo string, actualNumber int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testNumber, actualNumber)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.CommitFile
+		require.NoError(t, newMapPullRequestFilesF(s)(testCtx, testOwner, testRepo, testNumber, &github.ListOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapCommits", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.RepositoryCommit{{SHA: PTR("a")}, {SHA: PTR("b")}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewPullRequestsService(&PullRequestsServiceF{
+			ListCommits: func(_ context.Context, actualOwner string, actualRepo string, actualNumber int, opts *github.ListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testNumber, actualNumber)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.RepositoryCommit
+		require.NoError(t, newMapPullRequestCommitsF(s)(testCtx, testOwner, testRepo, testNumber, &github.ListOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapReviews", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.PullRequestReview{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewPullRequestsService(&PullRequestsServiceF{
+			ListReviews: func(_ context.Context, actualOwner string, actualRepo string, actualNumber int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, testNumber, actualNumber)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.PullRequestReview
+		require.NoError(t, newMapPullRequestReviewsF(s)(testCtx, testOwner, testRepo, testNumber, &github.ListOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+}
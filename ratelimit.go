@@ -0,0 +1,232 @@
+package ghx
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultRateLimitRetries = 3
+
+const (
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+
+	// rateLimitResetBuffer pads waits on X-RateLimit-Reset, which only has a resolution of seconds
+	rateLimitResetBuffer = time.Second
+	// secondaryRateLimitWait is how long GitHub asks to back off when a secondary limit carries no Retry-After
+	secondaryRateLimitWait = time.Minute
+)
+
+// RateLimitTransport waits out GitHub's primary and secondary rate limits instead of failing requests.
+// Waits never exceed the request context's deadline, a limit that cannot be waited out in time
+// is passed through for go-github to report as *github.RateLimitError or *github.AbuseRateLimitError.
+//
+// go-github refuses to send requests once a response reported no remaining requests, so exhausted
+// limits are waited out before that response is returned. Slowdowns are waited out before the next request.
+//
+//	client := ghx.NewClient(github.NewClient(&http.Client{Transport: &ghx.RateLimitTransport{}}))
+type RateLimitTransport struct {
+	// Base sends the requests, defaults to http.DefaultTransport
+	Base http.RoundTripper
+	// SlowdownThreshold spreads the remaining requests evenly until the reset once fewer than it remain, 0 disables it
+	SlowdownThreshold int
+	// MaxRetries caps how often a rate limited request is retried, defaults to DefaultRateLimitRetries
+	MaxRetries int
+	// OnWait is called before every wait
+	OnWait func(req *http.Request, wait time.Duration)
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu sync.Mutex
+	// notBefore is when the next request may be sent to slow down
+	notBefore time.Time
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := t.slowdown(); wait > 0 && t.fits(req.Context(), wait) {
+		if err := t.wait(req, wait); err != nil {
+			return nil, err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base().RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		wait, limited := t.limitWait(resp)
+		if !limited {
+			if wait > 0 && t.fits(req.Context(), wait) {
+				if err := t.wait(req, wait); err != nil {
+					drainAndClose(resp.Body)
+					return nil, err
+				}
+			}
+			return resp, nil
+		}
+		if attempt >= t.maxRetries() || !t.fits(req.Context(), wait) {
+			return resp, nil
+		}
+		retry, ok := rewind(req)
+		if !ok {
+			return resp, nil
+		}
+		err = t.wait(req, wait)
+		drainAndClose(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		req = retry
+	}
+}
+
+// limitWait returns how long to wait after resp and whether resp was rejected by a rate limit.
+// Slowdowns are not waited for after resp but recorded for the next request.
+func (t *RateLimitTransport) limitWait(resp *http.Response) (time.Duration, bool) {
+	remaining, err := strconv.Atoi(resp.Header.Get(headerRateRemaining))
+	hasRemaining := err == nil
+	resetUnix, err := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64)
+	hasReset := err == nil
+	untilReset := func() time.Duration {
+		return max(time.Unix(resetUnix, 0).Sub(t.clock())+rateLimitResetBuffer, 0)
+	}
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := t.retryAfter(resp); ok {
+			return wait, true
+		}
+		if hasRemaining && remaining == 0 && hasReset {
+			return untilReset(), true
+		}
+		if resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimit(resp) {
+			return secondaryRateLimitWait, true
+		}
+		return 0, false
+	}
+
+	if !hasRemaining || !hasReset {
+		return 0, false
+	}
+	if remaining == 0 {
+		return untilReset(), false
+	}
+	var notBefore time.Time
+	if remaining < t.SlowdownThreshold {
+		notBefore = t.clock().Add(untilReset() / time.Duration(remaining+1))
+	}
+	t.mu.Lock()
+	t.notBefore = notBefore
+	t.mu.Unlock()
+	return 0, false
+}
+
+// retryAfter parses Retry-After, which is either a number of seconds or an HTTP date
+func (t *RateLimitTransport) retryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get(headerRetryAfter)
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(t.clock()), 0), true
+	}
+	return 0, false
+}
+
+// slowdown returns how long to wait before the next request to spread the remaining ones until the reset,
+// each recorded slowdown is waited for once
+func (t *RateLimitTransport) slowdown() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.notBefore.IsZero() {
+		return 0
+	}
+	wait := t.notBefore.Sub(t.clock())
+	t.notBefore = time.Time{}
+	return wait
+}
+
+// isSecondaryRateLimit checks the body of a 403 for GitHub's secondary rate limit message, leaving the body readable
+func isSecondaryRateLimit(resp *http.Response) bool {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return err == nil && strings.Contains(strings.ToLower(string(data)), "secondary rate limit")
+}
+
+// rewind clones req for a retry, which is only possible when its body can be read again
+func rewind(req *http.Request) (*http.Request, bool) {
+	retry := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return retry, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	retry.Body = body
+	return retry, true
+}
+
+func (t *RateLimitTransport) fits(ctx context.Context, wait time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || !t.clock().Add(wait).After(deadline)
+}
+
+func (t *RateLimitTransport) wait(req *http.Request, wait time.Duration) error {
+	if t.OnWait != nil {
+		t.OnWait(req, wait)
+	}
+	if t.sleep != nil {
+		return t.sleep(req.Context(), wait)
+	}
+	return sleep(req.Context(), wait)
+}
+
+func (t *RateLimitTransport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+func (t *RateLimitTransport) maxRetries() int {
+	if t.MaxRetries == 0 {
+		return DefaultRateLimitRetries
+	}
+	return t.MaxRetries
+}
+
+func (t *RateLimitTransport) clock() time.Time {
+	if t.now == nil {
+		return time.Now()
+	}
+	return t.now()
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, body)
+	body.Close()
+}
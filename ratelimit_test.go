@@ -0,0 +1,265 @@
+package ghx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type scriptedResponse struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+// newScriptedServer answers the nth request with responses[n], repeating the last one once exhausted
+func newScriptedServer(t *testing.T, responses []scriptedResponse) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		rv := responses[min(len(bodies), len(responses))-1]
+		for k, v := range rv.headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(rv.status)
+		_, _ = io.WriteString(w, rv.body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &bodies
+}
+
+func newTestGitHubClient(t *testing.T, srv *httptest.Server, transport http.RoundTripper) *Client {
+	t.Helper()
+
+	c := github.NewClient(&http.Client{Transport: transport})
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	c.BaseURL = baseURL
+	return NewClient(c)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	testNow := time.Now().Truncate(time.Second)
+	resetIn := func(d time.Duration) string {
+		return strconv.FormatInt(testNow.Add(d).Unix(), 10)
+	}
+	ok := scriptedResponse{status: http.StatusOK, body: `{"number":1}`}
+
+	for _, tc := range []struct {
+		name              string
+		responses         []scriptedResponse
+		slowdownThreshold int
+		maxRetries        int
+		deadline          time.Duration
+		calls             int
+		expectWaits       []time.Duration
+		expectRequests    int
+		expectErr         any
+	}{
+		{
+			name:           "ok - no rate limit headers",
+			responses:      []scriptedResponse{ok},
+			expectRequests: 1,
+		},
+		{
+			name: "ok - primary limit waits until reset",
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{headerRateRemaining: "0", headerRateReset: resetIn(10 * time.Second)}},
+				ok,
+			},
+			expectWaits:    []time.Duration{11 * time.Second},
+			expectRequests: 2,
+		},
+		{
+			name: "ok - secondary limit waits for Retry-After",
+			responses: []scriptedResponse{
+				{status: http.StatusTooManyRequests, headers: map[string]string{headerRetryAfter: "30"}},
+				ok,
+			},
+			expectWaits:    []time.Duration{30 * time.Second},
+			expectRequests: 2,
+		},
+		{
+			name: "ok - secondary limit waits for Retry-After date",
+			responses: []scriptedResponse{
+				{status: http.StatusTooManyRequests, headers: map[string]string{headerRetryAfter: testNow.Add(20 * time.Second).UTC().Format(http.TimeFormat)}},
+				ok,
+			},
+			expectWaits:    []time.Duration{20 * time.Second},
+			expectRequests: 2,
+		},
+		{
+			name: "ok - secondary limit without Retry-After waits a minute",
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, body: `{"message":"You have exceeded a secondary rate limit."}`},
+				ok,
+			},
+			expectWaits:    []time.Duration{time.Minute},
+			expectRequests: 2,
+		},
+		{
+			name: "ok - exhausted limit is waited out before returning",
+			responses: []scriptedResponse{
+				{status: http.StatusOK, body: `{"number":1}`, headers: map[string]string{headerRateRemaining: "0", headerRateReset: resetIn(5 * time.Second)}},
+			},
+			expectWaits:    []time.Duration{6 * time.Second},
+			expectRequests: 1,
+		},
+		{
+			name: "ok - slows down below threshold before the next request",
+			responses: []scriptedResponse{
+				{status: http.StatusOK, body: `{"number":1}`, headers: map[string]string{headerRateRemaining: "1", headerRateReset: resetIn(9 * time.Second)}},
+				ok,
+			},
+			slowdownThreshold: 10,
+			calls:             3,
+			expectWaits:       []time.Duration{5 * time.Second},
+			expectRequests:    3,
+		},
+		{
+			name: "err - forbidden without rate limit is passed through",
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, body: `{"message":"Must have admin rights to Repository."}`},
+			},
+			expectRequests: 1,
+			expectErr:      &github.ErrorResponse{},
+		},
+		{
+			name: "err - wait past the deadline is passed through",
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{headerRateRemaining: "0", headerRateReset: resetIn(time.Hour)}},
+			},
+			deadline:       time.Minute,
+			expectRequests: 1,
+			expectErr:      &github.RateLimitError{},
+		},
+		{
+			name: "err - gives up after MaxRetries",
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{headerRetryAfter: "1"}, body: `{"message":"secondary rate limit","documentation_url":"https://docs.github.com/secondary-rate-limits"}`},
+			},
+			maxRetries:     2,
+			expectWaits:    []time.Duration{time.Second, time.Second},
+			expectRequests: 3,
+			expectErr:      &github.AbuseRateLimitError{},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, bodies := newScriptedServer(t, tc.responses)
+			var waits []time.Duration
+			transport := &RateLimitTransport{
+				SlowdownThreshold: tc.slowdownThreshold,
+				MaxRetries:        tc.maxRetries,
+				OnWait: func(_ *http.Request, wait time.Duration) {
+					waits = append(waits, wait)
+				},
+				now: func() time.Time { return testNow },
+				sleep: func(_ context.Context, _ time.Duration) error {
+					return nil
+				},
+			}
+			c := newTestGitHubClient(t, srv, transport)
+
+			ctx := context.Background()
+			if tc.deadline != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadline(ctx, testNow.Add(tc.deadline))
+				defer cancel()
+			}
+			var err error
+			for range max(tc.calls, 1) {
+				_, _, err = c.Issues.Get(ctx, "owner", "repo", 1)
+			}
+			if tc.expectErr != nil {
+				require.Error(t, err)
+				assert.IsType(t, tc.expectErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectWaits, waits)
+			assert.Len(t, *bodies, tc.expectRequests)
+		})
+	}
+}
+
+func TestRateLimitTransportReplaysBody(t *testing.T) {
+	t.Parallel()
+
+	srv, bodies := newScriptedServer(t, []scriptedResponse{
+		{status: http.StatusTooManyRequests, headers: map[string]string{headerRetryAfter: "1"}},
+		{status: http.StatusCreated, body: `{"number":1}`},
+	})
+	c := newTestGitHubClient(t, srv, &RateLimitTransport{
+		sleep: func(_ context.Context, _ time.Duration) error {
+			return nil
+		},
+	})
+
+	_, _, err := c.Issues.Create(context.Background(), "owner", "repo", &github.IssueRequest{Title: PTR("title")})
+	require.NoError(t, err)
+	require.Len(t, *bodies, 2)
+	assert.Equal(t, (*bodies)[0], (*bodies)[1])
+	assert.JSONEq(t, `{"title":"title"}`, (*bodies)[1])
+}
+
+func TestRateLimitTransportCanceledWait(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		response scriptedResponse
+	}{
+		{
+			name:     "err - retry wait",
+			response: scriptedResponse{status: http.StatusTooManyRequests, headers: map[string]string{headerRetryAfter: "60"}},
+		},
+		{
+			name: "err - exhausted limit wait",
+			response: scriptedResponse{status: http.StatusOK, body: `{"number":1}`, headers: map[string]string{
+				headerRateRemaining: "0",
+				headerRateReset:     strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10),
+			}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, bodies := newScriptedServer(t, []scriptedResponse{tc.response})
+			c := newTestGitHubClient(t, srv, &RateLimitTransport{})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			transport := c.Client.Client().Transport.(*RateLimitTransport)
+			transport.OnWait = func(_ *http.Request, _ time.Duration) {
+				cancel()
+			}
+			_, _, err := c.Issues.Get(ctx, "owner", "repo", 1)
+			require.ErrorIs(t, err, context.Canceled)
+			assert.Len(t, *bodies, 1)
+		})
+	}
+}
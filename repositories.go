@@ -0,0 +1,49 @@
+package ghx
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+)
+
+type repositoryCommitLister interface {
+	ListCommits(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error)
+}
+
+//ghxgen:extend RepositoriesService.MapCommits
+func newMapRepositoryCommitsF(repositoriesService repositoryCommitLister) func(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, opts *github.CommitsListOptions, handle RepositoryCommitHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.CommitsListOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+			return repositoriesService.ListCommits(ctx, owner, repo, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+type releaseLister interface {
+	ListReleases(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+}
+
+//ghxgen:extend RepositoriesService.MapReleases
+func newMapReleasesF(repositoriesService releaseLister) func(ctx context.Context, owner string, repo string, opts *github.ListOptions, handle RepositoryReleaseHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, opts *github.ListOptions, handle RepositoryReleaseHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, ownListOptions, func(ctx context.Context, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+			return repositoriesService.ListReleases(ctx, owner, repo, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
+
+type deploymentLister interface {
+	ListDeployments(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions) ([]*github.Deployment, *github.Response, error)
+}
+
+//ghxgen:extend RepositoriesService.MapDeployments
+func newMapDeploymentsF(repositoriesService deploymentLister) func(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions, handle DeploymentHandler, pageOpts ...PaginatorOption) error {
+	return func(ctx context.Context, owner string, repo string, opts *github.DeploymentsListOptions, handle DeploymentHandler, pageOpts ...PaginatorOption) error {
+		opts, list := listPages(opts, func(o *github.DeploymentsListOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.DeploymentsListOptions) ([]*github.Deployment, *github.Response, error) {
+			return repositoriesService.ListDeployments(ctx, owner, repo, opts)
+		})
+		return Paginate(opts.Page, list, cursorScope("", opts, pageOpts)...).Map(ctx, handle)
+	}
+}
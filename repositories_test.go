@@ -0,0 +1,76 @@
+package ghx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapRepositories(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testOwner = "testOwner"
+		testRepo  = "testRepo"
+	)
+	testCtx := context.Background()
+
+	t.Run("MapCommits", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.RepositoryCommit{{SHA: PTR("a")}, {SHA: PTR("b")}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewRepositoriesService(&RepositoriesServiceF{
+			ListCommits: func(_ context.Context, actualOwner string, actualRepo string, opts *github.CommitsListOptions) ([]*github.RepositoryCommit, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, "main", opts.SHA)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.RepositoryCommit
+		require.NoError(t, newMapRepositoryCommitsF(s)(testCtx, testOwner, testRepo, &github.CommitsListOptions{SHA: "main"}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapReleases", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.RepositoryRelease{{TagName: PTR("v1")}, {TagName: PTR("v2")}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewRepositoriesService(&RepositoriesServiceF{
+			ListReleases: func(_ context.Context, actualOwner string, actualRepo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.RepositoryRelease
+		require.NoError(t, newMapReleasesF(s)(testCtx, testOwner, testRepo, &github.ListOptions{}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+
+	t.Run("MapDeployments", func(t *testing.T) {
+		t.Parallel()
+
+		expect := []*github.Deployment{{ID: PTR[int64](1)}, {ID: PTR[int64](2)}}
+		pages := servePages(t, expect[:1], expect[1:])
+		s := NewRepositoriesService(&RepositoriesServiceF{
+			ListDeployments: func(_ context.Context, actualOwner string, actualRepo string, opts *github.DeploymentsListOptions) ([]*github.Deployment, *github.Response, error) {
+				assert.Equal(t, testOwner, actualOwner)
+				assert.Equal(t, testRepo, actualRepo)
+				assert.Equal(t, "production", opts.Environment)
+				return pages(opts.Page)
+			},
+		})
+
+		var actual []*github.Deployment
+		require.NoError(t, newMapDeploymentsF(s)(testCtx, testOwner, testRepo, &github.DeploymentsListOptions{Environment: "production"}, collect(&actual)))
+		assert.Equal(t, expect, actual)
+	})
+}
@@ -0,0 +1,177 @@
+package ghx
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+const (
+	DefaultRetryAttempts   = 3
+	DefaultRetryBackoff    = 500 * time.Millisecond
+	DefaultRetryMaxBackoff = 30 * time.Second
+	DefaultRetryMultiplier = 2
+)
+
+var (
+	DefaultRetryableStatusCodes = []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	DefaultRetryableMethods     = []string{http.MethodGet, http.MethodHead}
+)
+
+// RetryPolicy describes how transient failures are retried, zero fields fall back to their defaults
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt, defaults to DefaultRetryAttempts
+	MaxAttempts int
+	// Backoff is the wait before the first retry, defaults to DefaultRetryBackoff
+	Backoff time.Duration
+	// MaxBackoff caps the wait between attempts, defaults to DefaultRetryMaxBackoff
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after every retry, defaults to DefaultRetryMultiplier
+	Multiplier float64
+	// Jitter randomizes every wait by up to this fraction of it, e.g. 0.2 waits 80% to 120%
+	Jitter float64
+	// RetryableStatusCodes defaults to DefaultRetryableStatusCodes
+	RetryableStatusCodes []int
+	// RetryableMethods defaults to DefaultRetryableMethods, only requests with these methods are retried by RetryTransport
+	RetryableMethods []string
+}
+
+// Wait returns how long to wait before the given retry, retry 1 being the first one
+func (p RetryPolicy) Wait(retry int) time.Duration {
+	backoff := p.Backoff
+	if backoff == 0 {
+		backoff = DefaultRetryBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	multiplier := p.Multiplier
+	if multiplier == 0 {
+		multiplier = DefaultRetryMultiplier
+	}
+
+	wait := float64(backoff)
+	for range retry - 1 {
+		wait *= multiplier
+		if wait >= float64(maxBackoff) {
+			break
+		}
+	}
+	wait = min(wait, float64(maxBackoff))
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(wait)
+}
+
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts == 0 {
+		return DefaultRetryAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) retryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if codes == nil {
+		codes = DefaultRetryableStatusCodes
+	}
+	return slices.Contains(codes, code)
+}
+
+func (p RetryPolicy) retryableMethod(method string) bool {
+	methods := p.RetryableMethods
+	if methods == nil {
+		methods = DefaultRetryableMethods
+	}
+	return slices.Contains(methods, method)
+}
+
+// Retryable reports whether err is a transient failure under the policy:
+// a network error or a *github.ErrorResponse with a retryable status code
+func (p RetryPolicy) Retryable(err error) bool {
+	if err == nil || isContextErr(err) {
+		return false
+	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.Response != nil && p.retryableStatus(errResp.Response.StatusCode)
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Do calls f until it succeeds, fails with an error that is not Retryable or runs out of attempts
+func (p RetryPolicy) Do(ctx context.Context, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if attempt >= p.maxAttempts() || !p.Retryable(err) {
+			return err
+		}
+		if err := sleep(ctx, p.Wait(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// WithRetry retries a failing page under policy instead of failing the whole pagination
+func WithRetry(policy RetryPolicy) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.retry = &policy
+	}
+}
+
+// RetryTransport retries requests that fail with a network error or a retryable status code.
+// Only requests with a retryable method, by default GET and HEAD, are retried.
+type RetryTransport struct {
+	// Base sends the requests, defaults to http.DefaultTransport
+	Base   http.RoundTripper
+	Policy RetryPolicy
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.Policy.retryableMethod(req.Method) {
+		return t.base().RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base().RoundTrip(req)
+		if attempt >= t.Policy.maxAttempts() {
+			return resp, err
+		}
+		switch {
+		case err != nil:
+			if isContextErr(err) || req.Context().Err() != nil {
+				return nil, err
+			}
+		case !t.Policy.retryableStatus(resp.StatusCode):
+			return resp, nil
+		}
+
+		retry, ok := rewind(req)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			drainAndClose(resp.Body)
+		}
+		if err := sleep(req.Context(), t.Policy.Wait(attempt)); err != nil {
+			return nil, err
+		}
+		req = retry
+	}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
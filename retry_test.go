@@ -0,0 +1,217 @@
+package ghx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyWait(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		policy     RetryPolicy
+		retry      int
+		expectWait time.Duration
+	}{
+		{
+			retry:      1,
+			expectWait: DefaultRetryBackoff,
+		},
+		{
+			retry:      3,
+			expectWait: 4 * DefaultRetryBackoff,
+		},
+		{
+			retry:      100,
+			expectWait: DefaultRetryMaxBackoff,
+		},
+		{
+			policy:     RetryPolicy{Backoff: time.Second, Multiplier: 3, MaxBackoff: time.Minute},
+			retry:      3,
+			expectWait: 9 * time.Second,
+		},
+		{
+			policy:     RetryPolicy{Backoff: time.Second, Multiplier: 3, MaxBackoff: 5 * time.Second},
+			retry:      3,
+			expectWait: 5 * time.Second,
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%+v retry %d", tc.policy, tc.retry), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectWait, tc.policy.Wait(tc.retry))
+		})
+	}
+}
+
+func TestRetryPolicyWaitJitter(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{Backoff: time.Second, Jitter: 0.5}
+	for range 100 {
+		wait := p.Wait(1)
+		assert.GreaterOrEqual(t, wait, 500*time.Millisecond)
+		assert.LessOrEqual(t, wait, 1500*time.Millisecond)
+	}
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	t.Parallel()
+
+	errorResponse := func(code int) error {
+		return &github.ErrorResponse{Response: &http.Response{StatusCode: code}}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		policy RetryPolicy
+		err    error
+		expect bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "502",
+			err:    errorResponse(http.StatusBadGateway),
+			expect: true,
+		},
+		{
+			name:   "wrapped 503",
+			err:    fmt.Errorf("listing: %w", errorResponse(http.StatusServiceUnavailable)),
+			expect: true,
+		},
+		{
+			name: "404",
+			err:  errorResponse(http.StatusNotFound),
+		},
+		{
+			name:   "custom status codes",
+			policy: RetryPolicy{RetryableStatusCodes: []int{http.StatusNotFound}},
+			err:    errorResponse(http.StatusNotFound),
+			expect: true,
+		},
+		{
+			name:   "network error",
+			err:    &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			expect: true,
+		},
+		{
+			name: "context canceled",
+			err:  context.Canceled,
+		},
+		{
+			name: "other error",
+			err:  errors.New("other"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, tc.policy.Retryable(tc.err))
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	ok := scriptedResponse{status: http.StatusOK, body: `{"number":1}`}
+	badGateway := scriptedResponse{status: http.StatusBadGateway}
+
+	for _, tc := range []struct {
+		name           string
+		responses      []scriptedResponse
+		post           bool
+		expectRequests int
+		expectErr      bool
+	}{
+		{
+			name:           "ok - no retry needed",
+			responses:      []scriptedResponse{ok},
+			expectRequests: 1,
+		},
+		{
+			name:           "ok - retries 502",
+			responses:      []scriptedResponse{badGateway, badGateway, ok},
+			expectRequests: 3,
+		},
+		{
+			name:           "err - gives up after MaxAttempts",
+			responses:      []scriptedResponse{badGateway},
+			expectRequests: 3,
+			expectErr:      true,
+		},
+		{
+			name:           "err - does not retry 404",
+			responses:      []scriptedResponse{{status: http.StatusNotFound}},
+			expectRequests: 1,
+			expectErr:      true,
+		},
+		{
+			name:           "err - does not retry POST",
+			responses:      []scriptedResponse{badGateway, ok},
+			post:           true,
+			expectRequests: 1,
+			expectErr:      true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, bodies := newScriptedServer(t, tc.responses)
+			c := newTestGitHubClient(t, srv, &RetryTransport{Policy: RetryPolicy{Backoff: time.Millisecond}})
+
+			var err error
+			if tc.post {
+				_, _, err = c.Issues.Create(context.Background(), "owner", "repo", &github.IssueRequest{})
+			} else {
+				_, _, err = c.Issues.Get(context.Background(), "owner", "repo", 1)
+			}
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, *bodies, tc.expectRequests)
+		})
+	}
+}
+
+func TestPaginatorRetry(t *testing.T) {
+	t.Parallel()
+
+	badGateway := &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadGateway}}
+	calls := map[int]int{}
+	items, err := Paginate(0, func(_ context.Context, page int) ([]int, *github.Response, error) {
+		calls[page]++
+		switch {
+		case page == 0:
+			return []int{1}, &github.Response{NextPage: 2}, nil
+		case page == 2 && calls[page] < 3:
+			return nil, nil, badGateway
+		case page == 2:
+			return []int{2}, &github.Response{}, nil
+		default:
+			return nil, nil, errors.New("unexpected page")
+		}
+	}, WithRetry(RetryPolicy{Backoff: time.Millisecond})).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, items)
+	assert.Equal(t, map[int]int{0: 1, 2: 3}, calls, "only the failing page is retried")
+
+	_, err = Paginate(0, func(_ context.Context, _ int) ([]int, *github.Response, error) {
+		return nil, nil, badGateway
+	}, WithRetry(RetryPolicy{Backoff: time.Millisecond, MaxAttempts: 2})).All(context.Background())
+	assert.Equal(t, badGateway, err)
+}
@@ -2,108 +2,370 @@ package ghx
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/bevicted/ghx/sq"
 	"github.com/google/go-github/v62/github"
 )
 
-type SearchServiceF struct {
-	Code         func(ctx context.Context, query string, opts *github.SearchOptions) (*github.CodeSearchResult, *github.Response, error)
-	Commits      func(ctx context.Context, query string, opts *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error)
-	Issues       func(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error)
-	Labels       func(ctx context.Context, repoID int64, query string, opts *github.SearchOptions) (*github.LabelsSearchResult, *github.Response, error)
-	Repositories func(ctx context.Context, query string, opts *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error)
-	Topics       func(ctx context.Context, query string, opts *github.SearchOptions) (*github.TopicsSearchResult, *github.Response, error)
-	Users        func(ctx context.Context, query string, opts *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error)
+// MaxSearchResults is the most results GitHub returns for a single search query, regardless of its total
+const MaxSearchResults = 1000
 
-	Issue     func(ctx context.Context, query string) (*github.Issue, error)
-	MapIssues func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler) error
-}
+// searchEpoch predates every issue on GitHub, it is the start of the created: windows of MapAllIssues
+var searchEpoch = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
 
-type SearchService struct {
-	f *SearchServiceF
-}
+var (
+	// ErrCreatedQualifier is returned by MapAllIssues for queries with a created: qualifier, which it splits on itself
+	ErrCreatedQualifier = errors.New("query already has a created: qualifier")
+	// ErrCursorUnsupported is returned by MapAllIssues when passed WithCursor or WithCheckpoint
+	ErrCursorUnsupported = errors.New("cursors are not supported")
+)
 
-func (s *SearchService) Code(ctx context.Context, query string, opts *github.SearchOptions) (*github.CodeSearchResult, *github.Response, error) {
-	return s.f.Code(ctx, query, opts)
+// UncoveredSearchError reports the queries MapAllIssues could not split below MaxSearchResults,
+// only their first MaxSearchResults results were handled
+type UncoveredSearchError struct {
+	Queries []string
 }
-func (s *SearchService) Commits(ctx context.Context, query string, opts *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error) {
-	return s.f.Commits(ctx, query, opts)
+
+func (e *UncoveredSearchError) Error() string {
+	return fmt.Sprintf("search results beyond %d not covered for queries: %s", MaxSearchResults, strings.Join(e.Queries, ", "))
 }
-func (s *SearchService) Issues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
-	return s.f.Issues(ctx, query, opts)
+
+// SearchSummary describes how complete a search mapping was
+type SearchSummary struct {
+	// Total is the number of results GitHub reported for the query
+	Total int
+	// Delivered is the number of items handled
+	Delivered int
+	// Pages is the number of pages delivered, MapAllIssues does not count the pages it discarded to split a window
+	Pages int
+	// Incomplete is set once any page came back with IncompleteResults, e.g. because the search timed out
+	Incomplete bool
 }
-func (s *SearchService) Labels(ctx context.Context, repoID int64, query string, opts *github.SearchOptions) (*github.LabelsSearchResult, *github.Response, error) {
-	return s.f.Labels(ctx, repoID, query, opts)
+
+// IncompleteResultsPolicy decides what happens to search pages GitHub reports as incomplete
+type IncompleteResultsPolicy int
+
+const (
+	// IncompleteResultsAccept handles incomplete pages, only flagging them in the SearchSummary
+	IncompleteResultsAccept IncompleteResultsPolicy = iota
+	// IncompleteResultsFail stops at the first incomplete page with ErrIncompleteResults
+	IncompleteResultsFail
+	// IncompleteResultsRetry refetches incomplete pages under the WithRetry policy, or the default one,
+	// failing with ErrIncompleteResults once attempts run out
+	IncompleteResultsRetry
+)
+
+var ErrIncompleteResults = errors.New("search results are incomplete")
+
+// OnIncompleteResults sets how search mappers treat incomplete pages, the default is IncompleteResultsAccept
+func OnIncompleteResults(policy IncompleteResultsPolicy) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.incomplete = policy
+	}
 }
-func (s *SearchService) Repositories(ctx context.Context, query string, opts *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
-	return s.f.Repositories(ctx, query, opts)
+
+type searchResult interface {
+	GetTotal() int
+	GetIncompleteResults() bool
 }
-func (s *SearchService) Topics(ctx context.Context, query string, opts *github.SearchOptions) (*github.TopicsSearchResult, *github.Response, error) {
-	return s.f.Topics(ctx, query, opts)
+
+// searchSummarizer counts the pages and items of a search mapping into a SearchSummary
+type searchSummarizer struct {
+	mu      sync.Mutex
+	summary SearchSummary
+	cfg     paginatorConfig
+	// fetched is set once the first page was fetched, it holds the total of the query
+	fetched bool
 }
-func (s *SearchService) Users(ctx context.Context, query string, opts *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error) {
-	return s.f.Users(ctx, query, opts)
+
+// summarizeSearch wraps list to count its pages and apply the incomplete results policy
+func summarizeSearch[R searchResult](s *searchSummarizer, list func(ctx context.Context, page int) (R, *github.Response, error)) func(ctx context.Context, page int) (R, *github.Response, error) {
+	return func(ctx context.Context, page int) (R, *github.Response, error) {
+		for attempt := 1; ; attempt++ {
+			result, resp, err := list(ctx, page)
+			if err != nil {
+				return result, resp, err
+			}
+			incomplete := result.GetIncompleteResults()
+			switch {
+			case !incomplete || s.cfg.incomplete == IncompleteResultsAccept:
+			case s.cfg.incomplete == IncompleteResultsRetry && attempt < s.retry().maxAttempts():
+				if err := sleep(ctx, s.retry().Wait(attempt)); err != nil {
+					return result, resp, err
+				}
+				continue
+			default:
+				err = ErrIncompleteResults
+			}
+
+			s.mu.Lock()
+			s.summary.Pages++
+			if !s.fetched {
+				s.summary.Total = result.GetTotal()
+				s.fetched = true
+			}
+			s.summary.Incomplete = s.summary.Incomplete || incomplete
+			s.mu.Unlock()
+			return result, resp, err
+		}
+	}
 }
 
-func (s *SearchService) Issue(ctx context.Context, query string) (*github.Issue, error) {
-	return s.f.Issue(ctx, query)
+// discardPage uncounts a page whose items will not be delivered
+func (s *searchSummarizer) discardPage() {
+	s.mu.Lock()
+	s.summary.Pages--
+	s.mu.Unlock()
 }
-func (s *SearchService) MapIssues(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler) error {
-	return s.f.MapIssues(ctx, query, opts, handle)
+
+// deliver wraps handle to count the items it handled
+func deliver[T any](s *searchSummarizer, handle func(T) error) func(T) error {
+	return func(item T) error {
+		if err := handle(item); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.summary.Delivered++
+		s.mu.Unlock()
+		return nil
+	}
 }
 
-func NewSearchService(f *SearchServiceF) *SearchService {
-	return &SearchService{f: f}
+func (s *searchSummarizer) retry() *RetryPolicy {
+	if s.cfg.retry == nil {
+		return &RetryPolicy{}
+	}
+	return s.cfg.retry
 }
 
-func newSearchServicePassthrough(client *github.Client) *SearchService {
-	s := &SearchService{f: &SearchServiceF{
-		Code:         client.Search.Code,
-		Commits:      client.Search.Commits,
-		Issues:       client.Search.Issues,
-		Labels:       client.Search.Labels,
-		Repositories: client.Search.Repositories,
-		Topics:       client.Search.Topics,
-		Users:        client.Search.Users,
-	}}
-	s.f.Issue = newIssueF(s)
-	s.f.MapIssues = newMapIssuesF(s)
-	return s
+func (s *searchSummarizer) result() SearchSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.summary
 }
 
 type issueSearcher interface {
 	Issues(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error)
 }
 
+// Issue returns the first issue matching query, failing with ErrNotFound if there is none.
+// Several matches are not an error, the first one is returned, UniqueIssue fails with ErrAmbiguous instead.
+//
+//ghxgen:extend SearchService.Issue
 func newIssueF(searchService issueSearcher) func(ctx context.Context, query string) (*github.Issue, error) {
 	return func(ctx context.Context, query string) (*github.Issue, error) {
 		issuesSearchResult, _, err := searchService.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
-		if err != nil || len(issuesSearchResult.Issues) < 1 {
+		if err != nil {
 			return nil, err
 		}
+		if issuesSearchResult == nil || len(issuesSearchResult.Issues) < 1 {
+			return nil, &SearchResultError{Query: query, Total: issuesSearchResult.GetTotal(), Err: ErrNotFound}
+		}
 
 		return issuesSearchResult.Issues[0], nil
 	}
 }
 
-func newMapIssuesF(searchService issueSearcher) func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler) error {
-	return func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler) error {
-		for {
-			issuesSearchResult, resp, err := searchService.Issues(ctx, query, opts)
-			if err != nil {
+// UniqueIssue returns the only issue matching query, failing with ErrNotFound or ErrAmbiguous otherwise
+//
+//ghxgen:extend SearchService.UniqueIssue
+func newUniqueIssueF(searchService issueSearcher) func(ctx context.Context, query string) (*github.Issue, error) {
+	return func(ctx context.Context, query string) (*github.Issue, error) {
+		return unique(ctx, query, searchService.Issues, issuesOfSearchResult)
+	}
+}
+
+type repositorySearcher interface {
+	Repositories(ctx context.Context, query string, opts *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error)
+}
+
+// UniqueRepository returns the only repository matching query, failing with ErrNotFound or ErrAmbiguous otherwise
+//
+//ghxgen:extend SearchService.UniqueRepository
+func newUniqueRepositoryF(searchService repositorySearcher) func(ctx context.Context, query string) (*github.Repository, error) {
+	return func(ctx context.Context, query string) (*github.Repository, error) {
+		return unique(ctx, query, searchService.Repositories, func(r *github.RepositoriesSearchResult) []*github.Repository {
+			return r.Repositories
+		})
+	}
+}
+
+type userSearcher interface {
+	Users(ctx context.Context, query string, opts *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error)
+}
+
+// UniqueUser returns the only user matching query, failing with ErrNotFound or ErrAmbiguous otherwise
+//
+//ghxgen:extend SearchService.UniqueUser
+func newUniqueUserF(searchService userSearcher) func(ctx context.Context, query string) (*github.User, error) {
+	return func(ctx context.Context, query string) (*github.User, error) {
+		return unique(ctx, query, searchService.Users, func(r *github.UsersSearchResult) []*github.User {
+			return r.Users
+		})
+	}
+}
+
+type commitSearcher interface {
+	Commits(ctx context.Context, query string, opts *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error)
+}
+
+// UniqueCommit returns the only commit matching query, failing with ErrNotFound or ErrAmbiguous otherwise
+//
+//ghxgen:extend SearchService.UniqueCommit
+func newUniqueCommitF(searchService commitSearcher) func(ctx context.Context, query string) (*github.CommitResult, error) {
+	return func(ctx context.Context, query string) (*github.CommitResult, error) {
+		return unique(ctx, query, searchService.Commits, func(r *github.CommitsSearchResult) []*github.CommitResult {
+			return r.Commits
+		})
+	}
+}
+
+// unique fetches the first two results of query to tell a single match apart from none or several
+func unique[R any, PR interface {
+	*R
+	searchResult
+}, T any](ctx context.Context, query string, search func(ctx context.Context, query string, opts *github.SearchOptions) (PR, *github.Response, error), items func(PR) []T) (T, error) {
+	var zero T
+	result, _, err := search(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 2}})
+	if err != nil {
+		return zero, err
+	}
+	if result == nil {
+		return zero, &SearchResultError{Query: query, Err: ErrNotFound}
+	}
+	found := items(result)
+	total := max(result.GetTotal(), len(found))
+	switch {
+	case len(found) == 0:
+		return zero, &SearchResultError{Query: query, Total: total, Err: ErrNotFound}
+	case total > 1:
+		return zero, &SearchResultError{Query: query, Total: total, Err: ErrAmbiguous}
+	default:
+		return found[0], nil
+	}
+}
+
+//ghxgen:extend SearchService.MapIssues
+func newMapIssuesF(searchService issueSearcher) func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+	return func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+		opts, list := listPages(opts, func(o *github.SearchOptions) *github.ListOptions { return &o.ListOptions }, func(ctx context.Context, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+			return searchService.Issues(ctx, query, opts)
+		})
+		s := &searchSummarizer{cfg: newPaginatorConfig(pageOpts)}
+		err := PaginateWrapped(opts.Page, summarizeSearch(s, list), issuesOfSearchResult, cursorScope(query, opts, pageOpts)...).Map(ctx, deliver(s, handle))
+		return s.result(), err
+	}
+}
+
+// MapAllIssues maps every issue matching query, even beyond MaxSearchResults, by recursively splitting the query
+// into created: windows until each fits. Windows that cannot be split further, i.e. a single second with more than
+// MaxSearchResults issues, are reported through an *UncoveredSearchError once everything else was handled.
+// The first page of every window is fetched to learn its total, the pages of windows that get split are discarded
+// and not counted in SearchSummary.Pages.
+//
+// The query must be flat for every window to apply to all of it, sq.Parse errors are returned for AND, OR, NOT and parentheses.
+// It must not contain a created: qualifier itself, ErrCreatedQualifier is returned otherwise.
+// Splitting makes a single cursor meaningless, WithCursor and WithCheckpoint fail with ErrCursorUnsupported.
+//
+//ghxgen:extend SearchService.MapAllIssues
+func newMapAllIssuesF(searchService issueSearcher) func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+	return func(ctx context.Context, query string, opts *github.SearchOptions, handle IssueHandler, pageOpts ...PaginatorOption) (SearchSummary, error) {
+		cfg := newPaginatorConfig(pageOpts)
+		qualifiers, err := sq.Parse(query)
+		if err != nil {
+			return SearchSummary{}, err
+		}
+		if slices.ContainsFunc(qualifiers, func(q sq.SearchQualifier) bool { return strings.EqualFold(q.Key(), "created") }) {
+			return SearchSummary{}, fmt.Errorf("%w: %s", ErrCreatedQualifier, query)
+		}
+		if cfg.resume != nil || cfg.checkpoint != nil {
+			return SearchSummary{}, ErrCursorUnsupported
+		}
+		opts = copyOptions(opts, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: MaxPerPage}})
+
+		summarizer := &searchSummarizer{cfg: cfg}
+		s := &issueSearchSplitter{search: searchService, query: query, opts: *opts, handle: deliver(summarizer, handle), summarizer: summarizer}
+		if err := s.mapWindow(ctx, searchEpoch, time.Now().UTC().Truncate(time.Second)); err != nil {
+			return summarizer.result(), err
+		}
+		if len(s.uncovered) > 0 {
+			return summarizer.result(), &UncoveredSearchError{Queries: s.uncovered}
+		}
+		return summarizer.result(), nil
+	}
+}
+
+type issueSearchSplitter struct {
+	search     issueSearcher
+	query      string
+	opts       github.SearchOptions
+	handle     func(*github.Issue) error
+	summarizer *searchSummarizer
+	uncovered  []string
+}
+
+// mapWindow maps the issues created from through to, both inclusive, splitting the window in halves while it holds too many
+func (s *issueSearchSplitter) mapWindow(ctx context.Context, from time.Time, to time.Time) error {
+	// exact timestamps, sq.Between would take midnight UTC bounds as whole days
+	created := sq.Created(sq.Range(from.Format(time.RFC3339) + ".." + to.Format(time.RFC3339)))
+	query := strings.TrimSpace(s.query + " " + created.String())
+	cfg := s.summarizer.cfg
+	list := summarizeSearch(s.summarizer, func(ctx context.Context, page int) (*github.IssuesSearchResult, *github.Response, error) {
+		opts := s.opts
+		opts.Page = page
+		return s.search.Issues(ctx, query, &opts)
+	})
+
+	var (
+		first *github.IssuesSearchResult
+		resp  *github.Response
+	)
+	probe := func() error {
+		var err error
+		first, resp, err = list(ctx, 0)
+		return err
+	}
+	var err error
+	if cfg.retry != nil {
+		err = cfg.retry.Do(ctx, probe)
+	} else {
+		err = probe()
+	}
+	if err != nil {
+		return err
+	}
+
+	if first.GetTotal() > MaxSearchResults {
+		if to.After(from) {
+			s.summarizer.discardPage()
+			mid := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+			if err := s.mapWindow(ctx, from, mid); err != nil {
 				return err
 			}
-			for _, issue := range issuesSearchResult.Issues {
-				if err := handle(issue); err != nil {
-					return err
-				}
-			}
-			if resp.NextPage == 0 {
-				break
-			}
-			opts.Page = resp.NextPage
+			return s.mapWindow(ctx, mid.Add(time.Second), to)
 		}
+		s.uncovered = append(s.uncovered, query)
+	}
 
+	for _, issue := range first.Issues {
+		if err := s.handle(issue); err != nil {
+			return err
+		}
+	}
+	if resp == nil || resp.NextPage == 0 {
 		return nil
 	}
+	return PaginateWrapped(resp.NextPage, list, issuesOfSearchResult, func(c *paginatorConfig) {
+		*c = cfg
+	}).Map(ctx, s.handle)
+}
+
+func issuesOfSearchResult(r *github.IssuesSearchResult) []*github.Issue {
+	return r.Issues
 }
@@ -3,9 +3,13 @@ package ghx
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bevicted/ghx/ghxtest"
+	"github.com/bevicted/ghx/sq"
 	"github.com/google/go-github/v62/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -114,7 +118,8 @@ func TestMapSearchIssues(t *testing.T) {
 				Issues: func(actualCtx context.Context, actualQuery string, actualOpts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
 					assert.Equal(t, testCtx, actualCtx)
 					assert.Equal(t, testQuery, actualQuery)
-					assert.Same(t, opts, actualOpts)
+					assert.Equal(t, opts.Sort, actualOpts.Sort)
+					assert.Equal(t, opts.Order, actualOpts.Order)
 					require.Less(t, searchIssuesCallCount, len(tc.searchIssuesReturnValues), "Issues called more times than it has return values mocked")
 					rv := tc.searchIssuesReturnValues[searchIssuesCallCount]
 					searchIssuesCallCount++
@@ -122,13 +127,15 @@ func TestMapSearchIssues(t *testing.T) {
 				},
 			}))
 			var issueCount int
-			assert.Equal(t, tc.expectErr, mapIssues(testCtx, testQuery, opts, func(_ *github.Issue) error {
+			summary, err := mapIssues(testCtx, testQuery, opts, func(_ *github.Issue) error {
 				if tc.handlerErr == nil {
 					issueCount++
 				}
 				return tc.handlerErr
-			}))
+			})
+			assert.Equal(t, tc.expectErr, err)
 			assert.Equal(t, tc.expectIssues, issueCount, "handled different amount of issues than expected")
+			assert.Equal(t, issueCount, summary.Delivered)
 		})
 	}
 }
@@ -146,7 +153,8 @@ func TestSearchOneIssue(t *testing.T) {
 		searchIssuesReturnValues []searchIssuesReturnValue
 	}{
 		{
-			name: "ok - no query",
+			name:      "err - not found",
+			expectErr: &SearchResultError{Query: testQuery, Err: ErrNotFound},
 			searchIssuesReturnValues: []searchIssuesReturnValue{
 				{
 					Result: &github.IssuesSearchResult{},
@@ -154,6 +162,15 @@ func TestSearchOneIssue(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "err - nil result",
+			expectErr: &SearchResultError{Query: testQuery, Err: ErrNotFound},
+			searchIssuesReturnValues: []searchIssuesReturnValue{
+				{
+					Res: &github.Response{},
+				},
+			},
+		},
 		{
 			name: "ok - got issue",
 			searchIssuesReturnValues: []searchIssuesReturnValue{
@@ -163,6 +180,15 @@ func TestSearchOneIssue(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok - first of several",
+			searchIssuesReturnValues: []searchIssuesReturnValue{
+				{
+					Result: &github.IssuesSearchResult{Total: PTR(2), Issues: ghxtest.NewEmptyIssues(t, 1)},
+					Res:    &github.Response{},
+				},
+			},
+		},
 		{
 			name:      "err - searchIssueErr",
 			expectErr: errors.New("searchIssueErr"),
@@ -201,3 +227,341 @@ func TestSearchOneIssue(t *testing.T) {
 		})
 	}
 }
+
+// newCappedIssueSearcher serves searches over issues like GitHub does, filtering by the created: window of the query
+// and returning at most MaxSearchResults of them
+func newCappedIssueSearcher(t *testing.T, issues []*github.Issue) *SearchService {
+	t.Helper()
+
+	createdWindow := regexp.MustCompile(`created:(\S+)\.\.(\S+)`)
+	return NewSearchService(&SearchServiceF{
+		Issues: func(_ context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+			match := createdWindow.FindStringSubmatch(query)
+			require.Len(t, match, 3, "query without created window: %s", query)
+			from, err := time.Parse(time.RFC3339, match[1])
+			require.NoError(t, err)
+			to, err := time.Parse(time.RFC3339, match[2])
+			require.NoError(t, err)
+
+			var matching []*github.Issue
+			for _, issue := range issues {
+				if created := issue.GetCreatedAt().Time; !created.Before(from) && !created.After(to) {
+					matching = append(matching, issue)
+				}
+			}
+			total := len(matching)
+			matching = matching[:min(len(matching), MaxSearchResults)]
+
+			page := max(opts.Page, 1)
+			start := min((page-1)*opts.PerPage, len(matching))
+			end := min(start+opts.PerPage, len(matching))
+			resp := &github.Response{}
+			if end < len(matching) {
+				resp.NextPage = page + 1
+			}
+			return &github.IssuesSearchResult{Total: PTR(total), Issues: matching[start:end]}, resp, nil
+		},
+	})
+}
+
+func TestMapAllIssues(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newIssues := func(n int, created func(i int) time.Time) []*github.Issue {
+		issues := make([]*github.Issue, n)
+		for i := range issues {
+			issues[i] = &github.Issue{ID: PTR(int64(i + 1)), CreatedAt: &github.Timestamp{Time: created(i)}}
+		}
+		return issues
+	}
+
+	t.Run("ok - splits beyond the cap", func(t *testing.T) {
+		t.Parallel()
+
+		issues := newIssues(3500, func(i int) time.Time {
+			return start.Add(time.Duration(i) * time.Hour)
+		})
+		var (
+			actual              []*github.Issue
+			searches, discarded int
+		)
+		capped := newCappedIssueSearcher(t, issues)
+		s := NewSearchService(&SearchServiceF{
+			Issues: func(ctx context.Context, query string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+				result, resp, err := capped.Issues(ctx, query, opts)
+				searches++
+				if result.GetTotal() > MaxSearchResults {
+					discarded++
+				}
+				return result, resp, err
+			},
+		})
+		summary, err := newMapAllIssuesF(s)(testCtx, "repo:owner/repo", &github.SearchOptions{ListOptions: github.ListOptions{PerPage: MaxPerPage}}, collect(&actual))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, issues, actual, "every issue exactly once")
+		assert.Equal(t, len(issues), summary.Total)
+		assert.Equal(t, len(issues), summary.Delivered)
+		assert.Positive(t, discarded)
+		assert.Equal(t, searches-discarded, summary.Pages, "discarded pages are not counted")
+	})
+
+	t.Run("err - created qualifier", func(t *testing.T) {
+		t.Parallel()
+
+		for _, query := range []string{"created:>2020-01-01", "repo:owner/repo -created:2020-01-01", "is:open CREATED:2020-01-01"} {
+			_, err := newMapAllIssuesF(NewSearchService(&SearchServiceF{}))(testCtx, query, nil, func(*github.Issue) error { return nil })
+			require.ErrorIs(t, err, ErrCreatedQualifier, query)
+		}
+	})
+
+	t.Run("ok - created: in quoted text", func(t *testing.T) {
+		t.Parallel()
+
+		var queries []string
+		s := NewSearchService(&SearchServiceF{
+			Issues: func(_ context.Context, query string, _ *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+				queries = append(queries, query)
+				return &github.IssuesSearchResult{Total: PTR(0)}, &github.Response{}, nil
+			},
+		})
+		_, err := newMapAllIssuesF(s)(testCtx, `"foo created:bar" in:body`, nil, func(*github.Issue) error { return nil })
+		require.NoError(t, err)
+		require.Len(t, queries, 1)
+		assert.True(t, strings.HasPrefix(queries[0], `"foo created:bar" in:body created:`), queries[0])
+	})
+
+	t.Run("err - operators", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newMapAllIssuesF(NewSearchService(&SearchServiceF{}))(testCtx, "is:open (label:bug OR is:closed)", nil, func(*github.Issue) error { return nil })
+		require.ErrorIs(t, err, sq.ErrUnsupportedOperator)
+	})
+
+	t.Run("err - cursors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, pageOpt := range []PaginatorOption{WithCursor(Cursor{Page: 2}), WithCheckpoint(func(Cursor) error { return nil })} {
+			_, err := newMapAllIssuesF(NewSearchService(&SearchServiceF{}))(testCtx, "repo:owner/repo", nil, func(*github.Issue) error { return nil }, pageOpt)
+			require.ErrorIs(t, err, ErrCursorUnsupported)
+		}
+	})
+
+	t.Run("err - reports windows that cannot be split", func(t *testing.T) {
+		t.Parallel()
+
+		issues := newIssues(MaxSearchResults+5, func(_ int) time.Time {
+			return start
+		})
+		issues = append(issues, &github.Issue{ID: PTR[int64](0), CreatedAt: &github.Timestamp{Time: start.Add(time.Hour)}})
+		var actual []*github.Issue
+		s := newCappedIssueSearcher(t, issues)
+		_, err := newMapAllIssuesF(s)(testCtx, "repo:owner/repo", &github.SearchOptions{ListOptions: github.ListOptions{PerPage: MaxPerPage}}, collect(&actual))
+		var uncovered *UncoveredSearchError
+		require.ErrorAs(t, err, &uncovered)
+		assert.Equal(t, []string{`repo:owner/repo created:2020-01-01T00:00:00Z..2020-01-01T00:00:00Z`}, uncovered.Queries)
+		assert.Len(t, actual, MaxSearchResults+1)
+	})
+}
+
+func TestUniqueSearchResult(t *testing.T) {
+	t.Parallel()
+
+	const testQuery = "testQuery"
+	testCtx := context.Background()
+
+	for _, tc := range []struct {
+		name      string
+		total     int
+		issues    int
+		nilResult bool
+		expectErr error
+	}{
+		{
+			name:   "ok - exactly one",
+			total:  1,
+			issues: 1,
+		},
+		{
+			name:      "err - none",
+			expectErr: ErrNotFound,
+		},
+		{
+			name:      "err - nil result",
+			nilResult: true,
+			expectErr: ErrNotFound,
+		},
+		{
+			name:      "err - several",
+			total:     7,
+			issues:    2,
+			expectErr: ErrAmbiguous,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewSearchService(&SearchServiceF{
+				Issues: func(_ context.Context, actualQuery string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+					assert.Equal(t, testQuery, actualQuery)
+					assert.Equal(t, 2, opts.PerPage)
+					if tc.nilResult {
+						return nil, &github.Response{}, nil
+					}
+					return &github.IssuesSearchResult{Total: PTR(tc.total), Issues: ghxtest.NewEmptyIssues(t, tc.issues)}, &github.Response{}, nil
+				},
+				Repositories: func(_ context.Context, _ string, _ *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+					if tc.nilResult {
+						return nil, &github.Response{}, nil
+					}
+					return &github.RepositoriesSearchResult{Total: PTR(tc.total), Repositories: make([]*github.Repository, tc.issues)}, &github.Response{}, nil
+				},
+				Users: func(_ context.Context, _ string, _ *github.SearchOptions) (*github.UsersSearchResult, *github.Response, error) {
+					if tc.nilResult {
+						return nil, &github.Response{}, nil
+					}
+					return &github.UsersSearchResult{Total: PTR(tc.total), Users: make([]*github.User, tc.issues)}, &github.Response{}, nil
+				},
+				Commits: func(_ context.Context, _ string, _ *github.SearchOptions) (*github.CommitsSearchResult, *github.Response, error) {
+					if tc.nilResult {
+						return nil, &github.Response{}, nil
+					}
+					return &github.CommitsSearchResult{Total: PTR(tc.total), Commits: make([]*github.CommitResult, tc.issues)}, &github.Response{}, nil
+				},
+			})
+
+			_, issueErr := newUniqueIssueF(s)(testCtx, testQuery)
+			_, repoErr := newUniqueRepositoryF(s)(testCtx, testQuery)
+			_, userErr := newUniqueUserF(s)(testCtx, testQuery)
+			_, commitErr := newUniqueCommitF(s)(testCtx, testQuery)
+			for _, err := range []error{issueErr, repoErr, userErr, commitErr} {
+				if tc.expectErr == nil {
+					require.NoError(t, err)
+					continue
+				}
+				require.ErrorIs(t, err, tc.expectErr)
+				var resultErr *SearchResultError
+				require.ErrorAs(t, err, &resultErr)
+				assert.Equal(t, testQuery, resultErr.Query)
+				assert.Equal(t, tc.total, resultErr.Total)
+			}
+		})
+	}
+}
+
+func TestMapIssuesSummary(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	complete := &github.IssuesSearchResult{Total: PTR(3), IncompleteResults: PTR(false), Issues: ghxtest.NewEmptyIssues(t, 2)}
+	incomplete := &github.IssuesSearchResult{Total: PTR(3), IncompleteResults: PTR(true), Issues: ghxtest.NewEmptyIssues(t, 1)}
+	retry := WithRetry(RetryPolicy{Backoff: time.Millisecond})
+
+	for _, tc := range []struct {
+		name          string
+		pageOpts      []PaginatorOption
+		lastPage      []*github.IssuesSearchResult
+		expectSummary SearchSummary
+		expectCalls   int
+		expectErr     error
+	}{
+		{
+			name:          "ok - complete",
+			lastPage:      []*github.IssuesSearchResult{{Total: PTR(3), Issues: ghxtest.NewEmptyIssues(t, 1)}},
+			expectSummary: SearchSummary{Total: 3, Delivered: 3, Pages: 2},
+			expectCalls:   2,
+		},
+		{
+			name:          "ok - incomplete is accepted by default",
+			lastPage:      []*github.IssuesSearchResult{incomplete},
+			expectSummary: SearchSummary{Total: 3, Delivered: 3, Pages: 2, Incomplete: true},
+			expectCalls:   2,
+		},
+		{
+			name:          "ok - incomplete is retried",
+			pageOpts:      []PaginatorOption{OnIncompleteResults(IncompleteResultsRetry), retry},
+			lastPage:      []*github.IssuesSearchResult{incomplete, {Total: PTR(3), Issues: ghxtest.NewEmptyIssues(t, 1)}},
+			expectSummary: SearchSummary{Total: 3, Delivered: 3, Pages: 2},
+			expectCalls:   3,
+		},
+		{
+			name:          "err - incomplete fails",
+			pageOpts:      []PaginatorOption{OnIncompleteResults(IncompleteResultsFail)},
+			lastPage:      []*github.IssuesSearchResult{incomplete},
+			expectSummary: SearchSummary{Total: 3, Delivered: 2, Pages: 2, Incomplete: true},
+			expectCalls:   2,
+			expectErr:     ErrIncompleteResults,
+		},
+		{
+			name:          "err - incomplete after all retries",
+			pageOpts:      []PaginatorOption{OnIncompleteResults(IncompleteResultsRetry), retry},
+			lastPage:      []*github.IssuesSearchResult{incomplete},
+			expectSummary: SearchSummary{Total: 3, Delivered: 2, Pages: 2, Incomplete: true},
+			expectCalls:   1 + DefaultRetryAttempts,
+			expectErr:     ErrIncompleteResults,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls, lastPageCalls int
+			mapIssues := newMapIssuesF(NewSearchService(&SearchServiceF{
+				Issues: func(_ context.Context, _ string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+					calls++
+					if opts.Page == 0 {
+						return complete, &github.Response{NextPage: 2}, nil
+					}
+					result := tc.lastPage[min(lastPageCalls, len(tc.lastPage)-1)]
+					lastPageCalls++
+					return result, &github.Response{}, nil
+				},
+			}))
+			summary, err := mapIssues(testCtx, "query", &github.SearchOptions{}, func(*github.Issue) error { return nil }, tc.pageOpts...)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectSummary, summary)
+			assert.Equal(t, tc.expectCalls, calls)
+		})
+	}
+}
+
+func TestMapIssuesOptions(t *testing.T) {
+	t.Parallel()
+
+	testCtx := context.Background()
+	var pagesAsked []int
+	var perPages []int
+	mapIssues := newMapIssuesF(NewSearchService(&SearchServiceF{
+		Issues: func(_ context.Context, _ string, opts *github.SearchOptions) (*github.IssuesSearchResult, *github.Response, error) {
+			pagesAsked = append(pagesAsked, opts.Page)
+			perPages = append(perPages, opts.PerPage)
+			if opts.Page == 0 {
+				return &github.IssuesSearchResult{Issues: ghxtest.NewEmptyIssues(t, 1)}, &github.Response{NextPage: 2}, nil
+			}
+			return &github.IssuesSearchResult{Issues: ghxtest.NewEmptyIssues(t, 1)}, &github.Response{}, nil
+		},
+	}))
+	noop := func(*github.Issue) error { return nil }
+
+	require.NotPanics(t, func() {
+		_, err := mapIssues(testCtx, "query", nil, noop)
+		require.NoError(t, err)
+	})
+	assert.Equal(t, []int{MaxPerPage, MaxPerPage}, perPages, "nil opts default to MaxPerPage")
+
+	opts := &github.SearchOptions{Sort: "created", ListOptions: github.ListOptions{PerPage: 1}}
+	expectOpts := *opts
+	pagesAsked = nil
+	for range 2 {
+		_, err := mapIssues(testCtx, "query", opts, noop)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, expectOpts, *opts, "caller's opts must not be mutated")
+	assert.Equal(t, []int{0, 2, 0, 2}, pagesAsked, "reused opts must start from the first page again")
+}
@@ -0,0 +1,67 @@
+// Based on https://docs.github.com/en/search-github/searching-on-github/searching-code
+
+package code
+
+import (
+	"fmt"
+
+	"github.com/bevicted/ghx/sq"
+)
+
+type scope struct{}
+
+type Qualifier = sq.Qualifier[scope]
+
+type Qualifiers = sq.Qualifiers[scope]
+
+const (
+	InFile Qualifier = "in:file"
+	InPath Qualifier = "in:path"
+
+	IncludeForks Qualifier = "fork:true"
+	OnlyForks    Qualifier = "fork:only"
+)
+
+func InRepo(owner string, repo string) Qualifier {
+	return sq.NewQualifier[scope]("repo", fmt.Sprintf("%s/%s", owner, repo))
+}
+
+func InReposOwnedByUser(user string) Qualifier {
+	return sq.NewQualifier[scope]("user", user)
+}
+
+func InReposOwnedByOrg(org string) Qualifier {
+	return sq.NewQualifier[scope]("org", org)
+}
+
+func IsWrittenInLanguage(language string) Qualifier {
+	return sq.NewQualifier[scope]("language", language)
+}
+
+// Path matches files within path, e.g. src/ or docs/*.md
+func Path(path string) Qualifier {
+	return sq.NewQualifier[scope]("path", path)
+}
+
+func Filename(filename string) Qualifier {
+	return sq.NewQualifier[scope]("filename", filename)
+}
+
+// Extension matches file extensions without the leading dot, e.g. go
+func Extension(extension string) Qualifier {
+	return sq.NewQualifier[scope]("extension", extension)
+}
+
+// Symbol matches definitions of functions, classes and other symbols
+func Symbol(symbol string) Qualifier {
+	return sq.NewQualifier[scope]("symbol", symbol)
+}
+
+// Size matches file sizes in bytes
+func Size(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("size", r)
+}
+
+func HasText(text string) Qualifier {
+	return sq.NewTextQualifier[scope](text)
+}
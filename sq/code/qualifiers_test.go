@@ -0,0 +1,29 @@
+package code
+
+import (
+	"testing"
+
+	"github.com/bevicted/ghx/sq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifiers(t *testing.T) {
+	t.Parallel()
+
+	eq := func(s string, q Qualifier) {
+		assert.Equal(t, s, string(q))
+	}
+
+	eq(`repo:"owner/repo"`, InRepo("owner", "repo"))
+	eq(`user:"user"`, InReposOwnedByUser("user"))
+	eq(`org:"org"`, InReposOwnedByOrg("org"))
+	eq(`language:"go"`, IsWrittenInLanguage("go"))
+	eq(`path:"src/"`, Path("src/"))
+	eq(`filename:"go.mod"`, Filename("go.mod"))
+	eq(`extension:"go"`, Extension("go"))
+	eq(`symbol:"NewClient"`, Symbol("NewClient"))
+	eq(`size:>1000`, Size(sq.After(1000)))
+	eq(`"text"`, HasText("text"))
+
+	assert.Equal(t, `in:path extension:"go"`, Qualifiers{InPath, Extension("go")}.String())
+}
@@ -0,0 +1,83 @@
+// Based on https://docs.github.com/en/search-github/searching-on-github/searching-commits
+
+package commits
+
+import (
+	"fmt"
+
+	"github.com/bevicted/ghx/sq"
+)
+
+type scope struct{}
+
+type Qualifier = sq.Qualifier[scope]
+
+type Qualifiers = sq.Qualifiers[scope]
+
+const (
+	IsMerge    Qualifier = "merge:true"
+	IsNotMerge Qualifier = "merge:false"
+
+	IsPublic  Qualifier = "is:public"
+	IsPrivate Qualifier = "is:private"
+)
+
+func InRepo(owner string, repo string) Qualifier {
+	return sq.NewQualifier[scope]("repo", fmt.Sprintf("%s/%s", owner, repo))
+}
+
+func InReposOwnedByUser(user string) Qualifier {
+	return sq.NewQualifier[scope]("user", user)
+}
+
+func InReposOwnedByOrg(org string) Qualifier {
+	return sq.NewQualifier[scope]("org", org)
+}
+
+func IsAuthoredBy(user string) Qualifier {
+	return sq.NewQualifier[scope]("author", user)
+}
+
+func IsCommittedBy(user string) Qualifier {
+	return sq.NewQualifier[scope]("committer", user)
+}
+
+func AuthorName(name string) Qualifier {
+	return sq.NewQualifier[scope]("author-name", name)
+}
+
+func CommitterName(name string) Qualifier {
+	return sq.NewQualifier[scope]("committer-name", name)
+}
+
+func AuthorEmail(email string) Qualifier {
+	return sq.NewQualifier[scope]("author-email", email)
+}
+
+func CommitterEmail(email string) Qualifier {
+	return sq.NewQualifier[scope]("committer-email", email)
+}
+
+func AuthorDate(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("author-date", r)
+}
+
+func CommitterDate(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("committer-date", r)
+}
+
+func Hash(sha string) Qualifier {
+	return sq.NewQualifier[scope]("hash", sha)
+}
+
+func Parent(sha string) Qualifier {
+	return sq.NewQualifier[scope]("parent", sha)
+}
+
+func Tree(sha string) Qualifier {
+	return sq.NewQualifier[scope]("tree", sha)
+}
+
+func HasText(text string) Qualifier {
+	return sq.NewTextQualifier[scope](text)
+}
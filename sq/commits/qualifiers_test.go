@@ -0,0 +1,36 @@
+package commits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx/sq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifiers(t *testing.T) {
+	t.Parallel()
+
+	eq := func(s string, q Qualifier) {
+		assert.Equal(t, s, string(q))
+	}
+	day := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	eq(`repo:"owner/repo"`, InRepo("owner", "repo"))
+	eq(`user:"user"`, InReposOwnedByUser("user"))
+	eq(`org:"org"`, InReposOwnedByOrg("org"))
+	eq(`author:"user"`, IsAuthoredBy("user"))
+	eq(`committer:"user"`, IsCommittedBy("user"))
+	eq(`author-name:"name"`, AuthorName("name"))
+	eq(`committer-name:"name"`, CommitterName("name"))
+	eq(`author-email:"a@b.c"`, AuthorEmail("a@b.c"))
+	eq(`committer-email:"a@b.c"`, CommitterEmail("a@b.c"))
+	eq(`author-date:<=2020-01-02`, AuthorDate(sq.AtMost(day)))
+	eq(`committer-date:2020-01-02..*`, CommitterDate(sq.From(day)))
+	eq(`hash:"abc"`, Hash("abc"))
+	eq(`parent:"abc"`, Parent("abc"))
+	eq(`tree:"abc"`, Tree("abc"))
+	eq(`"text"`, HasText("text"))
+
+	assert.Equal(t, `merge:true hash:"abc"`, Qualifiers{IsMerge, Hash("abc")}.String())
+}
@@ -0,0 +1,363 @@
+package sq
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bevicted/ghx/state"
+	"github.com/google/go-github/v62/github"
+)
+
+var ErrUnsupportedQualifier = errors.New("qualifier cannot be evaluated locally")
+
+// UnsupportedQualifiersError lists the qualifiers Match skipped as they cannot be evaluated locally
+type UnsupportedQualifiersError struct {
+	Qualifiers SearchQualifiers
+}
+
+func (e *UnsupportedQualifiersError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnsupportedQualifier, e.Qualifiers)
+}
+
+func (e *UnsupportedQualifiersError) Unwrap() error {
+	return ErrUnsupportedQualifier
+}
+
+// Match evaluates q against issue the way GitHub search would.
+// Qualifiers that cannot be evaluated locally, e.g. involves: or author:@me, are skipped and reported
+// through an *UnsupportedQualifiersError alongside the result of the others.
+// Malformed values, e.g. an invalid date range, fail with ErrInvalidQuery.
+func Match(q SearchQualifiers, issue *github.Issue) (bool, error) {
+	var (
+		unsupported SearchQualifiers
+		texts       []string
+		scopes      SearchQualifiers
+		matched     = true
+	)
+	for _, qualifier := range q {
+		key, value, ok := qualifier.split()
+		switch {
+		case !ok:
+			texts = append(texts, qualifier.Value())
+			continue
+		case key == "in" && !qualifier.Negated():
+			scopes = append(scopes, qualifier)
+			continue
+		}
+
+		match, ok := issueMatchers[key]
+		if !ok {
+			unsupported = append(unsupported, qualifier)
+			continue
+		}
+		isMatch, err := match(issue, value)
+		switch {
+		case errors.Is(err, ErrUnsupportedQualifier):
+			unsupported = append(unsupported, qualifier)
+		case err != nil:
+			return false, fmt.Errorf("%s: %w", qualifier, err)
+		case isMatch == qualifier.Negated():
+			matched = false
+		}
+	}
+
+	textMatched, unsupportedScopes := matchText(issue, texts, scopes)
+	unsupported = append(unsupported, unsupportedScopes...)
+	matched = matched && textMatched
+
+	if len(unsupported) > 0 {
+		return matched, &UnsupportedQualifiersError{Qualifiers: unsupported}
+	}
+	return matched, nil
+}
+
+// issueMatcher reports whether issue matches the raw, possibly quoted, value of a qualifier
+type issueMatcher func(issue *github.Issue, value string) (bool, error)
+
+var issueMatchers = map[string]issueMatcher{
+	"type":      matchIs,
+	"is":        matchIs,
+	"state":     matchIs,
+	"reason":    matchReason,
+	"label":     matchLabel,
+	"author":    matchLogin(func(i *github.Issue) []*github.User { return []*github.User{i.GetUser()} }),
+	"assignee":  matchLogin(assigneesOf),
+	"milestone": matchMilestone,
+	"no":        matchNo,
+	"draft":     matchDraft,
+	"comments":  matchRange(commentsOf, parseNumberBound),
+	"created":   matchRange(timeOf(func(i *github.Issue) *github.Timestamp { return i.CreatedAt }), parseDateBound),
+	"updated":   matchRange(timeOf(func(i *github.Issue) *github.Timestamp { return i.UpdatedAt }), parseDateBound),
+	"closed":    matchRange(timeOf(func(i *github.Issue) *github.Timestamp { return i.ClosedAt }), parseDateBound),
+	"merged":    matchRange(timeOf(mergedAtOf), parseDateBound),
+}
+
+func matchIs(issue *github.Issue, value string) (bool, error) {
+	isPR := issue.PullRequestLinks != nil
+	switch unquote(value) {
+	case "issue":
+		return !isPR, nil
+	case "pr":
+		return isPR, nil
+	case state.Open.String(), state.Closed.String():
+		searched, _ := state.Parse(unquote(value))
+		actual, err := state.Parse(issue.GetState())
+		return err == nil && actual == searched, nil
+	case "merged":
+		return mergedAtOf(issue) != nil, nil
+	case "unmerged":
+		return isPR && mergedAtOf(issue) == nil, nil
+	case "locked":
+		return issue.GetLocked(), nil
+	case "unlocked":
+		return !issue.GetLocked(), nil
+	default:
+		return false, ErrUnsupportedQualifier
+	}
+}
+
+// matchReason matches the searchable reasons of Reason, e.g. "not planned" for state.ReasonNotPlanned
+func matchReason(issue *github.Issue, value string) (bool, error) {
+	actual, err := state.ParseReason(issue.GetStateReason())
+	for _, reason := range []state.Reason{state.ReasonCompleted, state.ReasonNotPlanned} {
+		if q, ok := Reason(reason).(SearchQualifier); ok && q.Value() == unquote(value) {
+			return err == nil && actual == reason, nil
+		}
+	}
+	return false, ErrUnsupportedQualifier
+}
+
+// matchLabel matches issues with any of a comma separated list of labels
+func matchLabel(issue *github.Issue, value string) (bool, error) {
+	for _, label := range splitList(value) {
+		for _, l := range issue.Labels {
+			if strings.EqualFold(l.GetName(), label) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func matchLogin(usersOf func(*github.Issue) []*github.User) issueMatcher {
+	return func(issue *github.Issue, value string) (bool, error) {
+		login := unquote(value)
+		if login == Me {
+			return false, ErrUnsupportedQualifier
+		}
+		for _, user := range usersOf(issue) {
+			if user != nil && strings.EqualFold(user.GetLogin(), login) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func assigneesOf(issue *github.Issue) []*github.User {
+	return append([]*github.User{issue.Assignee}, issue.Assignees...)
+}
+
+func matchMilestone(issue *github.Issue, value string) (bool, error) {
+	return issue.Milestone != nil && strings.EqualFold(issue.Milestone.GetTitle(), unquote(value)), nil
+}
+
+func matchNo(issue *github.Issue, value string) (bool, error) {
+	switch unquote(value) {
+	case "label":
+		return len(issue.Labels) == 0, nil
+	case "milestone":
+		return issue.Milestone == nil, nil
+	case "assignee":
+		for _, user := range assigneesOf(issue) {
+			if user != nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, ErrUnsupportedQualifier
+	}
+}
+
+func matchDraft(issue *github.Issue, value string) (bool, error) {
+	draft, err := strconv.ParseBool(unquote(value))
+	if err != nil {
+		return false, fmt.Errorf("%w: draft must be true or false", ErrInvalidQuery)
+	}
+	return issue.GetDraft() == draft, nil
+}
+
+// matchText matches issues containing every text within the scopes, title and body by default
+func matchText(issue *github.Issue, texts []string, scopes SearchQualifiers) (bool, SearchQualifiers) {
+	var (
+		fields      []string
+		unsupported SearchQualifiers
+	)
+	for _, scope := range scopes {
+		switch scope.Value() {
+		case "title":
+			fields = append(fields, issue.GetTitle())
+		case "body":
+			fields = append(fields, issue.GetBody())
+		default:
+			unsupported = append(unsupported, scope)
+		}
+	}
+	switch {
+	case len(scopes) == 0:
+		fields = []string{issue.GetTitle(), issue.GetBody()}
+	case len(fields) == 0:
+		// text only searched in unsupported scopes cannot be evaluated either
+		return true, unsupported
+	}
+
+	for _, text := range texts {
+		var found bool
+		for _, field := range fields {
+			if strings.Contains(strings.ToLower(field), strings.ToLower(text)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, unsupported
+		}
+	}
+	return true, unsupported
+}
+
+// boundParser returns the inclusive interval a range bound stands for, e.g. a whole day for a date
+type boundParser func(bound string) (int64, int64, error)
+
+// matchRange matches the value of issue against range syntax such as 5, >=10, 2020-01-01..*
+func matchRange(valueOf func(*github.Issue) (int64, bool), parse boundParser) issueMatcher {
+	return func(issue *github.Issue, value string) (bool, error) {
+		raw := unquote(value)
+		v, ok := valueOf(issue)
+
+		if from, to, isBetween := strings.Cut(raw, ".."); isBetween {
+			match := ok
+			if from != unbounded {
+				lo, _, err := parse(from)
+				if err != nil {
+					return false, err
+				}
+				match = match && v >= lo
+			}
+			if to != unbounded {
+				_, hi, err := parse(to)
+				if err != nil {
+					return false, err
+				}
+				match = match && v <= hi
+			}
+			return match, nil
+		}
+
+		for _, op := range []string{">=", "<=", ">", "<"} {
+			bound, isOp := strings.CutPrefix(raw, op)
+			if !isOp {
+				continue
+			}
+			lo, hi, err := parse(bound)
+			if err != nil {
+				return false, err
+			}
+			switch op {
+			case ">=":
+				return ok && v >= lo, nil
+			case "<=":
+				return ok && v <= hi, nil
+			case ">":
+				return ok && v > hi, nil
+			default:
+				return ok && v < lo, nil
+			}
+		}
+
+		lo, hi, err := parse(raw)
+		if err != nil {
+			return false, err
+		}
+		return ok && v >= lo && v <= hi, nil
+	}
+}
+
+func parseNumberBound(bound string) (int64, int64, error) {
+	n, err := strconv.ParseInt(bound, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %q is not a number", ErrInvalidQuery, bound)
+	}
+	return n, n, nil
+}
+
+// parseDateBound parses YYYY-MM-DD as the whole day in UTC and ISO8601 timestamps as their second, in unix seconds
+func parseDateBound(bound string) (int64, int64, error) {
+	if day, err := time.Parse(time.DateOnly, bound); err == nil {
+		return day.Unix(), day.Add(24*time.Hour).Unix() - 1, nil
+	}
+	ts, err := time.Parse(time.RFC3339, bound)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %q is neither YYYY-MM-DD nor an ISO8601 timestamp", ErrInvalidQuery, bound)
+	}
+	return ts.Unix(), ts.Unix(), nil
+}
+
+func commentsOf(issue *github.Issue) (int64, bool) {
+	return int64(issue.GetComments()), true
+}
+
+func timeOf(timestampOf func(*github.Issue) *github.Timestamp) func(*github.Issue) (int64, bool) {
+	return func(issue *github.Issue) (int64, bool) {
+		ts := timestampOf(issue)
+		if ts == nil {
+			return 0, false
+		}
+		return ts.Unix(), true
+	}
+}
+
+func mergedAtOf(issue *github.Issue) *github.Timestamp {
+	if issue.PullRequestLinks == nil {
+		return nil
+	}
+	return issue.PullRequestLinks.MergedAt
+}
+
+// splitList splits a comma separated list of possibly quoted values, e.g. bug,"help wanted"
+func splitList(value string) []string {
+	var (
+		values []string
+		quoted bool
+		start  int
+	)
+	for idx := 0; idx < len(value); idx++ {
+		switch value[idx] {
+		case '\\':
+			if quoted {
+				idx++
+			}
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				values = append(values, unquote(value[start:idx]))
+				start = idx + 1
+			}
+		}
+	}
+	return append(values, unquote(value[start:]))
+}
+
+func unquote(value string) string {
+	if strings.HasPrefix(value, `"`) {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return unquoted
+		}
+	}
+	return strings.Trim(value, `"`)
+}
@@ -0,0 +1,102 @@
+package sq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	created := day.Add(15 * time.Hour)
+	issue := &github.Issue{
+		State:       github.String("closed"),
+		StateReason: github.String("not_planned"),
+		Title:       github.String("Crash on startup"),
+		Body:        github.String("The client panics when opts is nil"),
+		User:        &github.User{Login: github.String("octocat")},
+		Assignees:   []*github.User{{Login: github.String("hubot")}},
+		Labels:      []*github.Label{{Name: github.String("bug")}, {Name: github.String("help wanted")}},
+		Milestone:   &github.Milestone{Title: github.String("v1.0")},
+		Comments:    github.Int(12),
+		CreatedAt:   &github.Timestamp{Time: created},
+		ClosedAt:    &github.Timestamp{Time: created.Add(48 * time.Hour)},
+	}
+	pr := *issue
+	pr.Draft = github.Bool(true)
+	pr.PullRequestLinks = &github.PullRequestLinks{}
+	unknownState := *issue
+	unknownState.State = github.String("unknown")
+	unknownState.StateReason = github.String("unknown")
+
+	for _, tc := range []struct {
+		name              string
+		query             SearchQualifiers
+		issue             *github.Issue
+		expect            bool
+		expectUnsupported SearchQualifiers
+		expectErr         error
+	}{
+		{name: "empty", expect: true},
+		{name: "type", query: SearchQualifiers{IsIssue}, expect: true},
+		{name: "type mismatch", query: SearchQualifiers{IsPR}},
+		{name: "type pr", query: SearchQualifiers{IsPR, IsDraft}, issue: &pr, expect: true},
+		{name: "state", query: SearchQualifiers{IsClosed, IsClosedBecauseNotPlanned}, expect: true},
+		{name: "state mismatch", query: SearchQualifiers{IsOpen}},
+		{name: "reason mismatch", query: SearchQualifiers{IsClosedBecauseCompleted}},
+		{name: "unknown state", query: SearchQualifiers{IsClosed}, issue: &unknownState},
+		{name: "unknown reason", query: SearchQualifiers{IsClosedBecauseNotPlanned}, issue: &unknownState},
+		{name: "label", query: SearchQualifiers{HasLabel("help wanted")}, expect: true},
+		{name: "any label", query: SearchQualifiers{HasAnyLabel("p0", "bug")}, expect: true},
+		{name: "all labels", query: HasAllLabels("bug", "p0")},
+		{name: "negated label", query: SearchQualifiers{LacksLabel("bug")}},
+		{name: "no labels", query: SearchQualifiers{HasNoLabels}},
+		{name: "author and assignee", query: SearchQualifiers{IsAuthoredBy("OctoCat"), IsAssignedTo("hubot")}, expect: true},
+		{name: "not authored by", query: SearchQualifiers{IsNotAuthoredBy("octocat")}},
+		{name: "milestone", query: SearchQualifiers{InMilestone("v1.0")}, expect: true},
+		{name: "comments range", query: SearchQualifiers{Comments(Between(10, 20)), Comments(After(11))}, expect: true},
+		{name: "comments mismatch", query: SearchQualifiers{Comments(AtMost(11))}},
+		{name: "created day", query: SearchQualifiers{Created(Exactly(day))}, expect: true},
+		{name: "created after day", query: SearchQualifiers{Created(After(day))}},
+		{name: "created timestamp range", query: SearchQualifiers{Created(Between(day, created))}, expect: true},
+		{name: "closed open ended", query: SearchQualifiers{Closed(From(day.Add(24 * time.Hour)))}, expect: true},
+		{name: "updated missing", query: SearchQualifiers{Updated(Until(day))}},
+		{name: "raw range", query: SearchQualifiers{WasCreated(">=2024-03-10")}, expect: true},
+		{name: "text", query: SearchQualifiers{HasText("crash"), HasText("panics")}, expect: true},
+		{name: "text in title", query: SearchQualifiers{RestrictTextSearchToTitle, HasText("panics")}},
+		{
+			name:              "unsupported qualifiers are reported",
+			query:             SearchQualifiers{IsAuthoredBySelf, HasUserInvolved("hubot"), RestrictTextSearchToComments, HasText("crash"), IsClosed},
+			expect:            true,
+			expectUnsupported: SearchQualifiers{IsAuthoredBySelf, HasUserInvolved("hubot"), RestrictTextSearchToComments},
+		},
+		{name: "invalid range", query: SearchQualifiers{WasCreated(">yesterday")}, expectErr: ErrInvalidQuery},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.issue == nil {
+				tc.issue = issue
+			}
+			actual, err := Match(tc.query, tc.issue)
+			switch {
+			case tc.expectErr != nil:
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			case tc.expectUnsupported != nil:
+				var unsupported *UnsupportedQualifiersError
+				require.ErrorAs(t, err, &unsupported)
+				assert.Equal(t, tc.expectUnsupported, unsupported.Qualifiers)
+			default:
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package sq
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidQuery        = errors.New("invalid search query")
+	ErrUnsupportedOperator = errors.New("unsupported search operator")
+)
+
+// Parse splits a search query into its qualifiers and text, e.g. `repo:"owner/repo" -label:bug comments:>=10 "some text"`.
+// Queries with AND, OR, NOT or parentheses have no flat representation and are rejected with ErrUnsupportedOperator.
+func Parse(query string) (SearchQualifiers, error) {
+	var (
+		qualifiers SearchQualifiers
+		token      strings.Builder
+		quoted     bool
+		escaped    bool
+		quoteStart int
+	)
+	flush := func() error {
+		if token.Len() == 0 {
+			return nil
+		}
+		t := token.String()
+		token.Reset()
+		switch t {
+		case opAnd, opOr, opNot:
+			return fmt.Errorf("%w: %s", ErrUnsupportedOperator, t)
+		}
+		qualifiers = append(qualifiers, SearchQualifier(t))
+		return nil
+	}
+
+	for idx, r := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+			quoteStart = idx
+		case quoted:
+		case r == '(' || r == ')':
+			return nil, fmt.Errorf("%w: parentheses at offset %d", ErrUnsupportedOperator, idx)
+		case unicode.IsSpace(r):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		token.WriteRune(r)
+	}
+	if quoted {
+		return nil, fmt.Errorf("%w: unterminated quote at offset %d", ErrInvalidQuery, quoteStart)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return qualifiers, nil
+}
+
+// Negated reports whether the qualifier excludes its matches, e.g. -label:bug
+func (s SearchQualifier) Negated() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// Key returns the key of the qualifier without negation, e.g. label for -label:bug, and "" for text
+func (s SearchQualifier) Key() string {
+	key, _, ok := s.split()
+	if !ok {
+		return ""
+	}
+	return key
+}
+
+// Value returns the unquoted value of the qualifier, e.g. "good first issue" for label:"good first issue",
+// and the unquoted text for text
+func (s SearchQualifier) Value() string {
+	_, value, ok := s.split()
+	if !ok {
+		value = strings.TrimPrefix(string(s), "-")
+	}
+	return unquote(value)
+}
+
+func (s SearchQualifier) split() (string, string, bool) {
+	q := strings.TrimPrefix(string(s), "-")
+	if strings.HasPrefix(q, `"`) {
+		return "", "", false
+	}
+	key, value, ok := strings.Cut(q, ":")
+	if !ok || key == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// Without returns the qualifiers whose key is none of keys, negated or not
+func (s SearchQualifiers) Without(keys ...string) SearchQualifiers {
+	var qualifiers SearchQualifiers
+	for _, qualifier := range s {
+		if key := qualifier.Key(); key == "" || !slices.Contains(keys, key) {
+			qualifiers = append(qualifiers, qualifier)
+		}
+	}
+	return qualifiers
+}
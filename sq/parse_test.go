@@ -0,0 +1,136 @@
+package sq
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		query     string
+		expect    SearchQualifiers
+		expectErr error
+	}{
+		{
+			name:  "ok - empty",
+			query: "  ",
+		},
+		{
+			name:   "ok - qualifiers and text",
+			query:  `repo:"owner/repo"  -label:bug in:title  fix "some text"`,
+			expect: SearchQualifiers{`repo:"owner/repo"`, "-label:bug", RestrictTextSearchToTitle, "fix", `"some text"`},
+		},
+		{
+			name:   "ok - ranges",
+			query:  `comments:>=10 created:2020-01-01..* reactions:<5 updated:*..2020-01-01`,
+			expect: SearchQualifiers{"comments:>=10", "created:2020-01-01..*", "reactions:<5", "updated:*..2020-01-01"},
+		},
+		{
+			name:   "ok - escaped quotes and operators in quotes",
+			query:  `label:"say \"OR\" (now)" "NOT"`,
+			expect: SearchQualifiers{`label:"say \"OR\" (now)"`, `"NOT"`},
+		},
+		{
+			name:      "err - unterminated quote",
+			query:     `label:"bug`,
+			expectErr: ErrInvalidQuery,
+		},
+		{
+			name:      "err - OR",
+			query:     `label:p0 OR label:p1`,
+			expectErr: ErrUnsupportedOperator,
+		},
+		{
+			name:      "err - parentheses",
+			query:     `(label:p0 label:p1)`,
+			expectErr: ErrUnsupportedOperator,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := Parse(tc.query)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, actual)
+		})
+	}
+}
+
+func TestSearchQualifierParts(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		qualifier     SearchQualifier
+		expectKey     string
+		expectValue   string
+		expectNegated bool
+	}{
+		{qualifier: HasLabel("good first issue"), expectKey: "label", expectValue: "good first issue"},
+		{qualifier: "-author:someone", expectKey: "author", expectValue: "someone", expectNegated: true},
+		{qualifier: "comments:>=10", expectKey: "comments", expectValue: ">=10"},
+		{qualifier: HasText("a: b"), expectValue: "a: b"},
+		{qualifier: "fix", expectValue: "fix"},
+	} {
+		tc := tc
+		t.Run(tc.qualifier.String(), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectKey, tc.qualifier.Key())
+			assert.Equal(t, tc.expectValue, tc.qualifier.Value())
+			assert.Equal(t, tc.expectNegated, tc.qualifier.Negated())
+		})
+	}
+}
+
+func TestSearchQualifiersWithout(t *testing.T) {
+	t.Parallel()
+
+	q, err := Parse(`author:a -author:b label:bug "author:c"`)
+	require.NoError(t, err)
+	assert.Equal(t, SearchQualifiers{"label:bug", `"author:c"`}, q.Without("author"))
+}
+
+var fuzzKey = regexp.MustCompile(`^[a-z][a-z-]*$`)
+
+func FuzzParseRoundTrip(f *testing.F) {
+	f.Add("label", "good first issue", "some text")
+	f.Add("created", ">=2020-01-01", `say "hi"`)
+	f.Add("comments", "10..*", "(OR)")
+	f.Fuzz(func(t *testing.T, key string, value string, text string) {
+		if !fuzzKey.MatchString(key) {
+			t.Skip()
+		}
+		q := SearchQualifiers{newSearchQualifier(key, value), newSearchQualifier("-"+key, value), HasText(text), IsOpen}
+		actual, err := Parse(q.String())
+		require.NoError(t, err)
+		assert.Equal(t, q, actual)
+		assert.Equal(t, key, actual[0].Key())
+		assert.Equal(t, value, actual[0].Value())
+		assert.Equal(t, text, actual[2].Value())
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add(`repo:"owner/repo" -label:bug in:title "some text"`)
+	f.Add(`label:"say \"OR\""`)
+	f.Fuzz(func(t *testing.T, query string) {
+		q, err := Parse(query)
+		if err != nil {
+			return
+		}
+		actual, err := Parse(q.String())
+		require.NoError(t, err)
+		assert.Equal(t, q, actual)
+	})
+}
@@ -0,0 +1,96 @@
+package sq
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Qualifier is a qualifier of the search type S, e.g. code.Qualifier for code search.
+// Search types are told apart by the package declaring S, Build fails with ErrMixedScopes for queries mixing them,
+// including with the issue qualifiers of this package. Text matches any search type.
+type Qualifier[S any] string
+
+func (q Qualifier[S]) String() string {
+	return string(q)
+}
+
+func (q Qualifier[S]) operators() int {
+	return 0
+}
+
+func (q Qualifier[S]) scope() string {
+	if SearchQualifier(q).Key() == "" {
+		return ""
+	}
+	return path.Base(reflect.TypeFor[S]().PkgPath())
+}
+
+func (q Qualifier[S]) negate() Expr {
+	if negated, ok := strings.CutPrefix(string(q), "-"); ok {
+		return Qualifier[S](negated)
+	}
+	return "-" + q
+}
+
+// Qualifiers are qualifiers of the search type S that must all match, empty ones are skipped
+type Qualifiers[S any] []Qualifier[S]
+
+func (q Qualifiers[S]) String() string {
+	parts := make([]string, 0, len(q))
+	for _, qualifier := range q {
+		if qualifier != "" {
+			parts = append(parts, qualifier.String())
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// operators counts the implicit ANDs between the non empty qualifiers
+func (q Qualifiers[S]) operators() int {
+	var n int
+	for _, qualifier := range q {
+		if qualifier != "" {
+			n++
+		}
+	}
+	return max(n-1, 0)
+}
+
+func (q Qualifiers[S]) scope() string {
+	for _, qualifier := range q {
+		if scope := qualifier.scope(); scope != "" {
+			return scope
+		}
+	}
+	return ""
+}
+
+func (q Qualifiers[S]) only() (Expr, bool) {
+	var only Expr
+	for _, qualifier := range q {
+		if qualifier == "" {
+			continue
+		}
+		if only != nil {
+			return nil, false
+		}
+		only = qualifier
+	}
+	return only, only != nil
+}
+
+// NewQualifier returns key:value, quoting value unless it is @me, a number or a range
+func NewQualifier[S any](key string, value string) Qualifier[S] {
+	return Qualifier[S](key + ":" + Quote(value))
+}
+
+func NewRangeQualifier[S any](key string, r Range) Qualifier[S] {
+	return Qualifier[S](key + ":" + r.String())
+}
+
+// NewTextQualifier matches text as a quoted phrase
+func NewTextQualifier[S any](text string) Qualifier[S] {
+	return Qualifier[S](strconv.Quote(text))
+}
@@ -0,0 +1,97 @@
+package sq
+
+import (
+	"testing"
+
+	"github.com/bevicted/ghx/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testScope struct{}
+
+func TestQualifier(t *testing.T) {
+	t.Parallel()
+
+	type (
+		qualifier  = Qualifier[testScope]
+		qualifiers = Qualifiers[testScope]
+	)
+	stars := NewRangeQualifier[testScope]("stars", AtLeast(100))
+	topic := NewQualifier[testScope]("topic", "go")
+	assert.Equal(t, qualifier(`stars:>=100`), stars)
+	assert.Equal(t, qualifier(`topic:"go"`), topic)
+	assert.Equal(t, qualifier(`"say \"hi\""`), NewTextQualifier[testScope](`say "hi"`))
+	assert.Equal(t, `stars:>=100 topic:"go"`, qualifiers{"", stars, "", topic}.String(), "empty qualifiers are skipped")
+
+	for _, tc := range []struct {
+		name        string
+		query       *QueryBuilder
+		expectQuery string
+		expectErr   error
+	}{
+		{
+			name:        "ok - qualifiers",
+			query:       Query(stars, qualifiers{topic, ""}),
+			expectQuery: `stars:>=100 topic:"go"`,
+		},
+		{
+			name:        "ok - not qualifier",
+			query:       Query().Not(topic),
+			expectQuery: `-topic:"go"`,
+		},
+		{
+			name:        "ok - not negated qualifier",
+			query:       Query().Not(Not(topic)),
+			expectQuery: `topic:"go"`,
+		},
+		{
+			name:        "ok - not single qualifier",
+			query:       Query().Not(qualifiers{"", topic}),
+			expectQuery: `-topic:"go"`,
+		},
+		{
+			name:        "ok - or qualifiers",
+			query:       Query().Or(qualifiers{stars, topic}, NewQualifier[testScope]("topic", "rust")),
+			expectQuery: `((stars:>=100 topic:"go") OR topic:"rust")`,
+		},
+		{
+			name:        "ok - text matches any search type",
+			query:       Query(stars, HasText("go"), NewTextQualifier[testScope]("rust"), State(state.All)),
+			expectQuery: `stars:>=100 "go" "rust"`,
+		},
+		{
+			name:      "err - mixed with issue qualifiers",
+			query:     Query(stars, IsDraft),
+			expectErr: ErrMixedScopes,
+		},
+		{
+			name:      "err - mixed within or",
+			query:     Query().Or(topic, Label("bug")),
+			expectErr: ErrMixedScopes,
+		},
+		{
+			name:      "err - mixed within not",
+			query:     Query(topic).Not(SearchQualifiers{IsOpen, IsDraft}),
+			expectErr: ErrMixedScopes,
+		},
+		{
+			name:      "err - too many implicit ands",
+			query:     Query().Not(qualifiers{stars, topic, stars, topic, stars, topic}),
+			expectErr: ErrTooManyOperators,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := tc.query.Build()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectQuery, query)
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package sq
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxQueryLength is the longest search query GitHub accepts
+	MaxQueryLength = 256
+	// MaxOperators is how many AND, OR and NOT operators GitHub accepts in a single search query
+	MaxOperators = 5
+)
+
+var (
+	ErrQueryTooLong     = errors.New("search query too long")
+	ErrTooManyOperators = errors.New("too many search operators")
+	ErrEmptyGroup       = errors.New("empty search group")
+	ErrMixedScopes      = errors.New("qualifiers of different search types")
+)
+
+// Expr is a part of a search query, e.g. a SearchQualifier or a group built with Or, And and Not
+type Expr interface {
+	String() string
+	// operators counts the AND, OR and NOT operators of the expression
+	operators() int
+}
+
+func (s SearchQualifier) operators() int {
+	return 0
+}
+
+// operators counts the implicit ANDs between the non empty qualifiers
+func (s SearchQualifiers) operators() int {
+	return max(len(s.nonEmpty())-1, 0)
+}
+
+func (s SearchQualifiers) nonEmpty() SearchQualifiers {
+	nonEmpty := make(SearchQualifiers, 0, len(s))
+	for _, qualifier := range s {
+		if qualifier != "" {
+			nonEmpty = append(nonEmpty, qualifier)
+		}
+	}
+	return nonEmpty
+}
+
+func (s SearchQualifier) negate() Expr {
+	if negated, ok := strings.CutPrefix(string(s), "-"); ok {
+		return SearchQualifier(negated)
+	}
+	return "-" + s
+}
+
+// scope is issues for every qualifier of issue and pull request search, text matches any search type
+func (s SearchQualifier) scope() string {
+	if s.Key() == "" {
+		return ""
+	}
+	return issuesScope
+}
+
+func (s SearchQualifiers) scope() string {
+	for _, qualifier := range s {
+		if scope := qualifier.scope(); scope != "" {
+			return scope
+		}
+	}
+	return ""
+}
+
+func (s SearchQualifiers) only() (Expr, bool) {
+	if nonEmpty := s.nonEmpty(); len(nonEmpty) == 1 {
+		return nonEmpty[0], true
+	}
+	return nil, false
+}
+
+// negatable qualifiers are negated with a - prefix rather than the NOT operator
+type negatable interface {
+	Expr
+	negate() Expr
+}
+
+// qualifierSet is a set of qualifiers that implicitly AND, such as SearchQualifiers
+type qualifierSet interface {
+	Expr
+	// only returns the single non empty qualifier of the set, if it has exactly one
+	only() (Expr, bool)
+}
+
+// scoped expressions belong to a search type, e.g. issues, or to none if they are empty or text
+type scoped interface {
+	Expr
+	scope() string
+}
+
+const issuesScope = "issues"
+
+// nested returns the string of expr within a group or Not, parenthesizing qualifier sets that implicitly AND
+func nested(expr Expr) string {
+	if _, ok := expr.(qualifierSet); ok && expr.operators() > 0 {
+		return "(" + expr.String() + ")"
+	}
+	return expr.String()
+}
+
+const (
+	opAnd = "AND"
+	opOr  = "OR"
+	opNot = "NOT"
+)
+
+type group struct {
+	op    string
+	exprs []Expr
+}
+
+func (g group) String() string {
+	parts := make([]string, len(g.exprs))
+	for idx, expr := range g.exprs {
+		parts[idx] = nested(expr)
+	}
+	return "(" + strings.Join(parts, " "+g.op+" ") + ")"
+}
+
+func (g group) operators() int {
+	n := len(g.exprs) - 1
+	for _, expr := range g.exprs {
+		n += expr.operators()
+	}
+	return n
+}
+
+type not struct {
+	expr Expr
+}
+
+func (n not) String() string {
+	return opNot + " " + nested(n.expr)
+}
+
+func (n not) operators() int {
+	return 1 + n.expr.operators()
+}
+
+type invalid struct {
+	err error
+}
+
+func (i invalid) String() string {
+	return ""
+}
+
+func (i invalid) operators() int {
+	return 0
+}
+
+// Or matches either of exprs, e.g. (label:"p0" OR label:"p1")
+func Or(exprs ...Expr) Expr {
+	return newGroup(opOr, exprs)
+}
+
+// And matches all of exprs in an explicit group, e.g. (label:"bug" AND is:open)
+func And(exprs ...Expr) Expr {
+	return newGroup(opAnd, exprs)
+}
+
+func newGroup(op string, exprs []Expr) Expr {
+	switch len(exprs) {
+	case 0:
+		return invalid{err: fmt.Errorf("%w: %s without expressions", ErrEmptyGroup, op)}
+	case 1:
+		return exprs[0]
+	default:
+		return group{op: op, exprs: exprs}
+	}
+}
+
+// Not excludes expr, qualifiers are negated with a - prefix, groups and qualifier sets with the NOT operator
+func Not(expr Expr) Expr {
+	if set, ok := expr.(qualifierSet); ok {
+		if only, ok := set.only(); ok {
+			expr = only
+		}
+	}
+	if q, ok := expr.(negatable); ok {
+		return q.negate()
+	}
+	return not{expr: expr}
+}
+
+func Label(label string) SearchQualifier {
+	return HasLabel(label)
+}
+
+// QueryBuilder builds a search query out of expressions that must all match
+type QueryBuilder struct {
+	exprs []Expr
+}
+
+// Query starts a search query matching all of exprs
+//
+//	q, err := sq.Query().Repo("owner", "repo").Label("bug").Or(sq.Label("p0"), sq.Label("p1")).Not(sq.IsDraft).Build()
+func Query(exprs ...Expr) *QueryBuilder {
+	return (&QueryBuilder{}).Where(exprs...)
+}
+
+// Where adds exprs that must all match
+func (q *QueryBuilder) Where(exprs ...Expr) *QueryBuilder {
+	q.exprs = append(q.exprs, exprs...)
+	return q
+}
+
+func (q *QueryBuilder) Repo(owner string, repo string) *QueryBuilder {
+	return q.Where(InRepo(owner, repo))
+}
+
+func (q *QueryBuilder) Org(org string) *QueryBuilder {
+	return q.Where(InReposOwnedByOrg(org))
+}
+
+func (q *QueryBuilder) Author(user string) *QueryBuilder {
+	return q.Where(IsAuthoredBy(user))
+}
+
+func (q *QueryBuilder) Label(label string) *QueryBuilder {
+	return q.Where(Label(label))
+}
+
+func (q *QueryBuilder) Text(text string) *QueryBuilder {
+	return q.Where(HasText(text))
+}
+
+// Or adds a group of which any expression must match
+func (q *QueryBuilder) Or(exprs ...Expr) *QueryBuilder {
+	return q.Where(Or(exprs...))
+}
+
+// Not adds an expression that must not match
+func (q *QueryBuilder) Not(expr Expr) *QueryBuilder {
+	return q.Where(Not(expr))
+}
+
+// String returns the query without validating it
+func (q *QueryBuilder) String() string {
+	parts := make([]string, 0, len(q.exprs))
+	for _, expr := range q.exprs {
+		if s := expr.String(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Build returns the query, failing if it is invalid or exceeds MaxQueryLength or MaxOperators
+func (q *QueryBuilder) Build() (string, error) {
+	if _, err := commonScope(q.exprs); err != nil {
+		return "", err
+	}
+	var operators int
+	for _, expr := range q.exprs {
+		if err := validate(expr); err != nil {
+			return "", err
+		}
+		operators += expr.operators()
+	}
+	if operators > MaxOperators {
+		return "", fmt.Errorf("%w: %d AND, OR and NOT operators, GitHub accepts %d", ErrTooManyOperators, operators, MaxOperators)
+	}
+	query := q.String()
+	if len(query) > MaxQueryLength {
+		return "", fmt.Errorf("%w: %d characters, GitHub accepts %d", ErrQueryTooLong, len(query), MaxQueryLength)
+	}
+	return query, nil
+}
+
+// validate returns the error of the first invalid expression within expr
+func validate(expr Expr) error {
+	switch e := expr.(type) {
+	case invalid:
+		return e.err
+	case group:
+		for _, expr := range e.exprs {
+			if err := validate(expr); err != nil {
+				return err
+			}
+		}
+	case not:
+		return validate(e.expr)
+	}
+	return nil
+}
+
+// commonScope returns the search type of the qualifiers within exprs, failing with ErrMixedScopes if they differ
+func commonScope(exprs []Expr) (string, error) {
+	var common string
+	for _, expr := range exprs {
+		var (
+			scope string
+			err   error
+		)
+		switch e := expr.(type) {
+		case group:
+			scope, err = commonScope(e.exprs)
+		case not:
+			scope, err = commonScope([]Expr{e.expr})
+		case scoped:
+			scope = e.scope()
+		}
+		switch {
+		case err != nil:
+			return "", err
+		case scope == "" || scope == common:
+		case common == "":
+			common = scope
+		default:
+			return "", fmt.Errorf("%w: %s and %s", ErrMixedScopes, common, scope)
+		}
+	}
+	return common, nil
+}
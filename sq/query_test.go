@@ -0,0 +1,133 @@
+package sq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		query       *QueryBuilder
+		expectQuery string
+		expectErr   error
+	}{
+		{
+			name:  "ok - empty",
+			query: Query(),
+		},
+		{
+			name:        "ok - qualifiers",
+			query:       Query(IsOpen).Repo("owner", "repo").Label("bug"),
+			expectQuery: `state:open repo:"owner/repo" label:"bug"`,
+		},
+		{
+			name:        "ok - or group",
+			query:       Query().Repo("owner", "repo").Label("bug").Or(Label("p0"), Label("p1")).Not(IsDraft),
+			expectQuery: `repo:"owner/repo" label:"bug" (label:"p0" OR label:"p1") -draft:true`,
+		},
+		{
+			name:        "ok - single expression or is not grouped",
+			query:       Query().Or(Label("p0")),
+			expectQuery: `label:"p0"`,
+		},
+		{
+			name:        "ok - not negated qualifier",
+			query:       Query().Not(HasNoLinkedPR),
+			expectQuery: `linked:pr`,
+		},
+		{
+			name:        "ok - nested groups",
+			query:       Query().Or(And(Label("bug"), IsOpen), Not(Or(Label("p0"), Label("p1")))),
+			expectQuery: `((label:"bug" AND state:open) OR NOT (label:"p0" OR label:"p1"))`,
+		},
+		{
+			name:        "ok - not qualifiers",
+			query:       Query().Not(SearchQualifiers{Label("a"), Label("b")}),
+			expectQuery: `NOT (label:"a" label:"b")`,
+		},
+		{
+			name:        "ok - not single qualifier",
+			query:       Query().Not(SearchQualifiers{Label("a"), ""}),
+			expectQuery: `-label:"a"`,
+		},
+		{
+			name:        "ok - or qualifiers",
+			query:       Query().Or(SearchQualifiers{Label("a"), IsOpen}, Label("b")),
+			expectQuery: `((label:"a" state:open) OR label:"b")`,
+		},
+		{
+			name:        "ok - qualifiers count implicit ands",
+			query:       Query().Or(SearchQualifiers{Label("a"), Label("b"), Label("c")}, SearchQualifiers{Label("d"), Label("e"), Label("f")}),
+			expectQuery: `((label:"a" label:"b" label:"c") OR (label:"d" label:"e" label:"f"))`,
+		},
+		{
+			name:      "err - too many implicit ands",
+			query:     Query().Not(SearchQualifiers{Label("a"), Label("b"), Label("c"), Label("d"), Label("e"), Label("f")}),
+			expectErr: ErrTooManyOperators,
+		},
+		{
+			name:        "ok - not all labels",
+			query:       Query().Not(HasAllLabels("bug", "help wanted")),
+			expectQuery: `NOT (label:"bug" label:"help wanted")`,
+		},
+		{
+			name:        "ok - not none of labels",
+			query:       Query().Not(HasNoneOfLabels("bug", "p0")),
+			expectQuery: `NOT (-label:"bug" -label:"p0")`,
+		},
+		{
+			name:        "ok - not single label set",
+			query:       Query().Not(HasNoneOfLabels("bug")),
+			expectQuery: `label:"bug"`,
+		},
+		{
+			name:        "ok - or label sets",
+			query:       Query().Or(HasAllLabels("bug", "p0"), HasNoneOfLabels("wontfix", "duplicate")),
+			expectQuery: `((label:"bug" label:"p0") OR (-label:"wontfix" -label:"duplicate"))`,
+		},
+		{
+			name:        "ok - or any label with all labels",
+			query:       Query().Or(HasAnyLabel("p0", "p1"), HasAllLabels("bug", "regression")),
+			expectQuery: `(label:"p0","p1" OR (label:"bug" label:"regression"))`,
+		},
+		{
+			name:      "err - label sets exceed operators",
+			query:     Query().Or(HasAllLabels("a", "b", "c"), HasNoneOfLabels("d", "e", "f")).Not(HasAllLabels("g", "h")),
+			expectErr: ErrTooManyOperators,
+		},
+		{
+			name:      "err - empty group",
+			query:     Query().Where(Or(Label("p0"), And())),
+			expectErr: ErrEmptyGroup,
+		},
+		{
+			name:      "err - too many operators",
+			query:     Query().Or(Label("a"), Label("b"), Label("c"), Label("d")).Or(Label("e"), Label("f"), Label("g"), Label("h")),
+			expectErr: ErrTooManyOperators,
+		},
+		{
+			name:      "err - too long",
+			query:     Query().Text(strings.Repeat("a", MaxQueryLength)),
+			expectErr: ErrQueryTooLong,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := tc.query.Build()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectQuery, query)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package sq
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// rangeValue matches raw range syntax, e.g. 5, >=2024-01-01, 10..*, 2024-01-01T00:00:00Z..2024-02-01
+var rangeValue = regexp.MustCompile(`^(?:[<>]=?)?(?:\*|[0-9][0-9A-Za-z:+.-]*)$|^(?:\*|[0-9][0-9A-Za-z:+-]*)\.\.(?:\*|[0-9][0-9A-Za-z:+-]*)$`)
+
+// Me is GitHub's macro for the authenticated user
+const Me = "@me"
+
+// Quote returns value as GitHub search syntax: ranges, numeric comparisons and @me stay raw
+// as GitHub does not parse them within quotes, everything else is quoted and escaped
+func Quote(value string) string {
+	if value == Me || rangeValue.MatchString(value) {
+		return value
+	}
+	return strconv.Quote(value)
+}
@@ -0,0 +1,109 @@
+package sq
+
+import (
+	"strconv"
+	"time"
+)
+
+// Bound is a date or number bound of a Range
+type Bound interface {
+	int | time.Time
+}
+
+// Range is the value of date and number qualifiers such as created: or comments:
+type Range string
+
+func (r Range) String() string {
+	return string(r)
+}
+
+// unbounded is GitHub's open end of a range
+const unbounded = "*"
+
+// Exactly matches v itself, for dates the whole day or the exact second of a timestamp
+func Exactly[T Bound](v T) Range {
+	return Range(formatBound(v))
+}
+
+// After matches values greater than v
+func After[T Bound](v T) Range {
+	return Range(">" + formatBound(v))
+}
+
+// AtLeast matches values greater than or equal to v
+func AtLeast[T Bound](v T) Range {
+	return Range(">=" + formatBound(v))
+}
+
+// Before matches values less than v
+func Before[T Bound](v T) Range {
+	return Range("<" + formatBound(v))
+}
+
+// AtMost matches values less than or equal to v
+func AtMost[T Bound](v T) Range {
+	return Range("<=" + formatBound(v))
+}
+
+// Between matches values from through to, both inclusive
+func Between[T Bound](from T, to T) Range {
+	return Range(formatBound(from) + ".." + formatBound(to))
+}
+
+// From matches values from v on, i.e. v..*
+func From[T Bound](v T) Range {
+	return Range(formatBound(v) + ".." + unbounded)
+}
+
+// Until matches values up to and including v, i.e. *..v
+func Until[T Bound](v T) Range {
+	return Range(unbounded + ".." + formatBound(v))
+}
+
+// formatBound formats numbers as is and dates as YYYY-MM-DD if they are midnight UTC, which GitHub takes as the whole day,
+// otherwise as ISO8601 timestamps with their timezone, e.g. 2017-01-01T01:00:00+07:00
+func formatBound[T Bound](v T) string {
+	switch v := any(v).(type) {
+	case int:
+		return strconv.Itoa(v)
+	case time.Time:
+		if v.Location() == time.UTC && v.Equal(v.Truncate(24*time.Hour)) {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format(time.RFC3339)
+	default:
+		panic("unreachable")
+	}
+}
+
+func newRangeQualifier(key string, r Range) SearchQualifier {
+	return SearchQualifier(key + ":" + r.String())
+}
+
+func Created(r Range) SearchQualifier {
+	return newRangeQualifier("created", r)
+}
+
+func Updated(r Range) SearchQualifier {
+	return newRangeQualifier("updated", r)
+}
+
+func Closed(r Range) SearchQualifier {
+	return newRangeQualifier("closed", r)
+}
+
+func Merged(r Range) SearchQualifier {
+	return newRangeQualifier("merged", r)
+}
+
+func Comments(r Range) SearchQualifier {
+	return newRangeQualifier("comments", r)
+}
+
+func Interactions(r Range) SearchQualifier {
+	return newRangeQualifier("interactions", r)
+}
+
+func Reactions(r Range) SearchQualifier {
+	return newRangeQualifier("reactions", r)
+}
@@ -0,0 +1,43 @@
+package sq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRanges(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2020, time.March, 4, 5, 6, 7, 0, time.UTC)
+	zoned := time.Date(2017, time.January, 1, 1, 0, 0, 0, time.FixedZone("", 7*60*60))
+
+	for _, tc := range []struct {
+		expect    string
+		qualifier SearchQualifier
+	}{
+		{expect: "created:2020-01-02", qualifier: Created(Exactly(day))},
+		{expect: "created:>2020-01-02", qualifier: Created(After(day))},
+		{expect: "updated:>=2020-03-04T05:06:07Z", qualifier: Updated(AtLeast(later))},
+		{expect: "closed:<2017-01-01T01:00:00+07:00", qualifier: Closed(Before(zoned))},
+		{expect: "merged:<=2020-01-02", qualifier: Merged(AtMost(day))},
+		{expect: "created:2020-01-02..2020-03-04T05:06:07Z", qualifier: Created(Between(day, later))},
+		{expect: "created:2020-01-02..*", qualifier: Created(From(day))},
+		{expect: "updated:*..2020-01-02", qualifier: Updated(Until(day))},
+		{expect: "comments:5", qualifier: Comments(Exactly(5))},
+		{expect: "comments:>=10", qualifier: Comments(AtLeast(10))},
+		{expect: "interactions:>100", qualifier: Interactions(After(100))},
+		{expect: "reactions:500..1000", qualifier: Reactions(Between(500, 1000))},
+		{expect: "reactions:*..10", qualifier: Reactions(Until(10))},
+		{expect: "reactions:<1", qualifier: Reactions(Before(1))},
+		{expect: "comments:<=3", qualifier: Comments(AtMost(3))},
+	} {
+		tc := tc
+		t.Run(tc.expect, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, tc.qualifier.String())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Based on https://docs.github.com/en/search-github/searching-on-github/searching-for-repositories
+
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/bevicted/ghx/sq"
+)
+
+type scope struct{}
+
+type Qualifier = sq.Qualifier[scope]
+
+type Qualifiers = sq.Qualifiers[scope]
+
+const (
+	InName        Qualifier = "in:name"
+	InDescription Qualifier = "in:description"
+	InTopics      Qualifier = "in:topics"
+	InReadme      Qualifier = "in:readme"
+
+	IncludeForks Qualifier = "fork:true"
+	OnlyForks    Qualifier = "fork:only"
+
+	IsPublic    Qualifier = "is:public"
+	IsPrivate   Qualifier = "is:private"
+	IsTemplate  Qualifier = "is:template"
+	IsMirror    Qualifier = "mirror:true"
+	IsNotMirror Qualifier = "mirror:false"
+
+	IsArchived    Qualifier = "archived:true"
+	IsNotArchived Qualifier = "archived:false"
+)
+
+func InRepo(owner string, repo string) Qualifier {
+	return sq.NewQualifier[scope]("repo", fmt.Sprintf("%s/%s", owner, repo))
+}
+
+func OwnedByUser(user string) Qualifier {
+	return sq.NewQualifier[scope]("user", user)
+}
+
+func OwnedByOrg(org string) Qualifier {
+	return sq.NewQualifier[scope]("org", org)
+}
+
+func IsWrittenInLanguage(language string) Qualifier {
+	return sq.NewQualifier[scope]("language", language)
+}
+
+func Topic(topic string) Qualifier {
+	return sq.NewQualifier[scope]("topic", topic)
+}
+
+// License matches a license keyword, e.g. apache-2.0
+func License(license string) Qualifier {
+	return sq.NewQualifier[scope]("license", license)
+}
+
+func Stars(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("stars", r)
+}
+
+func Forks(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("forks", r)
+}
+
+func Followers(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("followers", r)
+}
+
+// Size matches repository sizes in kilobytes
+func Size(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("size", r)
+}
+
+// Topics matches the number of topics
+func Topics(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("topics", r)
+}
+
+func GoodFirstIssues(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("good-first-issues", r)
+}
+
+func Created(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("created", r)
+}
+
+func Pushed(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("pushed", r)
+}
+
+func HasText(text string) Qualifier {
+	return sq.NewTextQualifier[scope](text)
+}
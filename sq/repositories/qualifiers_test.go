@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx/sq"
+	"github.com/bevicted/ghx/sq/code"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualifiers(t *testing.T) {
+	t.Parallel()
+
+	eq := func(s string, q Qualifier) {
+		assert.Equal(t, s, string(q))
+	}
+	day := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	eq(`repo:"owner/repo"`, InRepo("owner", "repo"))
+	eq(`user:"user"`, OwnedByUser("user"))
+	eq(`org:"org"`, OwnedByOrg("org"))
+	eq(`language:"go"`, IsWrittenInLanguage("go"))
+	eq(`topic:"cli"`, Topic("cli"))
+	eq(`license:"apache-2.0"`, License("apache-2.0"))
+	eq(`stars:>=100`, Stars(sq.AtLeast(100)))
+	eq(`forks:5`, Forks(sq.Exactly(5)))
+	eq(`followers:10..*`, Followers(sq.From(10)))
+	eq(`size:<1000`, Size(sq.Before(1000)))
+	eq(`topics:>3`, Topics(sq.After(3)))
+	eq(`good-first-issues:>=1`, GoodFirstIssues(sq.AtLeast(1)))
+	eq(`created:<2020-01-02`, Created(sq.Before(day)))
+	eq(`pushed:>2020-01-02`, Pushed(sq.After(day)))
+	eq(`"text"`, HasText("text"))
+
+	assert.Equal(t, `fork:only stars:>=100`, Qualifiers{OnlyForks, Stars(sq.AtLeast(100))}.String())
+}
+
+func TestMixedScopes(t *testing.T) {
+	t.Parallel()
+
+	_, err := sq.Query(Stars(sq.AtLeast(100)), sq.IsDraft, code.Symbol("main")).Build()
+	require.ErrorIs(t, err, sq.ErrMixedScopes)
+	_, err = sq.Query(Stars(sq.AtLeast(100)), code.Symbol("main")).Build()
+	require.ErrorIs(t, err, sq.ErrMixedScopes)
+	q, err := sq.Query(Stars(sq.AtLeast(100)), HasText("cli")).Or(Topic("go"), Topic("rust")).Build()
+	require.NoError(t, err)
+	assert.Equal(t, `stars:>=100 "cli" (topic:"go" OR topic:"rust")`, q)
+}
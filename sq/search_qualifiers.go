@@ -4,6 +4,7 @@ package sq
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -17,8 +18,11 @@ type SearchQualifiers []SearchQualifier
 
 func (s SearchQualifiers) String() string {
 	var query strings.Builder
-	for idx, qualifier := range s {
-		if idx != 0 {
+	for _, qualifier := range s {
+		if qualifier == "" {
+			continue
+		}
+		if query.Len() != 0 {
 			if _, err := query.WriteString(" "); err != nil {
 				panic(err)
 			}
@@ -81,7 +85,7 @@ const (
 )
 
 func newSearchQualifier(key string, value string) SearchQualifier {
-	return SearchQualifier(fmt.Sprintf("%s:%q", key, value))
+	return SearchQualifier(key + ":" + Quote(value))
 }
 
 func IsReviewedBy(user string) SearchQualifier {
@@ -144,18 +148,54 @@ func HasLabel(label string) SearchQualifier {
 	return newSearchQualifier("label", label)
 }
 
+// Deprecated: HasLabels quotes labels as a single comma separated value, which GitHub matches as one label,
+// use HasAllLabels or HasAnyLabel instead
 func HasLabels(labels ...string) SearchQualifier {
 	return HasLabel(strings.Join(labels, ","))
 }
 
+// HasAnyLabel matches issues with at least one of labels, e.g. label:bug,"help wanted"
+func HasAnyLabel(labels ...string) SearchQualifier {
+	return SearchQualifier("label:" + quoteList(labels))
+}
+
+// HasAllLabels matches issues with every one of labels, e.g. label:bug label:"help wanted"
+func HasAllLabels(labels ...string) SearchQualifiers {
+	qualifiers := make(SearchQualifiers, len(labels))
+	for idx, label := range labels {
+		qualifiers[idx] = HasLabel(label)
+	}
+	return qualifiers
+}
+
 func LacksLabel(label string) SearchQualifier {
 	return newSearchQualifier("-label", label)
 }
 
+// Deprecated: LacksLabels quotes labels as a single comma separated value, which GitHub matches as one label,
+// use HasNoneOfLabels instead
 func LacksLabels(labels ...string) SearchQualifier {
 	return LacksLabel(strings.Join(labels, ","))
 }
 
+// HasNoneOfLabels matches issues with none of labels, e.g. -label:bug -label:"help wanted"
+func HasNoneOfLabels(labels ...string) SearchQualifiers {
+	qualifiers := make(SearchQualifiers, len(labels))
+	for idx, label := range labels {
+		qualifiers[idx] = LacksLabel(label)
+	}
+	return qualifiers
+}
+
+// quoteList quotes every value on its own and joins them with commas, GitHub's syntax for any of values
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for idx, value := range values {
+		quoted[idx] = Quote(value)
+	}
+	return strings.Join(quoted, ",")
+}
+
 func InMilestone(milestone string) SearchQualifier {
 	return newSearchQualifier("milestone", milestone)
 }
@@ -176,41 +216,41 @@ func IsWrittenInLanguage(language string) SearchQualifier {
 	return newSearchQualifier("language", language)
 }
 
-// e.g.: 0, >100, 500..1000
+// e.g.: 0, >100, 500..1000, see Comments for typed ranges
 func HasNumberOfComments(quantity string) SearchQualifier {
 	return newSearchQualifier("comments", quantity)
 }
 
-// e.g.: 0, >100, 500..1000
+// e.g.: 0, >100, 500..1000, see Interactions for typed ranges
 func HasNumberOfInteractions(quantity string) SearchQualifier {
 	return newSearchQualifier("interactions", quantity)
 }
 
-// e.g.: 0, >100, 500..1000
+// e.g.: 0, >100, 500..1000, see Reactions for typed ranges
 func HasNumberOfReactions(quantity string) SearchQualifier {
 	return newSearchQualifier("reactions", quantity)
 }
 
-// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD
+// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD, see Created for typed ranges
 func WasCreated(iso8601Date string) SearchQualifier {
 	return newSearchQualifier("created", iso8601Date)
 }
 
-// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD
+// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD, see Updated for typed ranges
 func WasUpdated(iso8601Date string) SearchQualifier {
 	return newSearchQualifier("updated", iso8601Date)
 }
 
-// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD
+// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD, see Closed for typed ranges
 func WasClosed(iso8601Date string) SearchQualifier {
 	return newSearchQualifier("closed", iso8601Date)
 }
 
-// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD
+// YYYY-MM-DD, >=YYYY-MM-DD, YYYY-MM-DD..YYYY-MM-DD, *..YYYY-MM-DD, see Merged for typed ranges
 func WasMerged(iso8601Date string) SearchQualifier {
 	return newSearchQualifier("merged", iso8601Date)
 }
 
 func HasText(text string) SearchQualifier {
-	return SearchQualifier(fmt.Sprintf("%q", text))
+	return SearchQualifier(strconv.Quote(text))
 }
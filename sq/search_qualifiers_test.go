@@ -36,12 +36,51 @@ func TestSearchQualifiers(t *testing.T) {
 	eq(`head:"branch"`, HasMergeSourceBranch("branch"))
 	eq(`base:"branch"`, HasMergeTargetBranch("branch"))
 	eq(`language:"lang"`, IsWrittenInLanguage("lang"))
-	eq(`comments:"5"`, HasNumberOfComments("5"))
-	eq(`interactions:">5"`, HasNumberOfInteractions(">5"))
-	eq(`reactions:"5..10"`, HasNumberOfReactions("5..10"))
-	eq(`created:"2006-01-02"`, WasCreated("2006-01-02"))
-	eq(`updated:">2006-01-02"`, WasUpdated(">2006-01-02"))
-	eq(`closed:"2006-01-02..2006-02-03"`, WasClosed("2006-01-02..2006-02-03"))
+	eq(`comments:5`, HasNumberOfComments("5"))
+	eq(`interactions:>5`, HasNumberOfInteractions(">5"))
+	eq(`reactions:5..10`, HasNumberOfReactions("5..10"))
+	eq(`created:2006-01-02`, WasCreated("2006-01-02"))
+	eq(`updated:>2006-01-02`, WasUpdated(">2006-01-02"))
+	eq(`closed:2006-01-02..2006-02-03`, WasClosed("2006-01-02..2006-02-03"))
 	eq(`merged:"<=2006=01-02"`, WasMerged("<=2006=01-02"))
 	eq(`"text"`, HasText("text"))
+	eq(`author:@me`, IsAuthoredBy(Me))
+	eq(`label:"bug","help wanted"`, HasAnyLabel("bug", "help wanted"))
+	eq(`label:"bug"`, HasAnyLabel("bug"))
+	eq(`label:"a,b","say \"hi\""`, HasAnyLabel("a,b", `say "hi"`))
+
+	assert.Equal(t, `label:"bug" label:"help wanted"`, HasAllLabels("bug", "help wanted").String())
+	assert.Equal(t, `label:"a,b"`, HasAllLabels("a,b").String())
+	assert.Empty(t, HasAllLabels().String())
+	assert.Equal(t, `-label:"bug" -label:"help wanted"`, HasNoneOfLabels("bug", "help wanted").String())
+	assert.Equal(t, `-label:"a,b"`, HasNoneOfLabels("a,b").String())
+	assert.Empty(t, HasNoneOfLabels().String())
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		value  string
+		expect string
+	}{
+		{value: "@me", expect: "@me"},
+		{value: "5", expect: "5"},
+		{value: ">=2024-01-01", expect: ">=2024-01-01"},
+		{value: "<10", expect: "<10"},
+		{value: "10..*", expect: "10..*"},
+		{value: "*..2024-01-01T10:00:00+02:00", expect: "*..2024-01-01T10:00:00+02:00"},
+		{value: "bug", expect: `"bug"`},
+		{value: "help wanted", expect: `"help wanted"`},
+		{value: `say "hi"`, expect: `"say \"hi\""`},
+		{value: "a:b", expect: `"a:b"`},
+		{value: "@someone", expect: `"@someone"`},
+		{value: "", expect: `""`},
+	} {
+		tc := tc
+		t.Run(tc.value, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, Quote(tc.value))
+		})
+	}
 }
@@ -0,0 +1,46 @@
+package sq
+
+import (
+	"fmt"
+
+	"github.com/bevicted/ghx/state"
+)
+
+// State returns the qualifier of s, state.All matches any state and has no qualifier.
+// Unknown states fail Build.
+func State(s state.State) Expr {
+	switch s {
+	case state.Open:
+		return IsOpen
+	case state.Closed:
+		return IsClosed
+	case state.All:
+		return SearchQualifier("")
+	default:
+		return invalid{err: fmt.Errorf("%w: %d", state.ErrUnknown, s)}
+	}
+}
+
+// Reason returns the qualifier of r, unknown reasons and state.ReasonReopened, which cannot be searched for, fail Build
+func Reason(r state.Reason) Expr {
+	switch r {
+	case state.ReasonCompleted:
+		return IsClosedBecauseCompleted
+	case state.ReasonNotPlanned:
+		return IsClosedBecauseNotPlanned
+	case state.ReasonReopened:
+		return invalid{err: fmt.Errorf("%w: reason %q cannot be searched for", state.ErrUnknownReason, r)}
+	default:
+		return invalid{err: fmt.Errorf("%w: %d", state.ErrUnknownReason, r)}
+	}
+}
+
+// State adds the qualifier of s, failing Build for unknown states
+func (q *QueryBuilder) State(s state.State) *QueryBuilder {
+	return q.Where(State(s))
+}
+
+// Reason adds the qualifier of r, failing Build for unknown reasons and state.ReasonReopened
+func (q *QueryBuilder) Reason(r state.Reason) *QueryBuilder {
+	return q.Where(Reason(r))
+}
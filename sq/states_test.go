@@ -0,0 +1,35 @@
+package sq
+
+import (
+	"testing"
+
+	"github.com/bevicted/ghx/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStates(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, IsOpen, State(state.Open))
+	assert.Equal(t, IsClosed, State(state.Closed))
+	assert.Empty(t, State(state.All).String())
+	assert.Equal(t, IsClosedBecauseCompleted, Reason(state.ReasonCompleted))
+	assert.Equal(t, IsClosedBecauseNotPlanned, Reason(state.ReasonNotPlanned))
+
+	q, err := Query().State(state.All).State(state.Closed).Reason(state.ReasonNotPlanned).Label("bug").Build()
+	require.NoError(t, err)
+	assert.Equal(t, `state:closed reason:"not planned" label:"bug"`, q)
+	q, err = Query(State(state.All), IsClosed, Label("bug")).Build()
+	require.NoError(t, err)
+	assert.Equal(t, `state:closed label:"bug"`, q)
+
+	for _, expr := range []Expr{State(state.State(-1)), Not(State(state.State(-1))), Or(IsOpen, State(state.State(-1)))} {
+		_, err = Query(expr).Build()
+		require.ErrorIs(t, err, state.ErrUnknown)
+	}
+	for _, expr := range []Expr{Reason(state.ReasonReopened), Reason(state.Reason(-1))} {
+		_, err = Query(expr).Build()
+		require.ErrorIs(t, err, state.ErrUnknownReason)
+	}
+}
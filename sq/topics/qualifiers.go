@@ -0,0 +1,31 @@
+// Based on https://docs.github.com/en/search-github/searching-on-github/searching-topics
+
+package topics
+
+import "github.com/bevicted/ghx/sq"
+
+type scope struct{}
+
+type Qualifier = sq.Qualifier[scope]
+
+type Qualifiers = sq.Qualifiers[scope]
+
+const (
+	IsFeatured    Qualifier = "is:featured"
+	IsNotFeatured Qualifier = "-is:featured"
+	IsCurated     Qualifier = "is:curated"
+	IsNotCurated  Qualifier = "-is:curated"
+)
+
+// Repositories matches the number of repositories with the topic
+func Repositories(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("repositories", r)
+}
+
+func Created(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("created", r)
+}
+
+func HasText(text string) Qualifier {
+	return sq.NewTextQualifier[scope](text)
+}
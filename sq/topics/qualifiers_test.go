@@ -0,0 +1,24 @@
+package topics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx/sq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifiers(t *testing.T) {
+	t.Parallel()
+
+	eq := func(s string, q Qualifier) {
+		assert.Equal(t, s, string(q))
+	}
+	day := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	eq(`repositories:>100`, Repositories(sq.After(100)))
+	eq(`created:>2020-01-02`, Created(sq.After(day)))
+	eq(`"text"`, HasText("text"))
+
+	assert.Equal(t, `is:featured repositories:>100`, Qualifiers{IsFeatured, Repositories(sq.After(100))}.String())
+}
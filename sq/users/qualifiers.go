@@ -0,0 +1,55 @@
+// Based on https://docs.github.com/en/search-github/searching-on-github/searching-users
+
+package users
+
+import "github.com/bevicted/ghx/sq"
+
+type scope struct{}
+
+type Qualifier = sq.Qualifier[scope]
+
+type Qualifiers = sq.Qualifiers[scope]
+
+const (
+	IsUser Qualifier = "type:user"
+	IsOrg  Qualifier = "type:org"
+
+	InLogin Qualifier = "in:login"
+	InName  Qualifier = "in:name"
+	InEmail Qualifier = "in:email"
+
+	IsSponsorable Qualifier = "is:sponsorable"
+)
+
+func User(user string) Qualifier {
+	return sq.NewQualifier[scope]("user", user)
+}
+
+func Org(org string) Qualifier {
+	return sq.NewQualifier[scope]("org", org)
+}
+
+func Location(location string) Qualifier {
+	return sq.NewQualifier[scope]("location", location)
+}
+
+func IsWrittenInLanguage(language string) Qualifier {
+	return sq.NewQualifier[scope]("language", language)
+}
+
+func Followers(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("followers", r)
+}
+
+// Repos matches the number of repositories
+func Repos(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("repos", r)
+}
+
+func Created(r sq.Range) Qualifier {
+	return sq.NewRangeQualifier[scope]("created", r)
+}
+
+func HasText(text string) Qualifier {
+	return sq.NewTextQualifier[scope](text)
+}
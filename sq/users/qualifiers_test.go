@@ -0,0 +1,29 @@
+package users
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bevicted/ghx/sq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifiers(t *testing.T) {
+	t.Parallel()
+
+	eq := func(s string, q Qualifier) {
+		assert.Equal(t, s, string(q))
+	}
+	day := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	eq(`user:"user"`, User("user"))
+	eq(`org:"org"`, Org("org"))
+	eq(`location:"Berlin"`, Location("Berlin"))
+	eq(`language:"go"`, IsWrittenInLanguage("go"))
+	eq(`followers:>=1000`, Followers(sq.AtLeast(1000)))
+	eq(`repos:>10`, Repos(sq.After(10)))
+	eq(`created:2020-01-02`, Created(sq.Exactly(day)))
+	eq(`"text"`, HasText("text"))
+
+	assert.Equal(t, `type:org location:"Berlin"`, Qualifiers{IsOrg, Location("Berlin")}.String())
+}
@@ -0,0 +1,90 @@
+// Package state holds the issue and pull request states shared by ghx and sq, ghx re-exports them.
+// It has no dependencies so that packages ghx depends on can use them.
+package state
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknown       = errors.New("unknown state")
+	ErrUnknownReason = errors.New("unknown state reason")
+)
+
+type (
+	State  int
+	Reason int
+)
+
+const (
+	// Invalid is returned by Parse on error
+	Invalid State = iota - 1
+	Open
+	Closed
+	All
+)
+
+const (
+	// ReasonInvalid is returned by ParseReason on error
+	ReasonInvalid Reason = iota - 1
+	ReasonCompleted
+	ReasonNotPlanned
+	ReasonReopened
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case Closed:
+		return "closed"
+	case All:
+		return "all"
+	default:
+		return ""
+	}
+}
+
+func (r Reason) String() string {
+	switch r {
+	case ReasonCompleted:
+		return "completed"
+	case ReasonNotPlanned:
+		return "not_planned"
+	case ReasonReopened:
+		return "reopened"
+	default:
+		return ""
+	}
+}
+
+func (s State) StringP() *string {
+	v := s.String()
+	return &v
+}
+
+func (r Reason) StringP() *string {
+	v := r.String()
+	return &v
+}
+
+// Parse parses the state values of the API, e.g. github.Issue.State, returning Invalid on error
+func Parse(s string) (State, error) {
+	for _, state := range []State{Open, Closed, All} {
+		if state.String() == s {
+			return state, nil
+		}
+	}
+	return Invalid, fmt.Errorf("%w: %q", ErrUnknown, s)
+}
+
+// ParseReason parses the state reason values of the API, e.g. github.Issue.StateReason, returning ReasonInvalid on error
+func ParseReason(s string) (Reason, error) {
+	for _, reason := range []Reason{ReasonCompleted, ReasonNotPlanned, ReasonReopened} {
+		if reason.String() == s {
+			return reason, nil
+		}
+	}
+	return ReasonInvalid, fmt.Errorf("%w: %q", ErrUnknownReason, s)
+}
@@ -0,0 +1,131 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		state        State
+		expectString string
+	}{
+		{
+			state:        Open,
+			expectString: "open",
+		},
+		{
+			state:        Closed,
+			expectString: "closed",
+		},
+		{
+			state:        All,
+			expectString: "all",
+		},
+		{
+			state: Invalid,
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%v == %s", tc.state, tc.expectString), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectString, *tc.state.StringP())
+		})
+	}
+}
+
+func TestReason(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		reason       Reason
+		expectString string
+	}{
+		{
+			reason:       ReasonCompleted,
+			expectString: "completed",
+		},
+		{
+			reason:       ReasonNotPlanned,
+			expectString: "not_planned",
+		},
+		{
+			reason:       ReasonReopened,
+			expectString: "reopened",
+		},
+		{
+			reason: ReasonInvalid,
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%v == %s", tc.reason, tc.expectString), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectString, *tc.reason.StringP())
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s         string
+		expect    State
+		expectErr error
+	}{
+		{s: "open", expect: Open},
+		{s: "closed", expect: Closed},
+		{s: "all", expect: All},
+		{s: "", expect: Invalid, expectErr: ErrUnknown},
+		{s: "merged", expect: Invalid, expectErr: ErrUnknown},
+	} {
+		tc := tc
+		t.Run(tc.s, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := Parse(tc.s)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				assert.Equal(t, tc.expect, actual)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, actual)
+		})
+	}
+}
+
+func TestParseReason(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s         string
+		expect    Reason
+		expectErr error
+	}{
+		{s: "completed", expect: ReasonCompleted},
+		{s: "not_planned", expect: ReasonNotPlanned},
+		{s: "reopened", expect: ReasonReopened},
+		{s: "", expect: ReasonInvalid, expectErr: ErrUnknownReason},
+		{s: "not planned", expect: ReasonInvalid, expectErr: ErrUnknownReason},
+	} {
+		tc := tc
+		t.Run(tc.s, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseReason(tc.s)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				assert.Equal(t, tc.expect, actual)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, actual)
+		})
+	}
+}
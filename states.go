@@ -1,54 +1,38 @@
 package ghx
 
+import "github.com/bevicted/ghx/state"
+
+var (
+	ErrUnknownState       = state.ErrUnknown
+	ErrUnknownStateReason = state.ErrUnknownReason
+)
+
 type (
-	State       int
-	StateReason int
+	State       = state.State
+	StateReason = state.Reason
 )
 
 const (
-	StateOpen State = iota
-	StateClosed
-	StateAll
+	StateInvalid = state.Invalid
+	StateOpen    = state.Open
+	StateClosed  = state.Closed
+	StateAll     = state.All
 )
 
 const (
-	StateReasonCompleted StateReason = iota
-	StateReasonNotPlanned
-	StateReasonReopened
+	StateReasonInvalid    = state.ReasonInvalid
+	StateReasonCompleted  = state.ReasonCompleted
+	StateReasonNotPlanned = state.ReasonNotPlanned
+	StateReasonReopened   = state.ReasonReopened
 )
 
-func (s State) String() string {
-	switch s {
-	case StateOpen:
-		return "open"
-	case StateClosed:
-		return "closed"
-	case StateAll:
-		return "all"
-	default:
-		return ""
-	}
-}
-
-func (sr StateReason) String() string {
-	switch sr {
-	case StateReasonCompleted:
-		return "completed"
-	case StateReasonNotPlanned:
-		return "not_planned"
-	case StateReasonReopened:
-		return "reopened"
-	default:
-		return ""
-	}
-}
-
-func (s State) StringP() *string {
-	v := s.String()
-	return &v
+// ParseState parses the state values of the API, e.g. github.Issue.State, returning StateInvalid on error
+func ParseState(s string) (State, error) {
+	return state.Parse(s)
 }
 
-func (sr StateReason) StringP() *string {
-	v := sr.String()
-	return &v
+// ParseStateReason parses the state reason values of the API, e.g. github.Issue.StateReason,
+// returning StateReasonInvalid on error
+func ParseStateReason(s string) (StateReason, error) {
+	return state.ParseReason(s)
 }
@@ -4,8 +4,20 @@ import "github.com/google/go-github/v62/github"
 
 const MaxPerPage = 100
 
-type IssueHandler func(*github.Issue) error
-type PullRequestHandler func(*github.PullRequest) error
+type (
+	CommitFileHandler        func(*github.CommitFile) error
+	DeploymentHandler        func(*github.Deployment) error
+	IssueCommentHandler      func(*github.IssueComment) error
+	IssueHandler             func(*github.Issue) error
+	NotificationHandler      func(*github.Notification) error
+	PullRequestHandler       func(*github.PullRequest) error
+	PullRequestReviewHandler func(*github.PullRequestReview) error
+	RepositoryCommitHandler  func(*github.RepositoryCommit) error
+	RepositoryReleaseHandler func(*github.RepositoryRelease) error
+	TimelineHandler          func(*github.Timeline) error
+	UserHandler              func(*github.User) error
+	WorkflowRunHandler       func(*github.WorkflowRun) error
+)
 
 func PTR[T comparable](v T) *T {
 	return &v